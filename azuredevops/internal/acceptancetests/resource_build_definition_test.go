@@ -266,6 +266,89 @@ func getBuildDefinitionFromResource(resource *terraform.ResourceState) (*build.B
 	})
 }
 
+// Verifies a classic (designer) build definition can be created, updated and imported without a diff
+func TestAccBuildDefinition_DesignerProcess_CreateUpdateImport(t *testing.T) {
+	name := testutils.GenerateResourceName()
+	tfNode := "azuredevops_build_definition.build"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkBuildDefinitionDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclBuildDefinitionDesignerProcess(name, "echo first"),
+				Check: resource.ComposeTestCheckFunc(
+					checkBuildDefinitionExists(name),
+					resource.TestCheckResourceAttr(tfNode, "repository.0.yml_path", ""),
+					resource.TestCheckResourceAttr(tfNode, "designer_process.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "designer_process.0.phase.#", "1"),
+					resource.TestCheckResourceAttrSet(tfNode, "designer_process.0.phase.0.ref_name"),
+					resource.TestCheckResourceAttr(tfNode, "designer_process.0.phase.0.task.0.inputs.script", "echo first"),
+				),
+			}, {
+				Config: hclBuildDefinitionDesignerProcess(name, "echo second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "designer_process.0.phase.0.task.0.inputs.script", "echo second"),
+				),
+			}, {
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func hclBuildDefinitionDesignerProcess(name string, script string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name = "%[1]s"
+}
+
+resource "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "acc-%[1]s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_build_definition" "build" {
+  project_id = azuredevops_project.test.id
+  name       = "%[1]s"
+
+  repository {
+    repo_type   = "TfsGit"
+    repo_id     = azuredevops_git_repository.test.id
+    branch_name = azuredevops_git_repository.test.default_branch
+  }
+
+  designer_process {
+    agent_specification = "ubuntu-20.04"
+
+    phase {
+      name = "Agent job 1"
+
+      variable {
+        name  = "configuration"
+        value = "release"
+      }
+
+      task {
+        task_id      = "d9bafed4-0b18-4f58-968d-86655b4d2ce9"
+        version      = "2.*"
+        display_name = "Run a script"
+        inputs = {
+          script = "%[2]s"
+        }
+      }
+    }
+  }
+}
+`, name, script)
+}
+
 func hclBuildDefinitionSchedules(name string) string {
 	return fmt.Sprintf(`
 resource "azuredevops_project" "test" {
@@ -0,0 +1,78 @@
+//go:build (all || resource_build_folder) && !exclude_resource_build_folder
+// +build all resource_build_folder
+// +build !exclude_resource_build_folder
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func hclBuildFolder(projectName string, path string, description string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_build_folder" "folder" {
+	project_id  = azuredevops_project.project.id
+	path        = "%s"
+	description = "%s"
+}
+
+data "azuredevops_group" "tf-project-readers" {
+	project_id = azuredevops_project.project.id
+	name       = "Readers"
+}
+
+resource "azuredevops_build_folder_permissions" "permissions" {
+	project_id = azuredevops_project.project.id
+	principal  = data.azuredevops_group.tf-project-readers.id
+	path       = azuredevops_build_folder.folder.path
+
+	permissions = {
+		ViewBuilds   = "Allow"
+		DeleteBuilds = "Deny"
+	}
+}
+`, testutils.HclProjectResource(projectName), path, description)
+}
+
+// Verifies that a build folder can be created, renamed and delegated by permissions
+func TestAccBuildFolder_CreateAndRename(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_build_folder.folder"
+	tfPermissionsNode := "azuredevops_build_folder_permissions.permissions"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclBuildFolder(projectName, `\\Team`, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckProjectExists(projectName),
+					resource.TestCheckResourceAttr(tfNode, "path", `\Team`),
+					resource.TestCheckResourceAttr(tfNode, "description", "first"),
+					resource.TestCheckResourceAttr(tfPermissionsNode, "permissions.%", "2"),
+					resource.TestCheckResourceAttr(tfPermissionsNode, "permissions.ViewBuilds", "allow"),
+				),
+			}, {
+				Config: hclBuildFolder(projectName, `\\Renamed`, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "path", `\Renamed`),
+					resource.TestCheckResourceAttr(tfNode, "description", "second"),
+				),
+			}, {
+				ResourceName:            tfNode,
+				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_delete_non_empty"},
+			},
+		},
+	})
+}
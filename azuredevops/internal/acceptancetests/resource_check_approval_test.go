@@ -0,0 +1,74 @@
+//go:build (all || resource_check_approval) && !exclude_checks
+// +build all resource_check_approval
+// +build !exclude_checks
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccCheckApproval_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	environmentName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_check_approval.check"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclCheckApproval(projectName, environmentName, "first", 1440),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttrPair(tfNode, "target_resource_id", "azuredevops_environment.environment", "id"),
+					resource.TestCheckResourceAttr(tfNode, "target_resource_type", "environment"),
+					resource.TestCheckResourceAttr(tfNode, "approvers.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "instructions", "first"),
+					resource.TestCheckResourceAttr(tfNode, "timeout", "1440"),
+				),
+			}, {
+				Config: hclCheckApproval(projectName, environmentName, "second", 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "instructions", "second"),
+					resource.TestCheckResourceAttr(tfNode, "timeout", "60"),
+				),
+			}, {
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func hclCheckApproval(projectName string, environmentName string, instructions string, timeout int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_environment" "environment" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+}
+
+data "azuredevops_group" "approvers" {
+  project_id = azuredevops_project.project.id
+  name       = "Project Administrators"
+}
+
+resource "azuredevops_check_approval" "check" {
+  project_id           = azuredevops_project.project.id
+  target_resource_id   = azuredevops_environment.environment.id
+  target_resource_type = "environment"
+  approvers            = [data.azuredevops_group.approvers.descriptor]
+  instructions         = "%s"
+  timeout              = %d
+}
+`, testutils.HclProjectResource(projectName), environmentName, instructions, timeout)
+}
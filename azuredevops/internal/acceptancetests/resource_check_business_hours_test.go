@@ -0,0 +1,69 @@
+//go:build (all || resource_check_business_hours) && !exclude_checks
+// +build all resource_check_business_hours
+// +build !exclude_checks
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccCheckBusinessHours_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	variableGroupName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_check_business_hours.check"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclCheckBusinessHours(projectName, variableGroupName, "08:00", "17:00"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(tfNode, "target_resource_id", "azuredevops_variable_group.vg", "id"),
+					resource.TestCheckResourceAttr(tfNode, "business_days.#", "5"),
+					resource.TestCheckResourceAttr(tfNode, "start_time", "08:00"),
+					resource.TestCheckResourceAttr(tfNode, "end_time", "17:00"),
+				),
+			}, {
+				Config: hclCheckBusinessHours(projectName, variableGroupName, "09:00", "18:00"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "start_time", "09:00"),
+					resource.TestCheckResourceAttr(tfNode, "end_time", "18:00"),
+				),
+			},
+		},
+	})
+}
+
+func hclCheckBusinessHours(projectName string, variableGroupName string, startTime string, endTime string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_variable_group" "vg" {
+  project_id   = azuredevops_project.project.id
+  name         = "%s"
+  allow_access = true
+
+  variable {
+    name  = "key"
+    value = "value"
+  }
+}
+
+resource "azuredevops_check_business_hours" "check" {
+  project_id           = azuredevops_project.project.id
+  target_resource_id   = azuredevops_variable_group.vg.id
+  target_resource_type = "variablegroup"
+  business_days        = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+  time_zone            = "UTC"
+  start_time           = "%s"
+  end_time             = "%s"
+}
+`, testutils.HclProjectResource(projectName), variableGroupName, startTime, endTime)
+}
@@ -0,0 +1,104 @@
+//go:build (all || resource_environment) && !exclude_resource_environment
+// +build all resource_environment
+// +build !exclude_resource_environment
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccEnvironment_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	environmentNameFirst := testutils.GenerateResourceName()
+	environmentNameSecond := testutils.GenerateResourceName()
+	tfNode := "azuredevops_environment.environment"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclEnvironmentResource(projectName, environmentNameFirst, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttr(tfNode, "name", environmentNameFirst),
+					resource.TestCheckResourceAttr(tfNode, "description", "first"),
+				),
+			}, {
+				Config: hclEnvironmentResource(projectName, environmentNameSecond, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", environmentNameSecond),
+					resource.TestCheckResourceAttr(tfNode, "description", "second"),
+				),
+			}, {
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEnvironmentResourceKubernetes_Create(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	serviceEndpointName := testutils.GenerateResourceName()
+	environmentName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_environment_resource_kubernetes.kubernetes"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclEnvironmentResourceKubernetes(projectName, serviceEndpointName, environmentName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "environment_id"),
+					resource.TestCheckResourceAttrPair(tfNode, "service_endpoint_id", "azuredevops_serviceendpoint_kubernetes.serviceendpoint", "id"),
+					resource.TestCheckResourceAttr(tfNode, "name", "frontend"),
+					resource.TestCheckResourceAttr(tfNode, "namespace", "frontend"),
+					resource.TestCheckResourceAttr(tfNode, "tags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func hclEnvironmentResource(projectName string, environmentName string, description string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_environment" "environment" {
+  project_id  = azuredevops_project.project.id
+  name        = "%s"
+  description = "%s"
+}
+`, testutils.HclProjectResource(projectName), environmentName, description)
+}
+
+func hclEnvironmentResourceKubernetes(projectName string, serviceEndpointName string, environmentName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_environment" "environment" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+}
+
+resource "azuredevops_environment_resource_kubernetes" "kubernetes" {
+  project_id          = azuredevops_project.project.id
+  environment_id      = azuredevops_environment.environment.id
+  service_endpoint_id = azuredevops_serviceendpoint_kubernetes.serviceendpoint.id
+  name                = "frontend"
+  namespace           = "frontend"
+  tags                = ["web"]
+}
+`, testutils.HclServiceEndpointKubernetesResource(projectName, serviceEndpointName, "ServiceAccount"), environmentName)
+}
@@ -0,0 +1,69 @@
+//go:build (all || core || resource_git_repository_branch) && !exclude_resource_git_repository_branch
+// +build all core resource_git_repository_branch
+// +build !exclude_resource_git_repository_branch
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func hclGitRepoBranch(projectName string, gitRepoName string, isLocked bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository_branch" "from_branch" {
+	repository_id = azuredevops_git_repository.repository.id
+	name          = "release/1.0"
+	ref_branch    = azuredevops_git_repository.repository.default_branch
+	is_locked     = %t
+}
+
+resource "azuredevops_git_repository_branch" "from_commit" {
+	repository_id = azuredevops_git_repository.repository.id
+	name          = "hotfix/1.0"
+	ref_commit_id = azuredevops_git_repository_branch.from_branch.last_commit_id
+}
+`, testutils.HclGitRepoResource(projectName, gitRepoName, "Clean"), isLocked)
+}
+
+// TestAccGitRepoBranch_CreateAndLock verifies that branches can be created
+// from a branch and a commit and that a branch can be locked
+func TestAccGitRepoBranch_CreateAndLock(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfFromBranchNode := "azuredevops_git_repository_branch.from_branch"
+	tfFromCommitNode := "azuredevops_git_repository_branch.from_commit"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitRepoBranch(projectName, gitRepoName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfFromBranchNode, "ref", "refs/heads/release/1.0"),
+					resource.TestCheckResourceAttr(tfFromBranchNode, "is_locked", "false"),
+					resource.TestCheckResourceAttrSet(tfFromBranchNode, "last_commit_id"),
+					resource.TestCheckResourceAttrPair(tfFromCommitNode, "last_commit_id", tfFromBranchNode, "last_commit_id"),
+				),
+			},
+			{
+				Config: hclGitRepoBranch(projectName, gitRepoName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfFromBranchNode, "is_locked", "true"),
+				),
+			},
+			{
+				ResourceName:            tfFromBranchNode,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ref_branch"},
+			},
+		},
+	})
+}
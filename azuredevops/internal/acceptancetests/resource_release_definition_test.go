@@ -0,0 +1,146 @@
+//go:build (all || resource_release_definition) && !exclude_resource_release_definition
+// +build all resource_release_definition
+// +build !exclude_resource_release_definition
+
+package acceptancetests
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccReleaseDefinition_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	releaseDefinitionNameFirst := testutils.GenerateResourceName()
+	releaseDefinitionNameSecond := testutils.GenerateResourceName()
+	tfNode := "azuredevops_release_definition.release"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, &[]string{"AZDO_TEST_AAD_USER_EMAIL"}) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclReleaseDefinitionBasic(projectName, releaseDefinitionNameFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "revision"),
+					resource.TestCheckResourceAttr(tfNode, "name", releaseDefinitionNameFirst),
+					resource.TestCheckResourceAttr(tfNode, "stage.#", "1"),
+					resource.TestCheckResourceAttrSet(tfNode, "stage.0.id"),
+				),
+			}, {
+				Config: hclReleaseDefinitionComplete(projectName, releaseDefinitionNameSecond),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", releaseDefinitionNameSecond),
+					resource.TestCheckResourceAttr(tfNode, "artifact.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "stage.#", "2"),
+					resource.TestCheckResourceAttr(tfNode, "stage.1.trigger_after_stages.0", "dev"),
+					resource.TestCheckResourceAttr(tfNode, "stage.1.pre_deploy_approval.#", "1"),
+				),
+			}, {
+				ResourceName:            tfNode,
+				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"stage.0.job.0.task.0.inputs"},
+			},
+		},
+	})
+}
+
+func hclReleaseDefinitionTemplate(projectName string) string {
+	userEntitlement := testutils.HclUserEntitlementResource(os.Getenv("AZDO_TEST_AAD_USER_EMAIL"))
+	return strings.Join([]string{testutils.HclProjectResource(projectName), userEntitlement}, "\n")
+}
+
+func hclReleaseDefinitionBasic(projectName string, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_release_definition" "release" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+
+  stage {
+    name     = "dev"
+    owner_id = azuredevops_user_entitlement.user.id
+  }
+}
+`, hclReleaseDefinitionTemplate(projectName), name)
+}
+
+func hclReleaseDefinitionComplete(projectName string, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "repository" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+data "azuredevops_agent_queue" "queue" {
+  project_id = azuredevops_project.project.id
+  name       = "Azure Pipelines"
+}
+
+resource "azuredevops_release_definition" "release" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+
+  variable {
+    name  = "FOO"
+    value = "BAR"
+  }
+
+  artifact {
+    alias          = "_repo"
+    type           = "Git"
+    is_primary     = true
+    repository_id  = azuredevops_git_repository.repository.id
+    default_branch = "refs/heads/master"
+  }
+
+  continuous_deployment_trigger {
+    artifact_alias = "_repo"
+    branch_filter  = ["refs/heads/master"]
+  }
+
+  stage {
+    name     = "dev"
+    owner_id = azuredevops_user_entitlement.user.id
+
+    job {
+      name     = "Agent job"
+      queue_id = data.azuredevops_agent_queue.queue.id
+
+      task {
+        task_id = "d9bafed4-0b18-4f58-968d-86655b4d2ce9"
+        version = "2.*"
+        name    = "Command line"
+        inputs = {
+          script = "echo hello"
+        }
+      }
+    }
+  }
+
+  stage {
+    name                 = "prod"
+    owner_id             = azuredevops_user_entitlement.user.id
+    trigger_after_stages = ["dev"]
+
+    pre_deploy_approval {
+      approvers = [azuredevops_user_entitlement.user.id]
+    }
+  }
+}
+`, hclReleaseDefinitionTemplate(projectName), name, name)
+}
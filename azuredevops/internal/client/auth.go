@@ -0,0 +1,346 @@
+package client
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// the application ID of Azure DevOps in Azure Active Directory
+	azureDevOpsResourceID = "499b84ac-1321-427f-aa17-267ca6975798"
+
+	defaultAuthorityHost = "https://login.microsoftonline.com/"
+	defaultMSIEndpoint   = "http://169.254.169.254/metadata/identity/oauth2/token"
+
+	clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+	// tokens are refreshed ahead of their expiry so that running requests do not fail
+	tokenRefreshMargin = 5 * time.Minute
+)
+
+// AuthConfig holds the settings used to authenticate against Azure DevOps.
+// A personal access token takes precedence over the Azure Active Directory settings.
+type AuthConfig struct {
+	PersonalAccessToken string
+	// Username is sent with the personal access token or password using basic authentication
+	Username                  string
+	ClientID                  string
+	TenantID                  string
+	ClientSecret              string
+	ClientCertificatePath     string
+	ClientCertificatePassword string
+	OIDCTokenFilePath         string
+	UseMSI                    bool
+	MSIEndpoint               string
+	AuthorityHost             string
+}
+
+// accessToken is a bearer token issued by Azure Active Directory
+type accessToken struct {
+	Token     string
+	ExpiresOn time.Time
+}
+
+// tokenProvider acquires a new access token for Azure DevOps
+type tokenProvider interface {
+	getToken(ctx context.Context) (*accessToken, error)
+}
+
+// newTokenProvider returns the token provider for the configured Azure Active Directory authentication,
+// nil is returned if a personal access token is used
+func newTokenProvider(authConfig *AuthConfig, httpClient *http.Client) (tokenProvider, error) {
+	if authConfig.PersonalAccessToken != "" {
+		return nil, nil
+	}
+
+	if authConfig.UseMSI {
+		endpoint := authConfig.MSIEndpoint
+		if endpoint == "" {
+			endpoint = defaultMSIEndpoint
+		}
+		return &msiTokenProvider{
+			httpClient: httpClient,
+			endpoint:   endpoint,
+			clientID:   authConfig.ClientID,
+		}, nil
+	}
+
+	var credential func(tokenURL string) (url.Values, error)
+	switch {
+	case authConfig.ClientSecret != "":
+		credential = func(string) (url.Values, error) {
+			return url.Values{"client_secret": {authConfig.ClientSecret}}, nil
+		}
+	case authConfig.ClientCertificatePath != "":
+		certificate, key, err := readClientCertificate(authConfig.ClientCertificatePath, authConfig.ClientCertificatePassword)
+		if err != nil {
+			return nil, err
+		}
+		credential = func(tokenURL string) (url.Values, error) {
+			assertion, err := newClientAssertion(authConfig.ClientID, tokenURL, certificate, key)
+			if err != nil {
+				return nil, err
+			}
+			return url.Values{"client_assertion_type": {clientAssertionType}, "client_assertion": {assertion}}, nil
+		}
+	case authConfig.OIDCTokenFilePath != "":
+		// the federated token is read on every request as it is rotated by the platform
+		credential = func(string) (url.Values, error) {
+			token, err := ioutil.ReadFile(authConfig.OIDCTokenFilePath)
+			if err != nil {
+				return nil, fmt.Errorf("reading the OIDC token file %s failed: %+v", authConfig.OIDCTokenFilePath, err)
+			}
+			return url.Values{"client_assertion_type": {clientAssertionType}, "client_assertion": {strings.TrimSpace(string(token))}}, nil
+		}
+	default:
+		return nil, fmt.Errorf("the personal access token or an Azure Active Directory client secret, client certificate, OIDC token file or managed identity is required")
+	}
+
+	if authConfig.ClientID == "" || authConfig.TenantID == "" {
+		return nil, fmt.Errorf("the client ID and the tenant ID are required to authenticate with a service principal")
+	}
+
+	authorityHost := authConfig.AuthorityHost
+	if authorityHost == "" {
+		authorityHost = defaultAuthorityHost
+	}
+	return &clientCredentialsTokenProvider{
+		httpClient: httpClient,
+		tokenURL:   fmt.Sprintf("%s/%s/oauth2/v2.0/token", strings.TrimRight(authorityHost, "/"), url.PathEscape(authConfig.TenantID)),
+		clientID:   authConfig.ClientID,
+		credential: credential,
+	}, nil
+}
+
+// clientCredentialsTokenProvider acquires tokens for a service principal with the client credentials flow
+type clientCredentialsTokenProvider struct {
+	httpClient *http.Client
+	tokenURL   string
+	clientID   string
+	credential func(tokenURL string) (url.Values, error)
+}
+
+func (p *clientCredentialsTokenProvider) getToken(ctx context.Context) (*accessToken, error) {
+	form, err := p.credential(p.tokenURL)
+	if err != nil {
+		return nil, err
+	}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", p.clientID)
+	form.Set("scope", azureDevOpsResourceID+"/.default")
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, p.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return requestToken(p.httpClient, request)
+}
+
+// msiTokenProvider acquires tokens for the managed identity of the machine running Terraform
+type msiTokenProvider struct {
+	httpClient *http.Client
+	endpoint   string
+	clientID   string
+}
+
+func (p *msiTokenProvider) getToken(ctx context.Context) (*accessToken, error) {
+	query := url.Values{
+		"api-version": {"2018-02-01"},
+		"resource":    {azureDevOpsResourceID},
+	}
+	// a user assigned identity is selected by its client ID
+	if p.clientID != "" {
+		query.Set("client_id", p.clientID)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, p.endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Metadata", "true")
+	return requestToken(p.httpClient, request)
+}
+
+// tokenResponse is the response of the Azure Active Directory and the managed identity token endpoints,
+// the managed identity endpoint returns the expiry as a string
+type tokenResponse struct {
+	AccessToken      string      `json:"access_token"`
+	ExpiresIn        json.Number `json:"expires_in"`
+	Error            string      `json:"error"`
+	ErrorDescription string      `json:"error_description"`
+}
+
+func requestToken(httpClient *http.Client, request *http.Request) (*accessToken, error) {
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("requesting an access token from %s failed: %+v", request.URL.Host, err)
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var token tokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, fmt.Errorf("parsing the access token response failed, status: %d. Error: %+v", response.StatusCode, err)
+	}
+	if response.StatusCode != http.StatusOK || token.AccessToken == "" {
+		return nil, fmt.Errorf("requesting an access token failed, status: %d, error: %s, description: %s", response.StatusCode, token.Error, token.ErrorDescription)
+	}
+
+	expiresIn, err := token.ExpiresIn.Int64()
+	if err != nil {
+		return nil, fmt.Errorf("invalid access token expiry %q: %+v", token.ExpiresIn, err)
+	}
+	return &accessToken{
+		Token:     token.AccessToken,
+		ExpiresOn: time.Now().Add(time.Duration(expiresIn) * time.Second),
+	}, nil
+}
+
+// cachedTokenProvider reuses a token until it is about to expire
+type cachedTokenProvider struct {
+	provider tokenProvider
+	lock     sync.Mutex
+	token    *accessToken
+}
+
+func (p *cachedTokenProvider) getToken(ctx context.Context) (*accessToken, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.token != nil && time.Until(p.token.ExpiresOn) > tokenRefreshMargin {
+		return p.token, nil
+	}
+	token, err := p.provider.getToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.token = token
+	return token, nil
+}
+
+// bearerAuthTransport adds a bearer token to every request sent to Azure DevOps
+type bearerAuthTransport struct {
+	provider tokenProvider
+	base     http.RoundTripper
+}
+
+func (t *bearerAuthTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	token, err := t.provider.getToken(request.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	// a RoundTripper must not modify the request
+	authorized := request.Clone(request.Context())
+	authorized.Header.Set("Authorization", "Bearer "+token.Token)
+	return t.base.RoundTrip(authorized)
+}
+
+// readClientCertificate reads the certificate and the RSA private key from a PEM file
+func readClientCertificate(path string, password string) (*x509.Certificate, *rsa.PrivateKey, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading the client certificate %s failed: %+v", path, err)
+	}
+
+	var certificate *x509.Certificate
+	var key *rsa.PrivateKey
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		switch {
+		case block.Type == "CERTIFICATE" && certificate == nil:
+			if certificate, err = x509.ParseCertificate(block.Bytes); err != nil {
+				return nil, nil, fmt.Errorf("parsing the client certificate failed: %+v", err)
+			}
+		case strings.HasSuffix(block.Type, "PRIVATE KEY") && key == nil:
+			if key, err = parseRSAPrivateKey(block, password); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if certificate == nil || key == nil {
+		return nil, nil, fmt.Errorf("the client certificate %s must contain a PEM encoded certificate and RSA private key", path)
+	}
+	return certificate, key, nil
+}
+
+func parseRSAPrivateKey(block *pem.Block, password string) (*rsa.PrivateKey, error) {
+	keyBytes := block.Bytes
+	// legacy encrypted PEM blocks are the only encrypted keys supported by the standard library
+	if x509.IsEncryptedPEMBlock(block) {
+		decrypted, err := x509.DecryptPEMBlock(block, []byte(password))
+		if err != nil {
+			return nil, fmt.Errorf("decrypting the client certificate private key failed: %+v", err)
+		}
+		keyBytes = decrypted
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(keyBytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(keyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the client certificate private key failed: %+v", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("the client certificate private key must be an RSA key")
+	}
+	return key, nil
+}
+
+// newClientAssertion returns a JWT signed with the client certificate to authenticate the service principal
+func newClientAssertion(clientID string, tokenURL string, certificate *x509.Certificate, key *rsa.PrivateKey) (string, error) {
+	thumbprint := sha1.Sum(certificate.Raw)
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+		"x5t": base64.RawURLEncoding.EncodeToString(thumbprint[:]),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	claims, err := json.Marshal(map[string]interface{}{
+		"aud": tokenURL,
+		"iss": clientID,
+		"sub": clientID,
+		"jti": uuid.New().String(),
+		"nbf": now.Unix(),
+		"exp": now.Add(10 * time.Minute).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("signing the client assertion failed: %+v", err)
+	}
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
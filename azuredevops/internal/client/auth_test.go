@@ -0,0 +1,257 @@
+package client
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTokenEndpointStub returns a token endpoint that hands out numbered tokens and passes every request to the check function
+func newTokenEndpointStub(t *testing.T, expiresIn interface{}, check func(r *http.Request)) (*httptest.Server, *int32) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Nil(t, r.ParseForm())
+		check(r)
+		count := atomic.AddInt32(&requests, 1)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": fmt.Sprintf("token-%d", count),
+			"expires_in":   expiresIn,
+			"token_type":   "Bearer",
+		})
+	}))
+	return server, &requests
+}
+
+func TestAuth_NoCredentials_ReturnsError(t *testing.T) {
+	_, err := newTokenProvider(&AuthConfig{}, http.DefaultClient)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "the personal access token or an Azure Active Directory")
+}
+
+func TestAuth_PersonalAccessToken_HasNoTokenProvider(t *testing.T) {
+	provider, err := newTokenProvider(&AuthConfig{PersonalAccessToken: "pat", ClientSecret: "secret"}, http.DefaultClient)
+	require.Nil(t, err)
+	require.Nil(t, provider)
+}
+
+func TestAuth_ServicePrincipal_RequiresClientAndTenant(t *testing.T) {
+	_, err := newTokenProvider(&AuthConfig{ClientSecret: "secret", ClientID: "client"}, http.DefaultClient)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "the client ID and the tenant ID are required")
+}
+
+// verifies that the client secret is exchanged for a token which is added to the requests and reused
+func TestAuth_ClientSecret_AddsCachedBearerToken(t *testing.T) {
+	tokenServer, tokenRequests := newTokenEndpointStub(t, 3600, func(r *http.Request) {
+		require.Equal(t, "/tenant/oauth2/v2.0/token", r.URL.Path)
+		require.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		require.Equal(t, "client", r.PostForm.Get("client_id"))
+		require.Equal(t, "secret", r.PostForm.Get("client_secret"))
+		require.Equal(t, azureDevOpsResourceID+"/.default", r.PostForm.Get("scope"))
+	})
+	defer tokenServer.Close()
+
+	var authorizations []string
+	azdoServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+	}))
+	defer azdoServer.Close()
+
+	provider, err := newTokenProvider(&AuthConfig{
+		ClientID:      "client",
+		TenantID:      "tenant",
+		ClientSecret:  "secret",
+		AuthorityHost: tokenServer.URL,
+	}, http.DefaultClient)
+	require.Nil(t, err)
+
+	httpClient := &http.Client{Transport: &bearerAuthTransport{
+		provider: &cachedTokenProvider{provider: provider},
+		base:     http.DefaultTransport,
+	}}
+	for i := 0; i < 2; i++ {
+		response, err := httpClient.Get(azdoServer.URL)
+		require.Nil(t, err)
+		response.Body.Close()
+	}
+
+	require.Equal(t, []string{"Bearer token-1", "Bearer token-1"}, authorizations)
+	require.Equal(t, int32(1), atomic.LoadInt32(tokenRequests))
+}
+
+// verifies that a token about to expire is refreshed
+func TestAuth_CachedToken_RefreshedBeforeExpiry(t *testing.T) {
+	tokenServer, tokenRequests := newTokenEndpointStub(t, 60, func(r *http.Request) {})
+	defer tokenServer.Close()
+
+	provider := &cachedTokenProvider{provider: &clientCredentialsTokenProvider{
+		httpClient: http.DefaultClient,
+		tokenURL:   tokenServer.URL,
+		clientID:   "client",
+		credential: func(string) (url.Values, error) { return url.Values{}, nil },
+	}}
+
+	first, err := provider.getToken(context.Background())
+	require.Nil(t, err)
+	second, err := provider.getToken(context.Background())
+	require.Nil(t, err)
+
+	require.Equal(t, "token-1", first.Token)
+	require.Equal(t, "token-2", second.Token)
+	require.Equal(t, int32(2), atomic.LoadInt32(tokenRequests))
+}
+
+// verifies that the federated token is read from the file on every token request
+func TestAuth_OIDCTokenFile_SendsFederatedToken(t *testing.T) {
+	dir, err := ioutil.TempDir("", "oidc")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	tokenFile := filepath.Join(dir, "token")
+
+	var assertions []string
+	tokenServer, _ := newTokenEndpointStub(t, 3600, func(r *http.Request) {
+		require.Equal(t, clientAssertionType, r.PostForm.Get("client_assertion_type"))
+		assertions = append(assertions, r.PostForm.Get("client_assertion"))
+	})
+	defer tokenServer.Close()
+
+	provider, err := newTokenProvider(&AuthConfig{
+		ClientID:          "client",
+		TenantID:          "tenant",
+		OIDCTokenFilePath: tokenFile,
+		AuthorityHost:     tokenServer.URL,
+	}, http.DefaultClient)
+	require.Nil(t, err)
+
+	require.Nil(t, ioutil.WriteFile(tokenFile, []byte("federated-1\n"), 0600))
+	_, err = provider.getToken(context.Background())
+	require.Nil(t, err)
+	require.Nil(t, ioutil.WriteFile(tokenFile, []byte("federated-2"), 0600))
+	_, err = provider.getToken(context.Background())
+	require.Nil(t, err)
+
+	require.Equal(t, []string{"federated-1", "federated-2"}, assertions)
+}
+
+// verifies that the client assertion is signed with the client certificate
+func TestAuth_ClientCertificate_SendsSignedAssertion(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.Nil(t, err)
+
+	dir, err := ioutil.TempDir("", "certificate")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	certificateFile := filepath.Join(dir, "client.pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})
+	data = append(data, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})...)
+	require.Nil(t, ioutil.WriteFile(certificateFile, data, 0600))
+
+	var assertion string
+	tokenServer, _ := newTokenEndpointStub(t, 3600, func(r *http.Request) {
+		require.Equal(t, clientAssertionType, r.PostForm.Get("client_assertion_type"))
+		assertion = r.PostForm.Get("client_assertion")
+	})
+	defer tokenServer.Close()
+
+	provider, err := newTokenProvider(&AuthConfig{
+		ClientID:              "client",
+		TenantID:              "tenant",
+		ClientCertificatePath: certificateFile,
+		AuthorityHost:         tokenServer.URL,
+	}, http.DefaultClient)
+	require.Nil(t, err)
+	_, err = provider.getToken(context.Background())
+	require.Nil(t, err)
+
+	parts := strings.Split(assertion, ".")
+	require.Len(t, parts, 3)
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.Nil(t, err)
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	require.Nil(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature))
+
+	claimsJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.Nil(t, err)
+	var claims map[string]interface{}
+	require.Nil(t, json.Unmarshal(claimsJSON, &claims))
+	require.Equal(t, "client", claims["iss"])
+	require.Equal(t, "client", claims["sub"])
+	require.Equal(t, tokenServer.URL+"/tenant/oauth2/v2.0/token", claims["aud"])
+}
+
+// verifies that the managed identity endpoint is queried with the metadata header and a string expiry is accepted
+func TestAuth_ManagedIdentity_RequestsToken(t *testing.T) {
+	tokenServer, _ := newTokenEndpointStub(t, "3599", func(r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "true", r.Header.Get("Metadata"))
+		require.Equal(t, azureDevOpsResourceID, r.URL.Query().Get("resource"))
+		require.Equal(t, "identity", r.URL.Query().Get("client_id"))
+	})
+	defer tokenServer.Close()
+
+	provider, err := newTokenProvider(&AuthConfig{
+		UseMSI:      true,
+		ClientID:    "identity",
+		MSIEndpoint: tokenServer.URL,
+	}, http.DefaultClient)
+	require.Nil(t, err)
+
+	token, err := provider.getToken(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, "token-1", token.Token)
+	require.True(t, token.ExpiresOn.After(time.Now().Add(time.Hour-time.Minute)))
+}
+
+// verifies that the error of the token endpoint is reported
+func TestAuth_TokenEndpointError_IsReported(t *testing.T) {
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(map[string]string{
+			"error":             "invalid_client",
+			"error_description": "AADSTS7000215: Invalid client secret provided.",
+		})
+	}))
+	defer tokenServer.Close()
+
+	provider, err := newTokenProvider(&AuthConfig{
+		ClientID:      "client",
+		TenantID:      "tenant",
+		ClientSecret:  "wrong",
+		AuthorityHost: tokenServer.URL,
+	}, http.DefaultClient)
+	require.Nil(t, err)
+
+	_, err = provider.getToken(context.Background())
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "status: 401, error: invalid_client")
+	require.Contains(t, err.Error(), "AADSTS7000215")
+}
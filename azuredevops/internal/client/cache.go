@@ -0,0 +1,147 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// clientSettings holds everything needed to create the clients of an organization
+type clientSettings struct {
+	AuthConfig      *AuthConfig
+	TransportConfig *TransportConfig
+	TFVersion       string
+}
+
+// lazyInit connects an AggregatedClient to its organization when the first resource needs it
+type lazyInit struct {
+	lock        sync.Mutex
+	settings    *clientSettings
+	initialized bool
+}
+
+// NewAggregatedClient returns a client for the organization that does not connect to it yet, it connects when
+// a resource of the organization first needs it, see Initialize
+func NewAggregatedClient(authConfig *AuthConfig, transportConfig *TransportConfig, organizationURL string, tfVersion string) *AggregatedClient {
+	return &AggregatedClient{
+		OrganizationURL: organizationURL,
+		lazy: &lazyInit{
+			settings: &clientSettings{
+				AuthConfig:      authConfig,
+				TransportConfig: transportConfig,
+				TFVersion:       tfVersion,
+			},
+		},
+	}
+}
+
+// Initialize connects a client returned by NewAggregatedClient to its organization, the client of each API area
+// is created by its first request. The clients are shared by all provider configurations using the same
+// organization and credentials.
+// Clients that were created in any other way are left unchanged.
+func (c *AggregatedClient) Initialize() error {
+	if c.lazy == nil {
+		return nil
+	}
+
+	c.lazy.lock.Lock()
+	defer c.lazy.lock.Unlock()
+	if c.lazy.initialized {
+		return nil
+	}
+	clients, err := getCachedClient(c.OrganizationURL, c.lazy.settings)
+	if err != nil {
+		return err
+	}
+	c.setClients(clients)
+	c.lazy.initialized = true
+	return nil
+}
+
+// ForOrganization returns the initialized client of another organization using the same credentials and settings
+func (c *AggregatedClient) ForOrganization(organizationURL string) (*AggregatedClient, error) {
+	if organizationURL == "" || strings.EqualFold(normalizeOrganizationURL(organizationURL), normalizeOrganizationURL(c.OrganizationURL)) {
+		return c, c.Initialize()
+	}
+	if c.lazy == nil {
+		return nil, fmt.Errorf("the client of %s can not be used for the organization %s", c.OrganizationURL, organizationURL)
+	}
+	return getCachedClient(organizationURL, c.lazy.settings)
+}
+
+func (c *AggregatedClient) setClients(clients *AggregatedClient) {
+	c.CoreClient = clients.CoreClient
+	c.BuildClient = clients.BuildClient
+	c.GitReposClient = clients.GitReposClient
+	c.GraphClient = clients.GraphClient
+	c.OperationsClient = clients.OperationsClient
+	c.PolicyClient = clients.PolicyClient
+	c.ReleaseClient = clients.ReleaseClient
+	c.ServiceEndpointClient = clients.ServiceEndpointClient
+	c.TaskAgentClient = clients.TaskAgentClient
+	c.MemberEntitleManagementClient = clients.MemberEntitleManagementClient
+	c.FeatureManagementClient = clients.FeatureManagementClient
+	c.SecurityClient = clients.SecurityClient
+	c.IdentityClient = clients.IdentityClient
+	c.WorkItemTrackingClient = clients.WorkItemTrackingClient
+	c.RequestLimiter = clients.RequestLimiter
+	c.ServerInfo = clients.ServerInfo
+	c.Ctx = clients.Ctx
+}
+
+// cachedClient is the client of an organization shared by the provider configurations
+type cachedClient struct {
+	lock    sync.Mutex
+	clients *AggregatedClient
+}
+
+// the clients are cached by organization and credentials for the lifetime of the provider process
+var clientCache = map[string]*cachedClient{}
+var clientCacheLock sync.Mutex
+
+// getCachedClient returns the client of the organization, it is created if the cache does not contain it.
+// A client that failed to be created is not cached so that the next resource tries again.
+func getCachedClient(organizationURL string, settings *clientSettings) (*AggregatedClient, error) {
+	key, err := clientCacheKey(organizationURL, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCacheLock.Lock()
+	entry, ok := clientCache[key]
+	if !ok {
+		entry = &cachedClient{}
+		clientCache[key] = entry
+	}
+	clientCacheLock.Unlock()
+
+	// concurrent resources of the organization wait for the first one to create the client
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+	if entry.clients == nil {
+		clients, err := GetAzdoClient(settings.AuthConfig, settings.TransportConfig, organizationURL, settings.TFVersion)
+		if err != nil {
+			return nil, err
+		}
+		entry.clients = clients
+	}
+	return entry.clients, nil
+}
+
+// clientCacheKey identifies the organization and the credentials and settings used to connect to it,
+// the credentials are hashed so that they are not kept as a readable key
+func clientCacheKey(organizationURL string, settings *clientSettings) (string, error) {
+	content, err := json.Marshal(settings)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(content)
+	return normalizeOrganizationURL(organizationURL) + "|" + hex.EncodeToString(hash[:]), nil
+}
+
+func normalizeOrganizationURL(organizationURL string) string {
+	return strings.ToLower(strings.TrimRight(organizationURL, "/"))
+}
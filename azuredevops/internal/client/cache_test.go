@@ -0,0 +1,73 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that the clients are created on first use and shared by the provider configurations of an organization
+func TestCache_LazySharedClients(t *testing.T) {
+	var authorization string
+	collection := newCollectionServer(t, &authorization)
+	defer collection.Close()
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		collection.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	organizationURL := server.URL + "/tfs/DefaultCollection"
+	authConfig := &AuthConfig{PersonalAccessToken: "pat"}
+	first := NewAggregatedClient(authConfig, &TransportConfig{}, organizationURL, "0.12.0")
+	second := NewAggregatedClient(&AuthConfig{PersonalAccessToken: "pat"}, &TransportConfig{}, organizationURL+"/", "0.12.0")
+	other := NewAggregatedClient(&AuthConfig{PersonalAccessToken: "other"}, &TransportConfig{}, organizationURL, "0.12.0")
+	require.Nil(t, first.CoreClient)
+	require.Equal(t, int32(0), atomic.LoadInt32(&requests), "no request must be sent before a client is used")
+
+	require.Nil(t, first.Initialize())
+	sent := atomic.LoadInt32(&requests)
+	require.NotNil(t, first.CoreClient)
+	require.True(t, first.ServerInfo.IsOnPremises())
+
+	require.Nil(t, second.Initialize())
+	require.Equal(t, sent, atomic.LoadInt32(&requests), "the clients of the organization must be reused")
+	require.True(t, first.CoreClient == second.CoreClient)
+
+	require.Nil(t, other.Initialize())
+	require.False(t, first.CoreClient == other.CoreClient, "the clients of other credentials must not be reused")
+}
+
+func TestCache_ForOrganization(t *testing.T) {
+	var authorization string
+	server := newCollectionServer(t, &authorization)
+	defer server.Close()
+
+	organizationURL := server.URL + "/tfs/DefaultCollection"
+	defaultClients := NewAggregatedClient(&AuthConfig{PersonalAccessToken: "pat"}, &TransportConfig{}, "https://dev.azure.com/unused", "0.12.0")
+
+	clients, err := defaultClients.ForOrganization(organizationURL)
+	require.Nil(t, err)
+	require.Equal(t, organizationURL, clients.OrganizationURL)
+	require.NotNil(t, clients.CoreClient)
+	require.Nil(t, defaultClients.CoreClient, "the clients of the default organization must not be created")
+
+	same, err := defaultClients.ForOrganization(organizationURL)
+	require.Nil(t, err)
+	require.True(t, clients == same)
+}
+
+func TestCache_ForOrganization_ClientWithoutSettings(t *testing.T) {
+	clients := &AggregatedClient{OrganizationURL: "https://dev.azure.com/org"}
+
+	same, err := clients.ForOrganization("")
+	require.Nil(t, err)
+	require.True(t, clients == same)
+
+	_, err = clients.ForOrganization("https://dev.azure.com/other")
+	require.NotNil(t, err)
+}
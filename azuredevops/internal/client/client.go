@@ -2,11 +2,15 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"strings"
+	"sync"
 
+	"github.com/google/uuid"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
@@ -48,131 +52,242 @@ type AggregatedClient struct {
 	SecurityClient                security.Client
 	IdentityClient                identity.Client
 	WorkItemTrackingClient        workitemtracking.Client
+	RequestLimiter                *RequestLimiter
+	ServerInfo                    *ServerInfo
 	Ctx                           context.Context
+
+	// set for clients created by NewAggregatedClient
+	lazy *lazyInit
 }
 
-// GetAzdoClient builds and provides a connection to the Azure DevOps API
-func GetAzdoClient(azdoPAT string, organizationURL string, tfVersion string) (*AggregatedClient, error) {
-	ctx := context.Background()
+// wrappingClient is implemented by the clients of the provider that wrap a client of the SDK
+type wrappingClient interface {
+	unwrap() (interface{}, error)
+}
 
-	if strings.EqualFold(azdoPAT, "") {
-		return nil, fmt.Errorf("the personal access token is required")
+// UnwrapClient returns the client of the SDK behind a client of the AggregatedClient, the requests of APIs
+// that the SDK does not provide are sent through the client implementations of the SDK
+func UnwrapClient(c interface{}) (interface{}, error) {
+	for {
+		wrapper, ok := c.(wrappingClient)
+		if !ok {
+			return c, nil
+		}
+		var err error
+		if c, err = wrapper.unwrap(); err != nil {
+			return nil, err
+		}
 	}
+}
+
+// GetAzdoClient builds and provides a connection to the Azure DevOps API
+func GetAzdoClient(authConfig *AuthConfig, transportConfig *TransportConfig, organizationURL string, tfVersion string) (*AggregatedClient, error) {
+	ctx := context.Background()
 
 	if strings.EqualFold(organizationURL, "") {
 		return nil, fmt.Errorf("the url of the Azure DevOps is required")
 	}
 
-	connection := azuredevops.NewPatConnection(organizationURL, azdoPAT)
-	setUserAgent(connection, tfVersion)
-
-	// client for these APIs (includes CRUD for AzDO projects...):
-	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/core/?view=azure-devops-rest-5.1
-	coreClient, err := core.NewClient(ctx, connection)
+	transport, err := newBaseTransport(transportConfig)
 	if err != nil {
-		log.Printf("getAzdoClient(): core.NewClient failed.")
 		return nil, err
 	}
-
-	// client for these APIs (includes CRUD for AzDO build pipelines...):
-	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/build/?view=azure-devops-rest-5.1
-	buildClient, err := build.NewClient(ctx, connection)
+	provider, err := newTokenProvider(authConfig, &http.Client{Transport: transport})
 	if err != nil {
-		log.Printf("getAzdoClient(): build.NewClient failed.")
 		return nil, err
 	}
 
-	// client for these APIs (monitor async operations...):
-	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/operations/operations?view=azure-devops-rest-5.1
-	operationsClient := operations.NewClient(ctx, connection)
-
-	// client for these APIs (includes CRUD for AzDO service endpoints a.k.a. service connections...):
-	//  https://docs.microsoft.com/en-us/rest/api/azure/devops/serviceendpoint/endpoints?view=azure-devops-rest-5.1
-	serviceEndpointClient, err := serviceendpoint.NewClient(ctx, connection)
-	if err != nil {
-		log.Printf("getAzdoClient(): serviceendpoint.NewClient failed.")
-		return nil, err
+	var connection *azuredevops.Connection
+	if transportConfig.TraceFile != "" {
+		transport, err = newTraceTransport(transport, transportConfig.TraceFile, transportConfig.SensitiveFields)
+		if err != nil {
+			return nil, err
+		}
 	}
-
-	// client for these APIs (includes CRUD for AzDO variable groups):
-	taskagentClient, err := taskagent.NewClient(ctx, connection)
-	if err != nil {
-		log.Printf("getAzdoClient(): taskagent.NewClient failed.")
-		return nil, err
+	if provider == nil && authConfig.Username != "" {
+		// Azure DevOps Server collections with basic authentication enabled in IIS
+		connection = azuredevops.NewAnonymousConnection(organizationURL)
+		connection.AuthorizationString = azuredevops.CreateBasicAuthHeaderValue(authConfig.Username, authConfig.PersonalAccessToken)
+	} else if provider == nil {
+		connection = azuredevops.NewPatConnection(organizationURL, authConfig.PersonalAccessToken)
+	} else {
+		// the bearer token is added by the transport as it has to be refreshed while the provider runs
+		connection = azuredevops.NewAnonymousConnection(organizationURL)
+		transport = &bearerAuthTransport{
+			provider: &cachedTokenProvider{provider: provider},
+			base:     transport,
+		}
 	}
-
-	// client for these APIs:
-	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/git/?view=azure-devops-rest-5.1
-	gitReposClient, err := git.NewClient(ctx, connection)
+	limiter, err := newRequestLimiter(organizationURL, transportConfig)
 	if err != nil {
-		log.Printf("getAzdoClient(): git.NewClient failed.")
 		return nil, err
 	}
+	setUserAgent(connection, tfVersion)
+	factory := &clientFactory{
+		ctx:        ctx,
+		connection: connection,
+		transport:  newRetryTransport(transport, transportConfig),
+		limiter:    limiter,
+	}
 
-	//  https://docs.microsoft.com/en-us/rest/api/azure/devops/graph/?view=azure-devops-rest-5.1
-	graphClient, err := graph.NewClient(ctx, connection)
+	serverInfo, err := getServerInfo(ctx, factory.clientByURL("", connection.BaseUrl), connection.BaseUrl)
 	if err != nil {
-		log.Printf("getAzdoClient(): graph.NewClient failed.")
 		return nil, err
 	}
+	log.Printf("[DEBUG] Connected to %s", serverInfo)
+	factory.onPremises = serverInfo.IsOnPremises()
 
-	memberentitlementmanagementClient, err := memberentitlementmanagement.NewClient(ctx, connection)
-	if err != nil {
-		log.Printf("getAzdoClient(): memberentitlementmanagement.NewClient failed.")
-		return nil, err
+	// the clients of the API areas are created by their first request
+	aggregatedClient := &AggregatedClient{
+		OrganizationURL: organizationURL,
+		// client for these APIs (includes CRUD for AzDO projects...):
+		//	https://docs.microsoft.com/en-us/rest/api/azure/devops/core/?view=azure-devops-rest-5.1
+		CoreClient: &lazyCoreClient{lazyClient{create: factory.byResourceArea("core", core.ResourceAreaId, func(c azuredevops.Client) interface{} {
+			return &core.ClientImpl{Client: c}
+		})}},
+		// client for these APIs (includes CRUD for AzDO build pipelines...):
+		//	https://docs.microsoft.com/en-us/rest/api/azure/devops/build/?view=azure-devops-rest-5.1
+		BuildClient: &lazyBuildClient{lazyClient{create: factory.byResourceArea("build", build.ResourceAreaId, func(c azuredevops.Client) interface{} {
+			return &build.ClientImpl{Client: c}
+		})}},
+		//	https://docs.microsoft.com/en-us/rest/api/azure/devops/git/?view=azure-devops-rest-5.1
+		GitReposClient: &lazyGitClient{lazyClient{create: factory.byResourceArea("git", git.ResourceAreaId, func(c azuredevops.Client) interface{} {
+			return &git.ClientImpl{Client: c}
+		})}},
+		//  https://docs.microsoft.com/en-us/rest/api/azure/devops/graph/?view=azure-devops-rest-5.1
+		GraphClient: &lazyGraphClient{lazyClient{create: factory.byResourceArea("graph", graph.ResourceAreaId, func(c azuredevops.Client) interface{} {
+			return &graph.ClientImpl{Client: c}
+		})}},
+		// client for these APIs (monitor async operations...):
+		//	https://docs.microsoft.com/en-us/rest/api/azure/devops/operations/operations?view=azure-devops-rest-5.1
+		OperationsClient: &lazyOperationsClient{lazyClient{create: factory.byURL("operations", func(c azuredevops.Client) interface{} {
+			return &operations.ClientImpl{Client: c}
+		})}},
+		// https://docs.microsoft.com/en-us/rest/api/azure/devops/policy/configurations/create?view=azure-devops-rest-5.1
+		PolicyClient: &lazyPolicyClient{lazyClient{create: factory.byResourceArea("policy", policy.ResourceAreaId, func(c azuredevops.Client) interface{} {
+			return &policy.ClientImpl{Client: c}
+		})}},
+		// client for these APIs (includes CRUD for AzDO release pipelines...):
+		//	https://docs.microsoft.com/en-us/rest/api/azure/devops/release/?view=azure-devops-rest-5.1
+		ReleaseClient: &lazyReleaseClient{lazyClient{create: factory.byResourceArea("release", release.ResourceAreaId, func(c azuredevops.Client) interface{} {
+			return &release.ClientImpl{Client: c}
+		})}},
+		// client for these APIs (includes CRUD for AzDO service endpoints a.k.a. service connections...):
+		//  https://docs.microsoft.com/en-us/rest/api/azure/devops/serviceendpoint/endpoints?view=azure-devops-rest-5.1
+		ServiceEndpointClient: &lazyServiceendpointClient{lazyClient{create: factory.byResourceArea("serviceendpoint", serviceendpoint.ResourceAreaId, func(c azuredevops.Client) interface{} {
+			return &serviceendpoint.ClientImpl{Client: c}
+		})}},
+		// client for these APIs (includes CRUD for AzDO variable groups):
+		TaskAgentClient: &lazyTaskagentClient{lazyClient{create: factory.byResourceArea("taskagent", taskagent.ResourceAreaId, func(c azuredevops.Client) interface{} {
+			return &taskagent.ClientImpl{Client: c}
+		})}},
+		MemberEntitleManagementClient: &lazyMemberentitlementmanagementClient{lazyClient{create: factory.byResourceArea("memberentitlementmanagement", memberentitlementmanagement.ResourceAreaId, func(c azuredevops.Client) interface{} {
+			return &memberentitlementmanagement.ClientImpl{Client: c}
+		})}},
+		FeatureManagementClient: &lazyFeaturemanagementClient{lazyClient{create: factory.byURL("featuremanagement", func(c azuredevops.Client) interface{} {
+			return &featuremanagement.ClientImpl{Client: c}
+		})}},
+		SecurityClient: &lazySecurityClient{lazyClient{create: factory.byURL("security", func(c azuredevops.Client) interface{} {
+			return &security.ClientImpl{Client: c}
+		})}},
+		IdentityClient: &lazyIdentityClient{lazyClient{create: factory.byResourceArea("identity", identity.ResourceAreaId, func(c azuredevops.Client) interface{} {
+			return &identity.ClientImpl{Client: c}
+		})}},
+		WorkItemTrackingClient: &lazyWorkitemtrackingClient{lazyClient{create: factory.byResourceArea("workitemtracking", workitemtracking.ResourceAreaId, func(c azuredevops.Client) interface{} {
+			return &workitemtracking.ClientImpl{Client: c}
+		})}},
+		RequestLimiter: limiter,
+		ServerInfo:     serverInfo,
+		Ctx:            ctx,
 	}
 
-	// https://docs.microsoft.com/en-us/rest/api/azure/devops/policy/configurations/create?view=azure-devops-rest-5.1
-	policyClient, err := policy.NewClient(ctx, connection)
-	if err != nil {
-		log.Printf("getAzdoClient(): policy.NewClient failed.")
-		return nil, err
+	if transportConfig.IdentityCacheTTL > 0 {
+		// the identities are shared by all resources of the organization
+		cache := newIdentityCache(transportConfig.IdentityCacheTTL)
+		aggregatedClient.GraphClient = &cachedGraphClient{Client: aggregatedClient.GraphClient, cache: cache}
+		aggregatedClient.IdentityClient = &cachedIdentityClient{Client: aggregatedClient.IdentityClient, cache: cache}
 	}
 
-	// client for these APIs (includes CRUD for AzDO release pipelines...):
-	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/release/?view=azure-devops-rest-5.1
-	releaseClient, err := release.NewClient(ctx, connection)
-	if err != nil {
-		log.Printf("getAzdoClient(): release.NewClient failed.")
-		return nil, err
+	log.Printf("getAzdoClient(): Created the clients, the clients of the API areas are created by their first request")
+	return aggregatedClient, nil
+}
+
+// clientFactory creates the clients of the Azure DevOps SDK with the http transport of the provider,
+// the SDK itself always creates its clients with a default http client
+type clientFactory struct {
+	ctx           context.Context
+	connection    *azuredevops.Connection
+	transport     http.RoundTripper
+	limiter       *RequestLimiter
+	onPremises    bool
+	lock          sync.Mutex
+	resourceAreas map[uuid.UUID]string
+}
+
+// byResourceArea returns a function that creates the client of the API area located by the resource area,
+// newClient wraps the client of the area into the client of the SDK package
+func (f *clientFactory) byResourceArea(area string, resourceAreaID uuid.UUID, newClient func(azuredevops.Client) interface{}) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		client, err := f.clientByResourceArea(area, resourceAreaID)
+		if err != nil {
+			log.Printf("getAzdoClient(): %s.NewClient failed.", area)
+			return nil, err
+		}
+		return newClient(*client), nil
 	}
+}
 
-	securityClient := security.NewClient(ctx, connection)
-	identityClient, err := identity.NewClient(ctx, connection)
-	if err != nil {
-		log.Printf("getAzdoClient(): identity.NewClient failed.")
-		return nil, err
+// byURL returns a function that creates the client of the API area served by the organization URL
+func (f *clientFactory) byURL(area string, newClient func(azuredevops.Client) interface{}) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		return newClient(*f.clientByURL(area, f.connection.BaseUrl)), nil
 	}
+}
 
-	featuremanagementClient := featuremanagement.NewClient(ctx, connection)
+// clientByResourceArea returns a client for the location of the resource area, see azuredevops.Connection.GetClientByResourceAreaId
+func (f *clientFactory) clientByResourceArea(area string, resourceAreaID uuid.UUID) (*azuredevops.Client, error) {
+	// the API areas share the resource areas, they are looked up again if the lookup failed
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.resourceAreas == nil {
+		resourceAreaInfos, err := f.clientByURL("", f.connection.BaseUrl).GetResourceAreas(f.ctx)
+		var locationErr *azuredevops.LocationIdNotRegisteredError
+		if f.onPremises && errors.As(err, &locationErr) {
+			// older servers do not provide the resource areas, all APIs are served by the collection
+			resourceAreaInfos, err = &[]azuredevops.ResourceAreaInfo{}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		f.resourceAreas = map[uuid.UUID]string{}
+		for _, resourceArea := range *resourceAreaInfos {
+			if resourceArea.Id != nil && resourceArea.LocationUrl != nil {
+				f.resourceAreas[*resourceArea.Id] = *resourceArea.LocationUrl
+			}
+		}
+	}
 
-	workitemtrackingClient, err := workitemtracking.NewClient(ctx, connection)
-	if err != nil {
-		log.Printf("getAzdoClient(): workitemtracking.NewClient failed.")
-		return nil, err
+	// on prem servers return an empty list
+	if len(f.resourceAreas) == 0 {
+		return f.clientByURL(area, f.connection.BaseUrl), nil
+	}
+	locationURL, ok := f.resourceAreas[resourceAreaID]
+	if !ok && f.onPremises {
+		// the resources using the area fail with an unsupported API error once they send a request
+		return f.clientByURL(area, f.connection.BaseUrl), nil
 	}
+	if !ok {
+		return nil, &azuredevops.ResourceAreaIdNotRegisteredError{ResourceAreaId: resourceAreaID, Url: f.connection.BaseUrl}
+	}
+	return f.clientByURL(area, locationURL), nil
+}
 
-	aggregatedClient := &AggregatedClient{
-		OrganizationURL:               organizationURL,
-		CoreClient:                    coreClient,
-		BuildClient:                   buildClient,
-		GitReposClient:                gitReposClient,
-		GraphClient:                   graphClient,
-		OperationsClient:              operationsClient,
-		PolicyClient:                  policyClient,
-		ReleaseClient:                 releaseClient,
-		ServiceEndpointClient:         serviceEndpointClient,
-		TaskAgentClient:               taskagentClient,
-		MemberEntitleManagementClient: memberentitlementmanagementClient,
-		FeatureManagementClient:       featuremanagementClient,
-		SecurityClient:                securityClient,
-		IdentityClient:                identityClient,
-		WorkItemTrackingClient:        workitemtrackingClient,
-		Ctx:                           ctx,
-	}
-
-	log.Printf("getAzdoClient(): Created core, build, operations, and serviceendpoint clients successfully!")
-	return aggregatedClient, nil
+// clientByURL returns a client whose requests count against the limits of the API area
+func (f *clientFactory) clientByURL(area string, baseURL string) *azuredevops.Client {
+	httpClient := &http.Client{
+		Transport: &limitedTransport{limiter: f.limiter, area: area, base: f.transport},
+	}
+	return azuredevops.NewClientWithOptions(f.connection, strings.ToLower(strings.TrimRight(baseURL, "/")), azuredevops.WithHTTPClient(httpClient))
 }
 
 // setUserAgent set UserAgent for http headers
@@ -0,0 +1,227 @@
+//go:build ignore
+// +build ignore
+
+// gen_lazyclient generates lazyclient_generated.go, the clients of the API areas that create the client
+// of the SDK when their first request is sent. Run it with go generate after the SDK is updated.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const sdkPath = "github.com/microsoft/azure-devops-go-api/azuredevops/v6/"
+
+// the API areas of the AggregatedClient
+var areas = []string{
+	"build",
+	"core",
+	"featuremanagement",
+	"git",
+	"graph",
+	"identity",
+	"memberentitlementmanagement",
+	"operations",
+	"policy",
+	"release",
+	"security",
+	"serviceendpoint",
+	"taskagent",
+	"workitemtracking",
+}
+
+var versionElement = regexp.MustCompile(`^v[0-9]+$`)
+
+func main() {
+	imports := map[string]string{"context": "context"}
+	var body bytes.Buffer
+	for _, area := range areas {
+		if err := generateArea(&body, area, imports); err != nil {
+			log.Fatalf("generating the lazy client of %s: %v", area, err)
+		}
+	}
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by gen_lazyclient.go. DO NOT EDIT.\n\npackage client\n\nimport (\n")
+	var standardPaths []string
+	var paths []string
+	for importPath := range imports {
+		if strings.Contains(strings.Split(importPath, "/")[0], ".") {
+			paths = append(paths, importPath)
+		} else {
+			standardPaths = append(standardPaths, importPath)
+		}
+	}
+	sort.Strings(standardPaths)
+	sort.Strings(paths)
+	for _, importPath := range standardPaths {
+		fmt.Fprintf(&out, "\t%q\n", importPath)
+	}
+	out.WriteString("\n")
+	for _, importPath := range paths {
+		fmt.Fprintf(&out, "\t%q\n", importPath)
+	}
+	out.WriteString(")\n")
+	out.Write(body.Bytes())
+
+	source, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatalf("formatting the lazy clients: %v", err)
+	}
+	if err := ioutil.WriteFile("lazyclient_generated.go", source, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generateArea writes the lazy client of the area, it implements the Client interface of the SDK package
+func generateArea(w *bytes.Buffer, area string, imports map[string]string) error {
+	dir, err := exec.Command("go", "list", "-f", "{{.Dir}}", sdkPath+area).Output()
+	if err != nil {
+		return err
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filepath.Join(strings.TrimSpace(string(dir)), "client.go"), nil, 0)
+	if err != nil {
+		return err
+	}
+
+	fileImports := map[string]string{}
+	for _, spec := range file.Imports {
+		importPath := strings.Trim(spec.Path.Value, `"`)
+		name := packageName(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		fileImports[name] = importPath
+	}
+
+	iface := findClientInterface(file)
+	if iface == nil {
+		return fmt.Errorf("the package has no Client interface")
+	}
+
+	typeName := "lazy" + strings.Title(area) + "Client"
+	imports[sdkPath+area] = area
+	fmt.Fprintf(w, "\n// %s creates the %s client of the SDK on its first request\n", typeName, area)
+	fmt.Fprintf(w, "type %s struct {\n\tlazyClient\n}\n\n", typeName)
+	fmt.Fprintf(w, "func (c *%s) client() (%s.Client, error) {\n", typeName, area)
+	w.WriteString("\tclient, err := c.unwrap()\n\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+	fmt.Fprintf(w, "\treturn client.(%s.Client), nil\n}\n", area)
+
+	for _, method := range iface.Methods.List {
+		funcType, ok := method.Type.(*ast.FuncType)
+		if !ok || len(method.Names) != 1 {
+			return fmt.Errorf("unexpected interface member at %s", fset.Position(method.Pos()))
+		}
+		var params []string
+		var args []string
+		for i, param := range funcType.Params.List {
+			name := "args"
+			if i == 0 {
+				name = "ctx"
+			}
+			params = append(params, name+" "+qualify(fset, param.Type, area, fileImports, imports))
+			args = append(args, name)
+		}
+		var results []string
+		var zeroResults []string
+		for _, result := range funcType.Results.List {
+			results = append(results, qualify(fset, result.Type, area, fileImports, imports))
+			if ident, ok := result.Type.(*ast.Ident); ok && ident.Name == "error" {
+				zeroResults = append(zeroResults, "err")
+			} else {
+				zeroResults = append(zeroResults, "nil")
+			}
+		}
+
+		name := method.Names[0].Name
+		fmt.Fprintf(w, "\nfunc (c *%s) %s(%s) (%s) {\n", typeName, name, strings.Join(params, ", "), strings.Join(results, ", "))
+		w.WriteString("\tclient, err := c.client()\n")
+		fmt.Fprintf(w, "\tif err != nil {\n\t\treturn %s\n\t}\n", strings.Join(zeroResults, ", "))
+		fmt.Fprintf(w, "\treturn client.%s(%s)\n}\n", name, strings.Join(args, ", "))
+	}
+	return nil
+}
+
+func findClientInterface(file *ast.File) *ast.InterfaceType {
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			if iface, ok := typeSpec.Type.(*ast.InterfaceType); ok && typeSpec.Name.Name == "Client" {
+				return iface
+			}
+		}
+	}
+	return nil
+}
+
+// qualify prints the type of the SDK package as it is used outside of the package
+// and records the imports it needs
+func qualify(fset *token.FileSet, expr ast.Expr, area string, fileImports map[string]string, imports map[string]string) string {
+	expr = qualifyExpr(expr, area, fileImports, imports)
+	var out bytes.Buffer
+	if err := printer.Fprint(&out, fset, expr); err != nil {
+		log.Fatal(err)
+	}
+	return out.String()
+}
+
+func qualifyExpr(expr ast.Expr, area string, fileImports map[string]string, imports map[string]string) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(e.Name) {
+			return &ast.SelectorExpr{X: ast.NewIdent(area), Sel: e}
+		}
+		return e
+	case *ast.SelectorExpr:
+		name := e.X.(*ast.Ident).Name
+		importPath, ok := fileImports[name]
+		if !ok {
+			log.Fatalf("unknown package %s in %s", name, area)
+		}
+		if previous, ok := imports[importPath]; ok && previous != name {
+			log.Fatalf("the package %s is imported as %s and %s", importPath, previous, name)
+		}
+		imports[importPath] = name
+		return e
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualifyExpr(e.X, area, fileImports, imports)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: qualifyExpr(e.Elt, area, fileImports, imports)}
+	case *ast.MapType:
+		return &ast.MapType{
+			Key:   qualifyExpr(e.Key, area, fileImports, imports),
+			Value: qualifyExpr(e.Value, area, fileImports, imports),
+		}
+	case *ast.InterfaceType:
+		return e
+	}
+	log.Fatalf("unsupported type %T in %s", expr, area)
+	return nil
+}
+
+// packageName returns the name of the package of an import path, the SDK root package is versioned
+func packageName(importPath string) string {
+	name := path.Base(importPath)
+	if versionElement.MatchString(name) {
+		name = path.Base(path.Dir(importPath))
+	}
+	return name
+}
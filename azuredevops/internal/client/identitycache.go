@@ -0,0 +1,286 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/identity"
+)
+
+// DefaultIdentityCacheTTL is the time the resolved identities, descriptors and storage keys are cached
+const DefaultIdentityCacheTTL = 5 * time.Minute
+
+// the kinds of keys an identity is cached by
+const (
+	identityByDescriptor        = "descriptor"
+	identityBySubjectDescriptor = "subjectDescriptor"
+	identityByID                = "id"
+	storageKeyBySubject         = "storageKey"
+	descriptorByStorageKey      = "graphDescriptor"
+)
+
+// identityCache holds the identities, subject descriptors and storage keys resolved by the resources.
+// The descriptors and storage keys of a subject never change, the identities are refreshed after the TTL.
+type identityCache struct {
+	lock    sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[string]identityCacheEntry
+}
+
+type identityCacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+func newIdentityCache(ttl time.Duration) *identityCache {
+	return &identityCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[string]identityCacheEntry{},
+	}
+}
+
+func identityCacheKey(kind string, key string) string {
+	// descriptors are compared case insensitive by Azure DevOps
+	return kind + ":" + strings.ToLower(key)
+}
+
+func (c *identityCache) get(kind string, key string) (interface{}, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.entries[identityCacheKey(kind, key)]
+	if !ok || c.now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *identityCache) set(kind string, key string, value interface{}) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.entries[identityCacheKey(kind, key)] = identityCacheEntry{value: value, expires: c.now().Add(c.ttl)}
+}
+
+func (c *identityCache) clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.entries = map[string]identityCacheEntry{}
+}
+
+// setIdentity caches the identity by all of its keys, the storage key of a subject is the ID of its identity
+func (c *identityCache) setIdentity(value identity.Identity) {
+	if value.Descriptor != nil {
+		c.set(identityByDescriptor, *value.Descriptor, value)
+	}
+	if value.SubjectDescriptor != nil {
+		c.set(identityBySubjectDescriptor, *value.SubjectDescriptor, value)
+	}
+	if value.Id != nil {
+		c.set(identityByID, value.Id.String(), value)
+	}
+	if value.SubjectDescriptor != nil && value.Id != nil {
+		c.set(storageKeyBySubject, *value.SubjectDescriptor, *value.Id)
+		c.set(descriptorByStorageKey, value.Id.String(), *value.SubjectDescriptor)
+	}
+}
+
+// cachedIdentityClient resolves the identities of descriptors and IDs from the cache
+type cachedIdentityClient struct {
+	identity.Client
+	cache *identityCache
+}
+
+func (c *cachedIdentityClient) unwrap() (interface{}, error) {
+	return c.Client, nil
+}
+
+func (c *cachedIdentityClient) ReadIdentities(ctx context.Context, args identity.ReadIdentitiesArgs) (*[]identity.Identity, error) {
+	kind, keys := cachedIdentityLookup(args)
+	if kind == "" {
+		return c.Client.ReadIdentities(ctx, args)
+	}
+
+	var missing []string
+	for _, key := range keys {
+		if _, ok := c.cache.get(kind, key); !ok {
+			missing = append(missing, key)
+		}
+	}
+
+	// the identities that are not cached are read with a single request
+	fetched := map[string]identity.Identity{}
+	if len(missing) > 0 {
+		query := args
+		setIdentityLookup(&query, kind, strings.Join(missing, ","))
+		identities, err := c.Client.ReadIdentities(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		if identities != nil {
+			requested := map[string]bool{}
+			for _, key := range missing {
+				requested[strings.ToLower(key)] = true
+			}
+			for i, value := range *identities {
+				key := identityLookupKey(value, kind)
+				if !requested[strings.ToLower(key)] && len(*identities) == len(missing) {
+					// the server answers in the order of the request, unknown identities are empty
+					key = missing[i]
+					if value.Id != nil {
+						c.cache.set(kind, key, value)
+					}
+				}
+				c.cache.setIdentity(value)
+				if key != "" {
+					fetched[strings.ToLower(key)] = value
+				}
+			}
+		}
+	}
+
+	// the identities are returned in the order of the request, unknown identities are left out
+	identities := []identity.Identity{}
+	for _, key := range keys {
+		if value, ok := fetched[strings.ToLower(key)]; ok {
+			identities = append(identities, value)
+		} else if value, ok := c.cache.get(kind, key); ok {
+			identities = append(identities, value.(identity.Identity))
+		}
+	}
+	return &identities, nil
+}
+
+// cachedIdentityLookup returns the kind of key and the keys of a request that can be served from the cache,
+// requests searching for identities or reading their memberships or properties are not cached
+func cachedIdentityLookup(args identity.ReadIdentitiesArgs) (string, []string) {
+	if args.SearchFilter != nil || args.FilterValue != nil || args.Properties != nil || args.SocialDescriptors != nil {
+		return "", nil
+	}
+	if args.QueryMembership != nil && *args.QueryMembership != identity.QueryMembershipValues.None {
+		return "", nil
+	}
+
+	var kind string
+	var list *string
+	for lookupKind, lookupList := range map[string]*string{
+		identityByDescriptor:        args.Descriptors,
+		identityBySubjectDescriptor: args.SubjectDescriptors,
+		identityByID:                args.IdentityIds,
+	} {
+		if lookupList == nil {
+			continue
+		}
+		if list != nil {
+			// a request by several kinds of keys is passed on unchanged
+			return "", nil
+		}
+		kind, list = lookupKind, lookupList
+	}
+	if list == nil {
+		return "", nil
+	}
+
+	var keys []string
+	for _, key := range strings.Split(*list, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return "", nil
+	}
+	return kind, keys
+}
+
+func setIdentityLookup(args *identity.ReadIdentitiesArgs, kind string, list string) {
+	switch kind {
+	case identityByDescriptor:
+		args.Descriptors = &list
+	case identityBySubjectDescriptor:
+		args.SubjectDescriptors = &list
+	case identityByID:
+		args.IdentityIds = &list
+	}
+}
+
+func identityLookupKey(value identity.Identity, kind string) string {
+	switch kind {
+	case identityByDescriptor:
+		if value.Descriptor != nil {
+			return *value.Descriptor
+		}
+	case identityBySubjectDescriptor:
+		if value.SubjectDescriptor != nil {
+			return *value.SubjectDescriptor
+		}
+	case identityByID:
+		if value.Id != nil {
+			return value.Id.String()
+		}
+	}
+	return ""
+}
+
+// cachedGraphClient resolves the storage keys and descriptors of subjects from the cache
+type cachedGraphClient struct {
+	graph.Client
+	cache *identityCache
+}
+
+func (c *cachedGraphClient) unwrap() (interface{}, error) {
+	return c.Client, nil
+}
+
+func (c *cachedGraphClient) GetStorageKey(ctx context.Context, args graph.GetStorageKeyArgs) (*graph.GraphStorageKeyResult, error) {
+	if args.SubjectDescriptor == nil {
+		return c.Client.GetStorageKey(ctx, args)
+	}
+	if value, ok := c.cache.get(storageKeyBySubject, *args.SubjectDescriptor); ok {
+		storageKey := value.(uuid.UUID)
+		return &graph.GraphStorageKeyResult{Value: &storageKey}, nil
+	}
+
+	result, err := c.Client.GetStorageKey(ctx, args)
+	if err == nil && result != nil && result.Value != nil {
+		c.cache.set(storageKeyBySubject, *args.SubjectDescriptor, *result.Value)
+		c.cache.set(descriptorByStorageKey, result.Value.String(), *args.SubjectDescriptor)
+	}
+	return result, err
+}
+
+func (c *cachedGraphClient) GetDescriptor(ctx context.Context, args graph.GetDescriptorArgs) (*graph.GraphDescriptorResult, error) {
+	if args.StorageKey == nil {
+		return c.Client.GetDescriptor(ctx, args)
+	}
+	if value, ok := c.cache.get(descriptorByStorageKey, args.StorageKey.String()); ok {
+		descriptor := value.(string)
+		return &graph.GraphDescriptorResult{Value: &descriptor}, nil
+	}
+
+	result, err := c.Client.GetDescriptor(ctx, args)
+	if err == nil && result != nil && result.Value != nil {
+		c.cache.set(descriptorByStorageKey, args.StorageKey.String(), *result.Value)
+		c.cache.set(storageKeyBySubject, *result.Value, *args.StorageKey)
+	}
+	return result, err
+}
+
+// the identities of deleted subjects must not be resolved from the cache anymore
+
+func (c *cachedGraphClient) DeleteGroup(ctx context.Context, args graph.DeleteGroupArgs) error {
+	defer c.cache.clear()
+	return c.Client.DeleteGroup(ctx, args)
+}
+
+func (c *cachedGraphClient) DeleteUser(ctx context.Context, args graph.DeleteUserArgs) error {
+	defer c.cache.clear()
+	return c.Client.DeleteUser(ctx, args)
+}
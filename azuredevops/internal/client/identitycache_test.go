@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/identity"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func testIdentity(subjectDescriptor string) identity.Identity {
+	id := uuid.New()
+	descriptor := "Microsoft.TeamFoundation.Identity;" + subjectDescriptor
+	return identity.Identity{Id: &id, Descriptor: &descriptor, SubjectDescriptor: &subjectDescriptor}
+}
+
+func subjectDescriptors(list string) identity.ReadIdentitiesArgs {
+	return identity.ReadIdentitiesArgs{SubjectDescriptors: &list}
+}
+
+// verifies that only the identities that are not cached are read
+func TestIdentityCache_ReadIdentities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	first, second := testIdentity("aad.first"), testIdentity("aad.second")
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	identityClient.EXPECT().ReadIdentities(gomock.Any(), subjectDescriptors("aad.first")).Return(&[]identity.Identity{first}, nil).Times(1)
+	identityClient.EXPECT().ReadIdentities(gomock.Any(), subjectDescriptors("aad.second")).Return(&[]identity.Identity{second}, nil).Times(1)
+
+	cached := &cachedIdentityClient{Client: identityClient, cache: newIdentityCache(time.Minute)}
+	identities, err := cached.ReadIdentities(context.Background(), subjectDescriptors("aad.first"))
+	require.Nil(t, err)
+	require.Equal(t, []identity.Identity{first}, *identities)
+
+	identities, err = cached.ReadIdentities(context.Background(), subjectDescriptors("aad.second,AAD.FIRST"))
+	require.Nil(t, err)
+	require.Equal(t, []identity.Identity{second, first}, *identities)
+
+	identities, err = cached.ReadIdentities(context.Background(), identity.ReadIdentitiesArgs{IdentityIds: converter.String(first.Id.String())})
+	require.Nil(t, err)
+	require.Equal(t, []identity.Identity{first}, *identities)
+}
+
+func TestIdentityCache_ReadIdentities_Expires(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	first := testIdentity("aad.first")
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	identityClient.EXPECT().ReadIdentities(gomock.Any(), subjectDescriptors("aad.first")).Return(&[]identity.Identity{first}, nil).Times(2)
+
+	cache := newIdentityCache(time.Minute)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	cached := &cachedIdentityClient{Client: identityClient, cache: cache}
+
+	_, err := cached.ReadIdentities(context.Background(), subjectDescriptors("aad.first"))
+	require.Nil(t, err)
+	now = now.Add(2 * time.Minute)
+	_, err = cached.ReadIdentities(context.Background(), subjectDescriptors("aad.first"))
+	require.Nil(t, err)
+}
+
+func TestIdentityCache_ReadIdentities_MembershipNotCached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	args := subjectDescriptors("vssgp.group")
+	args.QueryMembership = &identity.QueryMembershipValues.Direct
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	identityClient.EXPECT().ReadIdentities(gomock.Any(), args).Return(&[]identity.Identity{testIdentity("vssgp.group")}, nil).Times(2)
+
+	cached := &cachedIdentityClient{Client: identityClient, cache: newIdentityCache(time.Minute)}
+	for i := 0; i < 2; i++ {
+		_, err := cached.ReadIdentities(context.Background(), args)
+		require.Nil(t, err)
+	}
+}
+
+// verifies that the storage keys and descriptors of subjects resolve each other from the cache
+func TestIdentityCache_StorageKeyAndDescriptor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storageKey := uuid.New()
+	descriptor := "vssgp.group"
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	graphClient.EXPECT().
+		GetStorageKey(gomock.Any(), graph.GetStorageKeyArgs{SubjectDescriptor: &descriptor}).
+		Return(&graph.GraphStorageKeyResult{Value: &storageKey}, nil).
+		Times(1)
+
+	cached := &cachedGraphClient{Client: graphClient, cache: newIdentityCache(time.Minute)}
+	for i := 0; i < 2; i++ {
+		result, err := cached.GetStorageKey(context.Background(), graph.GetStorageKeyArgs{SubjectDescriptor: &descriptor})
+		require.Nil(t, err)
+		require.Equal(t, storageKey, *result.Value)
+	}
+	result, err := cached.GetDescriptor(context.Background(), graph.GetDescriptorArgs{StorageKey: &storageKey})
+	require.Nil(t, err)
+	require.Equal(t, descriptor, *result.Value)
+
+	graphClient.EXPECT().DeleteGroup(gomock.Any(), graph.DeleteGroupArgs{GroupDescriptor: &descriptor}).Return(nil).Times(1)
+	graphClient.EXPECT().
+		GetStorageKey(gomock.Any(), graph.GetStorageKeyArgs{SubjectDescriptor: &descriptor}).
+		Return(&graph.GraphStorageKeyResult{Value: &storageKey}, nil).
+		Times(1)
+	require.Nil(t, cached.DeleteGroup(context.Background(), graph.DeleteGroupArgs{GroupDescriptor: &descriptor}))
+	_, err = cached.GetStorageKey(context.Background(), graph.GetStorageKeyArgs{SubjectDescriptor: &descriptor})
+	require.Nil(t, err)
+}
@@ -0,0 +1,27 @@
+package client
+
+import "sync"
+
+//go:generate go run gen_lazyclient.go
+
+// lazyClient creates the client of the SDK of an API area when the first request of the area is sent, the
+// resource areas of the organization are only looked up if a resource uses an API area located by them.
+// A client that failed to be created is not kept so that the next request tries again.
+type lazyClient struct {
+	lock   sync.Mutex
+	create func() (interface{}, error)
+	sdk    interface{}
+}
+
+func (c *lazyClient) unwrap() (interface{}, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.sdk == nil {
+		sdk, err := c.create()
+		if err != nil {
+			return nil, err
+		}
+		c.sdk = sdk
+	}
+	return c.sdk, nil
+}
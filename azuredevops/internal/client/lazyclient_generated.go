@@ -0,0 +1,5267 @@
+// Code generated by gen_lazyclient.go. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/delegatedauthorization"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/featuremanagement"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/memberentitlementmanagement"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/operations"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/policy"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/profile"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/release"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/security"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtracking"
+)
+
+// lazyBuildClient creates the build client of the SDK on its first request
+type lazyBuildClient struct {
+	lazyClient
+}
+
+func (c *lazyBuildClient) client() (build.Client, error) {
+	client, err := c.unwrap()
+	if err != nil {
+		return nil, err
+	}
+	return client.(build.Client), nil
+}
+
+func (c *lazyBuildClient) AddBuildTag(ctx context.Context, args build.
+	AddBuildTagArgs) (*[]string, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AddBuildTag(ctx, args)
+}
+
+func (c *lazyBuildClient) AddBuildTags(ctx context.Context, args build.
+	AddBuildTagsArgs) (*[]string, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AddBuildTags(ctx, args)
+}
+
+func (c *lazyBuildClient) AddDefinitionTag(ctx context.Context, args build.
+	AddDefinitionTagArgs) (*[]string, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AddDefinitionTag(ctx, args)
+}
+
+func (c *lazyBuildClient) AddDefinitionTags(ctx context.Context, args build.
+	AddDefinitionTagsArgs) (*[]string, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AddDefinitionTags(ctx, args)
+}
+
+func (c *lazyBuildClient) AddRetentionLeases(ctx context.Context, args build.
+	AddRetentionLeasesArgs) (*[]build.
+	RetentionLease, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AddRetentionLeases(ctx, args)
+}
+
+func (c *lazyBuildClient) AuthorizeDefinitionResources(ctx context.Context, args build.
+	AuthorizeDefinitionResourcesArgs) (*[]build.
+	DefinitionResourceReference, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AuthorizeDefinitionResources(ctx, args)
+}
+
+func (c *lazyBuildClient) AuthorizeProjectResources(ctx context.Context, args build.
+	AuthorizeProjectResourcesArgs) (*[]build.
+	DefinitionResourceReference, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AuthorizeProjectResources(ctx, args)
+}
+
+func (c *lazyBuildClient) CreateArtifact(ctx context.Context, args build.
+	CreateArtifactArgs) (*build.
+	BuildArtifact, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateArtifact(ctx, args)
+}
+
+func (c *lazyBuildClient) CreateDefinition(ctx context.Context, args build.
+	CreateDefinitionArgs) (*build.
+	BuildDefinition, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateDefinition(ctx, args)
+}
+
+func (c *lazyBuildClient) CreateFolder(ctx context.Context, args build.
+	CreateFolderArgs) (*build.
+	Folder, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateFolder(ctx, args)
+}
+
+func (c *lazyBuildClient) DeleteBuild(ctx context.Context, args build.
+	DeleteBuildArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteBuild(ctx, args)
+}
+
+func (c *lazyBuildClient) DeleteBuildTag(ctx context.Context, args build.
+	DeleteBuildTagArgs) (*[]string, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.DeleteBuildTag(ctx, args)
+}
+
+func (c *lazyBuildClient) DeleteDefinition(ctx context.Context, args build.
+	DeleteDefinitionArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteDefinition(ctx, args)
+}
+
+func (c *lazyBuildClient) DeleteDefinitionTag(ctx context.Context, args build.
+	DeleteDefinitionTagArgs) (*[]string, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.DeleteDefinitionTag(ctx, args)
+}
+
+func (c *lazyBuildClient) DeleteFolder(ctx context.Context, args build.
+	DeleteFolderArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteFolder(ctx, args)
+}
+
+func (c *lazyBuildClient) DeleteRetentionLeasesById(ctx context.Context, args build.
+	DeleteRetentionLeasesByIdArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteRetentionLeasesById(ctx, args)
+}
+
+func (c *lazyBuildClient) DeleteTag(ctx context.Context, args build.
+	DeleteTagArgs) (*[]string, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.DeleteTag(ctx, args)
+}
+
+func (c *lazyBuildClient) DeleteTemplate(ctx context.Context, args build.
+	DeleteTemplateArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteTemplate(ctx, args)
+}
+
+func (c *lazyBuildClient) GetArtifact(ctx context.Context, args build.
+	GetArtifactArgs) (*build.
+	BuildArtifact, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetArtifact(ctx, args)
+}
+
+func (c *lazyBuildClient) GetArtifactContentZip(ctx context.Context, args build.
+	GetArtifactContentZipArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetArtifactContentZip(ctx, args)
+}
+
+func (c *lazyBuildClient) GetArtifacts(ctx context.Context, args build.
+	GetArtifactsArgs) (*[]build.
+	BuildArtifact, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetArtifacts(ctx, args)
+}
+
+func (c *lazyBuildClient) GetAttachment(ctx context.Context, args build.
+	GetAttachmentArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAttachment(ctx, args)
+}
+
+func (c *lazyBuildClient) GetAttachments(ctx context.Context, args build.
+	GetAttachmentsArgs) (*[]build.
+	Attachment, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAttachments(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuild(ctx context.Context, args build.
+	GetBuildArgs) (*build.
+	Build, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuild(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildBadge(ctx context.Context, args build.
+	GetBuildBadgeArgs) (*build.
+	BuildBadge, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildBadge(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildBadgeData(ctx context.Context, args build.
+	GetBuildBadgeDataArgs) (*string, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildBadgeData(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildChanges(ctx context.Context, args build.
+	GetBuildChangesArgs) (*build.
+	GetBuildChangesResponseValue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildChanges(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildController(ctx context.Context, args build.
+	GetBuildControllerArgs) (*build.
+	BuildController, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildController(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildControllers(ctx context.Context, args build.
+	GetBuildControllersArgs) (*[]build.
+	BuildController, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildControllers(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildGeneralSettings(ctx context.Context, args build.
+	GetBuildGeneralSettingsArgs) (*build.
+	PipelineGeneralSettings, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildGeneralSettings(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildLog(ctx context.Context, args build.
+	GetBuildLogArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildLog(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildLogLines(ctx context.Context, args build.
+	GetBuildLogLinesArgs) (*[]string, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildLogLines(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildLogs(ctx context.Context, args build.
+	GetBuildLogsArgs) (*[]build.
+	BuildLog, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildLogs(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildLogsZip(ctx context.Context, args build.
+	GetBuildLogsZipArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildLogsZip(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildLogZip(ctx context.Context, args build.
+	GetBuildLogZipArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildLogZip(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildOptionDefinitions(ctx context.Context, args build.
+	GetBuildOptionDefinitionsArgs) (*[]build.
+	BuildOptionDefinition, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildOptionDefinitions(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildProperties(ctx context.Context, args build.
+	GetBuildPropertiesArgs) (interface{}, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildProperties(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildReport(ctx context.Context, args build.
+	GetBuildReportArgs) (*build.
+	BuildReportMetadata, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildReport(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildReportHtmlContent(ctx context.Context, args build.
+	GetBuildReportHtmlContentArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildReportHtmlContent(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuilds(ctx context.Context, args build.
+	GetBuildsArgs) (*build.
+	GetBuildsResponseValue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuilds(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildSettings(ctx context.Context, args build.
+	GetBuildSettingsArgs) (*build.
+	BuildSettings, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildSettings(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildTags(ctx context.Context, args build.
+	GetBuildTagsArgs) (*[]string, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildTags(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildTimeline(ctx context.Context, args build.
+	GetBuildTimelineArgs) (*build.
+	Timeline, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildTimeline(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildWorkItemsRefs(ctx context.Context, args build.
+	GetBuildWorkItemsRefsArgs) (*[]webapi.ResourceRef, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildWorkItemsRefs(ctx, args)
+}
+
+func (c *lazyBuildClient) GetBuildWorkItemsRefsFromCommits(ctx context.Context, args build.
+	GetBuildWorkItemsRefsFromCommitsArgs) (*[]webapi.ResourceRef, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBuildWorkItemsRefsFromCommits(ctx, args)
+}
+
+func (c *lazyBuildClient) GetChangesBetweenBuilds(ctx context.Context, args build.
+	GetChangesBetweenBuildsArgs) (*[]build.
+	Change, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetChangesBetweenBuilds(ctx, args)
+}
+
+func (c *lazyBuildClient) GetDefinition(ctx context.Context, args build.
+	GetDefinitionArgs) (*build.
+	BuildDefinition, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDefinition(ctx, args)
+}
+
+func (c *lazyBuildClient) GetDefinitionMetrics(ctx context.Context, args build.
+	GetDefinitionMetricsArgs) (*[]build.
+	BuildMetric, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDefinitionMetrics(ctx, args)
+}
+
+func (c *lazyBuildClient) GetDefinitionProperties(ctx context.Context, args build.
+	GetDefinitionPropertiesArgs) (interface{}, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDefinitionProperties(ctx, args)
+}
+
+func (c *lazyBuildClient) GetDefinitionResources(ctx context.Context, args build.
+	GetDefinitionResourcesArgs) (*[]build.
+	DefinitionResourceReference, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDefinitionResources(ctx, args)
+}
+
+func (c *lazyBuildClient) GetDefinitionRevisions(ctx context.Context, args build.
+	GetDefinitionRevisionsArgs) (*[]build.
+	BuildDefinitionRevision, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDefinitionRevisions(ctx, args)
+}
+
+func (c *lazyBuildClient) GetDefinitions(ctx context.Context, args build.
+	GetDefinitionsArgs) (*build.
+	GetDefinitionsResponseValue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDefinitions(ctx, args)
+}
+
+func (c *lazyBuildClient) GetDefinitionTags(ctx context.Context, args build.
+	GetDefinitionTagsArgs) (*[]string, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDefinitionTags(ctx, args)
+}
+
+func (c *lazyBuildClient) GetFile(ctx context.Context, args build.
+	GetFileArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetFile(ctx, args)
+}
+
+func (c *lazyBuildClient) GetFileContents(ctx context.Context, args build.
+	GetFileContentsArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetFileContents(ctx, args)
+}
+
+func (c *lazyBuildClient) GetFolders(ctx context.Context, args build.
+	GetFoldersArgs) (*[]build.
+	Folder, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetFolders(ctx, args)
+}
+
+func (c *lazyBuildClient) GetLatestBuild(ctx context.Context, args build.
+	GetLatestBuildArgs) (*build.
+	Build, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetLatestBuild(ctx, args)
+}
+
+func (c *lazyBuildClient) GetPathContents(ctx context.Context, args build.
+	GetPathContentsArgs) (*[]build.
+	SourceRepositoryItem, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPathContents(ctx, args)
+}
+
+func (c *lazyBuildClient) GetProjectMetrics(ctx context.Context, args build.
+	GetProjectMetricsArgs) (*[]build.
+	BuildMetric, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetProjectMetrics(ctx, args)
+}
+
+func (c *lazyBuildClient) GetProjectResources(ctx context.Context, args build.
+	GetProjectResourcesArgs) (*[]build.
+	DefinitionResourceReference, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetProjectResources(ctx, args)
+}
+
+func (c *lazyBuildClient) GetPullRequest(ctx context.Context, args build.
+	GetPullRequestArgs) (*build.
+	PullRequest, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequest(ctx, args)
+}
+
+func (c *lazyBuildClient) GetResourceUsage(ctx context.Context, args build.
+	GetResourceUsageArgs) (*build.
+	BuildResourceUsage, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetResourceUsage(ctx, args)
+}
+
+func (c *lazyBuildClient) GetRetentionLease(ctx context.Context, args build.
+	GetRetentionLeaseArgs) (*build.
+	RetentionLease, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRetentionLease(ctx, args)
+}
+
+func (c *lazyBuildClient) GetRetentionLeasesByMinimalRetentionLeases(ctx context.Context, args build.
+	GetRetentionLeasesByMinimalRetentionLeasesArgs) (*[]build.
+	RetentionLease, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRetentionLeasesByMinimalRetentionLeases(ctx, args)
+}
+
+func (c *lazyBuildClient) GetRetentionLeasesByOwnerId(ctx context.Context, args build.
+	GetRetentionLeasesByOwnerIdArgs) (*[]build.
+	RetentionLease, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRetentionLeasesByOwnerId(ctx, args)
+}
+
+func (c *lazyBuildClient) GetRetentionLeasesByUserId(ctx context.Context, args build.
+	GetRetentionLeasesByUserIdArgs) (*[]build.
+	RetentionLease, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRetentionLeasesByUserId(ctx, args)
+}
+
+func (c *lazyBuildClient) GetRetentionSettings(ctx context.Context, args build.
+	GetRetentionSettingsArgs) (*build.
+	ProjectRetentionSetting, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRetentionSettings(ctx, args)
+}
+
+func (c *lazyBuildClient) GetStatusBadge(ctx context.Context, args build.
+	GetStatusBadgeArgs) (*string, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetStatusBadge(ctx, args)
+}
+
+func (c *lazyBuildClient) GetTags(ctx context.Context, args build.
+	GetTagsArgs) (*[]string, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTags(ctx, args)
+}
+
+func (c *lazyBuildClient) GetTemplate(ctx context.Context, args build.
+	GetTemplateArgs) (*build.
+	BuildDefinitionTemplate, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTemplate(ctx, args)
+}
+
+func (c *lazyBuildClient) GetTemplates(ctx context.Context, args build.
+	GetTemplatesArgs) (*[]build.
+	BuildDefinitionTemplate, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTemplates(ctx, args)
+}
+
+func (c *lazyBuildClient) GetWorkItemsBetweenBuilds(ctx context.Context, args build.
+	GetWorkItemsBetweenBuildsArgs) (*[]webapi.ResourceRef, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetWorkItemsBetweenBuilds(ctx, args)
+}
+
+func (c *lazyBuildClient) ListBranches(ctx context.Context, args build.
+	ListBranchesArgs) (*[]string, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ListBranches(ctx, args)
+}
+
+func (c *lazyBuildClient) ListRepositories(ctx context.Context, args build.
+	ListRepositoriesArgs) (*build.
+	SourceRepositories, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ListRepositories(ctx, args)
+}
+
+func (c *lazyBuildClient) ListSourceProviders(ctx context.Context, args build.
+	ListSourceProvidersArgs) (*[]build.
+	SourceProviderAttributes, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ListSourceProviders(ctx, args)
+}
+
+func (c *lazyBuildClient) ListWebhooks(ctx context.Context, args build.
+	ListWebhooksArgs) (*[]build.
+	RepositoryWebhook, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ListWebhooks(ctx, args)
+}
+
+func (c *lazyBuildClient) QueueBuild(ctx context.Context, args build.
+	QueueBuildArgs) (*build.
+	Build, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.QueueBuild(ctx, args)
+}
+
+func (c *lazyBuildClient) RestoreDefinition(ctx context.Context, args build.
+	RestoreDefinitionArgs) (*build.
+	BuildDefinition, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.RestoreDefinition(ctx, args)
+}
+
+func (c *lazyBuildClient) RestoreWebhooks(ctx context.Context, args build.
+	RestoreWebhooksArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.RestoreWebhooks(ctx, args)
+}
+
+func (c *lazyBuildClient) SaveTemplate(ctx context.Context, args build.
+	SaveTemplateArgs) (*build.
+	BuildDefinitionTemplate, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.SaveTemplate(ctx, args)
+}
+
+func (c *lazyBuildClient) UpdateBuild(ctx context.Context, args build.
+	UpdateBuildArgs) (*build.
+	Build, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateBuild(ctx, args)
+}
+
+func (c *lazyBuildClient) UpdateBuildGeneralSettings(ctx context.Context, args build.
+	UpdateBuildGeneralSettingsArgs) (*build.
+	PipelineGeneralSettings, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateBuildGeneralSettings(ctx, args)
+}
+
+func (c *lazyBuildClient) UpdateBuildProperties(ctx context.Context, args build.
+	UpdateBuildPropertiesArgs) (interface{}, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateBuildProperties(ctx, args)
+}
+
+func (c *lazyBuildClient) UpdateBuilds(ctx context.Context, args build.
+	UpdateBuildsArgs) (*[]build.
+	Build, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateBuilds(ctx, args)
+}
+
+func (c *lazyBuildClient) UpdateBuildSettings(ctx context.Context, args build.
+	UpdateBuildSettingsArgs) (*build.
+	BuildSettings, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateBuildSettings(ctx, args)
+}
+
+func (c *lazyBuildClient) UpdateDefinition(ctx context.Context, args build.
+	UpdateDefinitionArgs) (*build.
+	BuildDefinition, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateDefinition(ctx, args)
+}
+
+func (c *lazyBuildClient) UpdateDefinitionProperties(ctx context.Context, args build.
+	UpdateDefinitionPropertiesArgs) (interface{}, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateDefinitionProperties(ctx, args)
+}
+
+func (c *lazyBuildClient) UpdateFolder(ctx context.Context, args build.
+	UpdateFolderArgs) (*build.
+	Folder, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateFolder(ctx, args)
+}
+
+func (c *lazyBuildClient) UpdateRetentionSettings(ctx context.Context, args build.
+	UpdateRetentionSettingsArgs) (*build.
+	ProjectRetentionSetting, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateRetentionSettings(ctx, args)
+}
+
+func (c *lazyBuildClient) UpdateStage(ctx context.Context, args build.
+	UpdateStageArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.UpdateStage(ctx, args)
+}
+
+// lazyCoreClient creates the core client of the SDK on its first request
+type lazyCoreClient struct {
+	lazyClient
+}
+
+func (c *lazyCoreClient) client() (core.Client, error) {
+	client, err := c.unwrap()
+	if err != nil {
+		return nil, err
+	}
+	return client.(core.Client), nil
+}
+
+func (c *lazyCoreClient) CreateConnectedService(ctx context.Context, args core.
+	CreateConnectedServiceArgs) (*core.
+	WebApiConnectedService, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateConnectedService(ctx, args)
+}
+
+func (c *lazyCoreClient) CreateOrUpdateProxy(ctx context.Context, args core.
+	CreateOrUpdateProxyArgs) (*core.
+	Proxy, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateOrUpdateProxy(ctx, args)
+}
+
+func (c *lazyCoreClient) CreateTeam(ctx context.Context, args core.
+	CreateTeamArgs) (*core.
+	WebApiTeam, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateTeam(ctx, args)
+}
+
+func (c *lazyCoreClient) DeleteProxy(ctx context.Context, args core.
+	DeleteProxyArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteProxy(ctx, args)
+}
+
+func (c *lazyCoreClient) DeleteTeam(ctx context.Context, args core.
+	DeleteTeamArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteTeam(ctx, args)
+}
+
+func (c *lazyCoreClient) GetAllTeams(ctx context.Context, args core.
+	GetAllTeamsArgs) (*[]core.
+	WebApiTeam, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAllTeams(ctx, args)
+}
+
+func (c *lazyCoreClient) GetConnectedServiceDetails(ctx context.Context, args core.
+	GetConnectedServiceDetailsArgs) (*core.
+	WebApiConnectedServiceDetails, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetConnectedServiceDetails(ctx, args)
+}
+
+func (c *lazyCoreClient) GetConnectedServices(ctx context.Context, args core.
+	GetConnectedServicesArgs) (*[]core.
+	WebApiConnectedService, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetConnectedServices(ctx, args)
+}
+
+func (c *lazyCoreClient) GetProcessById(ctx context.Context, args core.
+	GetProcessByIdArgs) (*core.
+	Process, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetProcessById(ctx, args)
+}
+
+func (c *lazyCoreClient) GetProcesses(ctx context.Context, args core.
+	GetProcessesArgs) (*[]core.
+	Process, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetProcesses(ctx, args)
+}
+
+func (c *lazyCoreClient) GetProject(ctx context.Context, args core.
+	GetProjectArgs) (*core.
+	TeamProject, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetProject(ctx, args)
+}
+
+func (c *lazyCoreClient) GetProjectCollection(ctx context.Context, args core.
+	GetProjectCollectionArgs) (*core.
+	TeamProjectCollection, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetProjectCollection(ctx, args)
+}
+
+func (c *lazyCoreClient) GetProjectCollections(ctx context.Context, args core.
+	GetProjectCollectionsArgs) (*[]core.
+	TeamProjectCollectionReference, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetProjectCollections(ctx, args)
+}
+
+func (c *lazyCoreClient) GetProjectProperties(ctx context.Context, args core.
+	GetProjectPropertiesArgs) (*[]core.
+	ProjectProperty, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetProjectProperties(ctx, args)
+}
+
+func (c *lazyCoreClient) GetProjects(ctx context.Context, args core.
+	GetProjectsArgs) (*core.
+	GetProjectsResponseValue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetProjects(ctx, args)
+}
+
+func (c *lazyCoreClient) GetProxies(ctx context.Context, args core.
+	GetProxiesArgs) (*[]core.
+	Proxy, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetProxies(ctx, args)
+}
+
+func (c *lazyCoreClient) GetTeam(ctx context.Context, args core.
+	GetTeamArgs) (*core.
+	WebApiTeam, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTeam(ctx, args)
+}
+
+func (c *lazyCoreClient) GetTeamMembersWithExtendedProperties(ctx context.Context, args core.
+	GetTeamMembersWithExtendedPropertiesArgs) (*[]webapi.TeamMember, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTeamMembersWithExtendedProperties(ctx, args)
+}
+
+func (c *lazyCoreClient) GetTeams(ctx context.Context, args core.
+	GetTeamsArgs) (*[]core.
+	WebApiTeam, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTeams(ctx, args)
+}
+
+func (c *lazyCoreClient) QueueCreateProject(ctx context.Context, args core.
+	QueueCreateProjectArgs) (*operations.OperationReference, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.QueueCreateProject(ctx, args)
+}
+
+func (c *lazyCoreClient) QueueDeleteProject(ctx context.Context, args core.
+	QueueDeleteProjectArgs) (*operations.OperationReference, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.QueueDeleteProject(ctx, args)
+}
+
+func (c *lazyCoreClient) RemoveProjectAvatar(ctx context.Context, args core.
+	RemoveProjectAvatarArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.RemoveProjectAvatar(ctx, args)
+}
+
+func (c *lazyCoreClient) SetProjectAvatar(ctx context.Context, args core.
+	SetProjectAvatarArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.SetProjectAvatar(ctx, args)
+}
+
+func (c *lazyCoreClient) SetProjectProperties(ctx context.Context, args core.
+	SetProjectPropertiesArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.SetProjectProperties(ctx, args)
+}
+
+func (c *lazyCoreClient) UpdateProject(ctx context.Context, args core.
+	UpdateProjectArgs) (*operations.OperationReference, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateProject(ctx, args)
+}
+
+func (c *lazyCoreClient) UpdateTeam(ctx context.Context, args core.
+	UpdateTeamArgs) (*core.
+	WebApiTeam, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateTeam(ctx, args)
+}
+
+// lazyFeaturemanagementClient creates the featuremanagement client of the SDK on its first request
+type lazyFeaturemanagementClient struct {
+	lazyClient
+}
+
+func (c *lazyFeaturemanagementClient) client() (featuremanagement.Client, error) {
+	client, err := c.unwrap()
+	if err != nil {
+		return nil, err
+	}
+	return client.(featuremanagement.Client), nil
+}
+
+func (c *lazyFeaturemanagementClient) GetFeature(ctx context.Context, args featuremanagement.
+	GetFeatureArgs) (*featuremanagement.
+	ContributedFeature, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetFeature(ctx, args)
+}
+
+func (c *lazyFeaturemanagementClient) GetFeatures(ctx context.Context, args featuremanagement.
+	GetFeaturesArgs) (*[]featuremanagement.
+	ContributedFeature, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetFeatures(ctx, args)
+}
+
+func (c *lazyFeaturemanagementClient) GetFeatureState(ctx context.Context, args featuremanagement.
+	GetFeatureStateArgs) (*featuremanagement.
+	ContributedFeatureState, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetFeatureState(ctx, args)
+}
+
+func (c *lazyFeaturemanagementClient) GetFeatureStateForScope(ctx context.Context, args featuremanagement.
+	GetFeatureStateForScopeArgs) (*featuremanagement.
+	ContributedFeatureState, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetFeatureStateForScope(ctx, args)
+}
+
+func (c *lazyFeaturemanagementClient) QueryFeatureStates(ctx context.Context, args featuremanagement.
+	QueryFeatureStatesArgs) (*featuremanagement.
+	ContributedFeatureStateQuery, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.QueryFeatureStates(ctx, args)
+}
+
+func (c *lazyFeaturemanagementClient) QueryFeatureStatesForDefaultScope(ctx context.Context, args featuremanagement.
+	QueryFeatureStatesForDefaultScopeArgs) (*featuremanagement.
+	ContributedFeatureStateQuery, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.QueryFeatureStatesForDefaultScope(ctx, args)
+}
+
+func (c *lazyFeaturemanagementClient) QueryFeatureStatesForNamedScope(ctx context.Context, args featuremanagement.
+	QueryFeatureStatesForNamedScopeArgs) (*featuremanagement.
+	ContributedFeatureStateQuery, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.QueryFeatureStatesForNamedScope(ctx, args)
+}
+
+func (c *lazyFeaturemanagementClient) SetFeatureState(ctx context.Context, args featuremanagement.
+	SetFeatureStateArgs) (*featuremanagement.
+	ContributedFeatureState, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.SetFeatureState(ctx, args)
+}
+
+func (c *lazyFeaturemanagementClient) SetFeatureStateForScope(ctx context.Context, args featuremanagement.
+	SetFeatureStateForScopeArgs) (*featuremanagement.
+	ContributedFeatureState, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.SetFeatureStateForScope(ctx, args)
+}
+
+// lazyGitClient creates the git client of the SDK on its first request
+type lazyGitClient struct {
+	lazyClient
+}
+
+func (c *lazyGitClient) client() (git.Client, error) {
+	client, err := c.unwrap()
+	if err != nil {
+		return nil, err
+	}
+	return client.(git.Client), nil
+}
+
+func (c *lazyGitClient) CreateAnnotatedTag(ctx context.Context, args git.
+	CreateAnnotatedTagArgs) (*git.
+	GitAnnotatedTag, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateAnnotatedTag(ctx, args)
+}
+
+func (c *lazyGitClient) CreateAttachment(ctx context.Context, args git.
+	CreateAttachmentArgs) (*git.
+	Attachment, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateAttachment(ctx, args)
+}
+
+func (c *lazyGitClient) CreateCherryPick(ctx context.Context, args git.
+	CreateCherryPickArgs) (*git.
+	GitCherryPick, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateCherryPick(ctx, args)
+}
+
+func (c *lazyGitClient) CreateComment(ctx context.Context, args git.
+	CreateCommentArgs) (*git.
+	Comment, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateComment(ctx, args)
+}
+
+func (c *lazyGitClient) CreateCommitStatus(ctx context.Context, args git.
+	CreateCommitStatusArgs) (*git.
+	GitStatus, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateCommitStatus(ctx, args)
+}
+
+func (c *lazyGitClient) CreateFavorite(ctx context.Context, args git.
+	CreateFavoriteArgs) (*git.
+	GitRefFavorite, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateFavorite(ctx, args)
+}
+
+func (c *lazyGitClient) CreateForkSyncRequest(ctx context.Context, args git.
+	CreateForkSyncRequestArgs) (*git.
+	GitForkSyncRequest, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateForkSyncRequest(ctx, args)
+}
+
+func (c *lazyGitClient) CreateImportRequest(ctx context.Context, args git.
+	CreateImportRequestArgs) (*git.
+	GitImportRequest, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateImportRequest(ctx, args)
+}
+
+func (c *lazyGitClient) CreateLike(ctx context.Context, args git.
+	CreateLikeArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.CreateLike(ctx, args)
+}
+
+func (c *lazyGitClient) CreateMergeRequest(ctx context.Context, args git.
+	CreateMergeRequestArgs) (*git.
+	GitMerge, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateMergeRequest(ctx, args)
+}
+
+func (c *lazyGitClient) CreatePullRequest(ctx context.Context, args git.
+	CreatePullRequestArgs) (*git.
+	GitPullRequest, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreatePullRequest(ctx, args)
+}
+
+func (c *lazyGitClient) CreatePullRequestIterationStatus(ctx context.Context, args git.
+	CreatePullRequestIterationStatusArgs) (*git.
+	GitPullRequestStatus, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreatePullRequestIterationStatus(ctx, args)
+}
+
+func (c *lazyGitClient) CreatePullRequestLabel(ctx context.Context, args git.
+	CreatePullRequestLabelArgs) (*core.WebApiTagDefinition, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreatePullRequestLabel(ctx, args)
+}
+
+func (c *lazyGitClient) CreatePullRequestReviewer(ctx context.Context, args git.
+	CreatePullRequestReviewerArgs) (*git.
+	IdentityRefWithVote, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreatePullRequestReviewer(ctx, args)
+}
+
+func (c *lazyGitClient) CreatePullRequestReviewers(ctx context.Context, args git.
+	CreatePullRequestReviewersArgs) (*[]git.
+	IdentityRefWithVote, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreatePullRequestReviewers(ctx, args)
+}
+
+func (c *lazyGitClient) CreatePullRequestStatus(ctx context.Context, args git.
+	CreatePullRequestStatusArgs) (*git.
+	GitPullRequestStatus, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreatePullRequestStatus(ctx, args)
+}
+
+func (c *lazyGitClient) CreatePush(ctx context.Context, args git.
+	CreatePushArgs) (*git.
+	GitPush, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreatePush(ctx, args)
+}
+
+func (c *lazyGitClient) CreateRepository(ctx context.Context, args git.
+	CreateRepositoryArgs) (*git.
+	GitRepository, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateRepository(ctx, args)
+}
+
+func (c *lazyGitClient) CreateRevert(ctx context.Context, args git.
+	CreateRevertArgs) (*git.
+	GitRevert, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateRevert(ctx, args)
+}
+
+func (c *lazyGitClient) CreateThread(ctx context.Context, args git.
+	CreateThreadArgs) (*git.
+	GitPullRequestCommentThread, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateThread(ctx, args)
+}
+
+func (c *lazyGitClient) CreateUnmaterializedPullRequestReviewer(ctx context.Context, args git.
+	CreateUnmaterializedPullRequestReviewerArgs) (*git.
+	IdentityRefWithVote, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateUnmaterializedPullRequestReviewer(ctx, args)
+}
+
+func (c *lazyGitClient) DeleteAttachment(ctx context.Context, args git.
+	DeleteAttachmentArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteAttachment(ctx, args)
+}
+
+func (c *lazyGitClient) DeleteComment(ctx context.Context, args git.
+	DeleteCommentArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteComment(ctx, args)
+}
+
+func (c *lazyGitClient) DeleteLike(ctx context.Context, args git.
+	DeleteLikeArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteLike(ctx, args)
+}
+
+func (c *lazyGitClient) DeletePullRequestIterationStatus(ctx context.Context, args git.
+	DeletePullRequestIterationStatusArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeletePullRequestIterationStatus(ctx, args)
+}
+
+func (c *lazyGitClient) DeletePullRequestLabels(ctx context.Context, args git.
+	DeletePullRequestLabelsArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeletePullRequestLabels(ctx, args)
+}
+
+func (c *lazyGitClient) DeletePullRequestReviewer(ctx context.Context, args git.
+	DeletePullRequestReviewerArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeletePullRequestReviewer(ctx, args)
+}
+
+func (c *lazyGitClient) DeletePullRequestStatus(ctx context.Context, args git.
+	DeletePullRequestStatusArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeletePullRequestStatus(ctx, args)
+}
+
+func (c *lazyGitClient) DeleteRefFavorite(ctx context.Context, args git.
+	DeleteRefFavoriteArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteRefFavorite(ctx, args)
+}
+
+func (c *lazyGitClient) DeleteRepository(ctx context.Context, args git.
+	DeleteRepositoryArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteRepository(ctx, args)
+}
+
+func (c *lazyGitClient) DeleteRepositoryFromRecycleBin(ctx context.Context, args git.
+	DeleteRepositoryFromRecycleBinArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteRepositoryFromRecycleBin(ctx, args)
+}
+
+func (c *lazyGitClient) GetAnnotatedTag(ctx context.Context, args git.
+	GetAnnotatedTagArgs) (*git.
+	GitAnnotatedTag, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAnnotatedTag(ctx, args)
+}
+
+func (c *lazyGitClient) GetAttachmentContent(ctx context.Context, args git.
+	GetAttachmentContentArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAttachmentContent(ctx, args)
+}
+
+func (c *lazyGitClient) GetAttachments(ctx context.Context, args git.
+	GetAttachmentsArgs) (*[]git.
+	Attachment, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAttachments(ctx, args)
+}
+
+func (c *lazyGitClient) GetAttachmentZip(ctx context.Context, args git.
+	GetAttachmentZipArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAttachmentZip(ctx, args)
+}
+
+func (c *lazyGitClient) GetBlob(ctx context.Context, args git.
+	GetBlobArgs) (*git.
+	GitBlobRef, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBlob(ctx, args)
+}
+
+func (c *lazyGitClient) GetBlobContent(ctx context.Context, args git.
+	GetBlobContentArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBlobContent(ctx, args)
+}
+
+func (c *lazyGitClient) GetBlobsZip(ctx context.Context, args git.
+	GetBlobsZipArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBlobsZip(ctx, args)
+}
+
+func (c *lazyGitClient) GetBlobZip(ctx context.Context, args git.
+	GetBlobZipArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBlobZip(ctx, args)
+}
+
+func (c *lazyGitClient) GetBranch(ctx context.Context, args git.
+	GetBranchArgs) (*git.
+	GitBranchStats, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBranch(ctx, args)
+}
+
+func (c *lazyGitClient) GetBranches(ctx context.Context, args git.
+	GetBranchesArgs) (*[]git.
+	GitBranchStats, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBranches(ctx, args)
+}
+
+func (c *lazyGitClient) GetChanges(ctx context.Context, args git.
+	GetChangesArgs) (*git.
+	GitCommitChanges, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetChanges(ctx, args)
+}
+
+func (c *lazyGitClient) GetCherryPick(ctx context.Context, args git.
+	GetCherryPickArgs) (*git.
+	GitCherryPick, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetCherryPick(ctx, args)
+}
+
+func (c *lazyGitClient) GetCherryPickForRefName(ctx context.Context, args git.
+	GetCherryPickForRefNameArgs) (*git.
+	GitCherryPick, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetCherryPickForRefName(ctx, args)
+}
+
+func (c *lazyGitClient) GetComment(ctx context.Context, args git.
+	GetCommentArgs) (*git.
+	Comment, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetComment(ctx, args)
+}
+
+func (c *lazyGitClient) GetComments(ctx context.Context, args git.
+	GetCommentsArgs) (*[]git.
+	Comment, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetComments(ctx, args)
+}
+
+func (c *lazyGitClient) GetCommit(ctx context.Context, args git.
+	GetCommitArgs) (*git.
+	GitCommit, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetCommit(ctx, args)
+}
+
+func (c *lazyGitClient) GetCommitDiffs(ctx context.Context, args git.
+	GetCommitDiffsArgs) (*git.
+	GitCommitDiffs, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetCommitDiffs(ctx, args)
+}
+
+func (c *lazyGitClient) GetCommits(ctx context.Context, args git.
+	GetCommitsArgs) (*[]git.
+	GitCommitRef, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetCommits(ctx, args)
+}
+
+func (c *lazyGitClient) GetCommitsBatch(ctx context.Context, args git.
+	GetCommitsBatchArgs) (*[]git.
+	GitCommitRef, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetCommitsBatch(ctx, args)
+}
+
+func (c *lazyGitClient) GetDeletedRepositories(ctx context.Context, args git.
+	GetDeletedRepositoriesArgs) (*[]git.
+	GitDeletedRepository, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDeletedRepositories(ctx, args)
+}
+
+func (c *lazyGitClient) GetForks(ctx context.Context, args git.
+	GetForksArgs) (*[]git.
+	GitRepositoryRef, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetForks(ctx, args)
+}
+
+func (c *lazyGitClient) GetForkSyncRequest(ctx context.Context, args git.
+	GetForkSyncRequestArgs) (*git.
+	GitForkSyncRequest, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetForkSyncRequest(ctx, args)
+}
+
+func (c *lazyGitClient) GetForkSyncRequests(ctx context.Context, args git.
+	GetForkSyncRequestsArgs) (*[]git.
+	GitForkSyncRequest, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetForkSyncRequests(ctx, args)
+}
+
+func (c *lazyGitClient) GetImportRequest(ctx context.Context, args git.
+	GetImportRequestArgs) (*git.
+	GitImportRequest, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetImportRequest(ctx, args)
+}
+
+func (c *lazyGitClient) GetItem(ctx context.Context, args git.
+	GetItemArgs) (*git.
+	GitItem, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetItem(ctx, args)
+}
+
+func (c *lazyGitClient) GetItemContent(ctx context.Context, args git.
+	GetItemContentArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetItemContent(ctx, args)
+}
+
+func (c *lazyGitClient) GetItems(ctx context.Context, args git.
+	GetItemsArgs) (*[]git.
+	GitItem, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetItems(ctx, args)
+}
+
+func (c *lazyGitClient) GetItemsBatch(ctx context.Context, args git.
+	GetItemsBatchArgs) (*[][]git.
+	GitItem, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetItemsBatch(ctx, args)
+}
+
+func (c *lazyGitClient) GetItemText(ctx context.Context, args git.
+	GetItemTextArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetItemText(ctx, args)
+}
+
+func (c *lazyGitClient) GetItemZip(ctx context.Context, args git.
+	GetItemZipArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetItemZip(ctx, args)
+}
+
+func (c *lazyGitClient) GetLikes(ctx context.Context, args git.
+	GetLikesArgs) (*[]webapi.IdentityRef, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetLikes(ctx, args)
+}
+
+func (c *lazyGitClient) GetMergeBases(ctx context.Context, args git.
+	GetMergeBasesArgs) (*[]git.
+	GitCommitRef, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetMergeBases(ctx, args)
+}
+
+func (c *lazyGitClient) GetMergeRequest(ctx context.Context, args git.
+	GetMergeRequestArgs) (*git.
+	GitMerge, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetMergeRequest(ctx, args)
+}
+
+func (c *lazyGitClient) GetPolicyConfigurations(ctx context.Context, args git.
+	GetPolicyConfigurationsArgs) (*git.
+	GitPolicyConfigurationResponse, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPolicyConfigurations(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequest(ctx context.Context, args git.
+	GetPullRequestArgs) (*git.
+	GitPullRequest, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequest(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequestById(ctx context.Context, args git.
+	GetPullRequestByIdArgs) (*git.
+	GitPullRequest, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequestById(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequestCommits(ctx context.Context, args git.
+	GetPullRequestCommitsArgs) (*git.
+	GetPullRequestCommitsResponseValue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequestCommits(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequestIteration(ctx context.Context, args git.
+	GetPullRequestIterationArgs) (*git.
+	GitPullRequestIteration, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequestIteration(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequestIterationChanges(ctx context.Context, args git.
+	GetPullRequestIterationChangesArgs) (*git.
+	GitPullRequestIterationChanges, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequestIterationChanges(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequestIterationCommits(ctx context.Context, args git.
+	GetPullRequestIterationCommitsArgs) (*[]git.
+	GitCommitRef, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequestIterationCommits(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequestIterations(ctx context.Context, args git.
+	GetPullRequestIterationsArgs) (*[]git.
+	GitPullRequestIteration, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequestIterations(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequestIterationStatus(ctx context.Context, args git.
+	GetPullRequestIterationStatusArgs) (*git.
+	GitPullRequestStatus, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequestIterationStatus(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequestIterationStatuses(ctx context.Context, args git.
+	GetPullRequestIterationStatusesArgs) (*[]git.
+	GitPullRequestStatus, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequestIterationStatuses(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequestLabel(ctx context.Context, args git.
+	GetPullRequestLabelArgs) (*core.WebApiTagDefinition, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequestLabel(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequestLabels(ctx context.Context, args git.
+	GetPullRequestLabelsArgs) (*[]core.WebApiTagDefinition, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequestLabels(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequestProperties(ctx context.Context, args git.
+	GetPullRequestPropertiesArgs) (interface{}, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequestProperties(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequestQuery(ctx context.Context, args git.
+	GetPullRequestQueryArgs) (*git.
+	GitPullRequestQuery, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequestQuery(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequestReviewer(ctx context.Context, args git.
+	GetPullRequestReviewerArgs) (*git.
+	IdentityRefWithVote, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequestReviewer(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequestReviewers(ctx context.Context, args git.
+	GetPullRequestReviewersArgs) (*[]git.
+	IdentityRefWithVote, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequestReviewers(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequests(ctx context.Context, args git.
+	GetPullRequestsArgs) (*[]git.
+	GitPullRequest, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequests(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequestsByProject(ctx context.Context, args git.
+	GetPullRequestsByProjectArgs) (*[]git.
+	GitPullRequest, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequestsByProject(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequestStatus(ctx context.Context, args git.
+	GetPullRequestStatusArgs) (*git.
+	GitPullRequestStatus, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequestStatus(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequestStatuses(ctx context.Context, args git.
+	GetPullRequestStatusesArgs) (*[]git.
+	GitPullRequestStatus, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequestStatuses(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequestThread(ctx context.Context, args git.
+	GetPullRequestThreadArgs) (*git.
+	GitPullRequestCommentThread, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequestThread(ctx, args)
+}
+
+func (c *lazyGitClient) GetPullRequestWorkItemRefs(ctx context.Context, args git.
+	GetPullRequestWorkItemRefsArgs) (*[]webapi.ResourceRef, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPullRequestWorkItemRefs(ctx, args)
+}
+
+func (c *lazyGitClient) GetPush(ctx context.Context, args git.
+	GetPushArgs) (*git.
+	GitPush, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPush(ctx, args)
+}
+
+func (c *lazyGitClient) GetPushCommits(ctx context.Context, args git.
+	GetPushCommitsArgs) (*[]git.
+	GitCommitRef, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPushCommits(ctx, args)
+}
+
+func (c *lazyGitClient) GetPushes(ctx context.Context, args git.
+	GetPushesArgs) (*[]git.
+	GitPush, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPushes(ctx, args)
+}
+
+func (c *lazyGitClient) GetRecycleBinRepositories(ctx context.Context, args git.
+	GetRecycleBinRepositoriesArgs) (*[]git.
+	GitDeletedRepository, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRecycleBinRepositories(ctx, args)
+}
+
+func (c *lazyGitClient) GetRefFavorite(ctx context.Context, args git.
+	GetRefFavoriteArgs) (*git.
+	GitRefFavorite, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRefFavorite(ctx, args)
+}
+
+func (c *lazyGitClient) GetRefFavorites(ctx context.Context, args git.
+	GetRefFavoritesArgs) (*[]git.
+	GitRefFavorite, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRefFavorites(ctx, args)
+}
+
+func (c *lazyGitClient) GetRefs(ctx context.Context, args git.
+	GetRefsArgs) (*git.
+	GetRefsResponseValue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRefs(ctx, args)
+}
+
+func (c *lazyGitClient) GetRepositories(ctx context.Context, args git.
+	GetRepositoriesArgs) (*[]git.
+	GitRepository, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRepositories(ctx, args)
+}
+
+func (c *lazyGitClient) GetRepository(ctx context.Context, args git.
+	GetRepositoryArgs) (*git.
+	GitRepository, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRepository(ctx, args)
+}
+
+func (c *lazyGitClient) GetRepositoryWithParent(ctx context.Context, args git.
+	GetRepositoryWithParentArgs) (*git.
+	GitRepository, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRepositoryWithParent(ctx, args)
+}
+
+func (c *lazyGitClient) GetRevert(ctx context.Context, args git.
+	GetRevertArgs) (*git.
+	GitRevert, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRevert(ctx, args)
+}
+
+func (c *lazyGitClient) GetRevertForRefName(ctx context.Context, args git.
+	GetRevertForRefNameArgs) (*git.
+	GitRevert, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRevertForRefName(ctx, args)
+}
+
+func (c *lazyGitClient) GetStatuses(ctx context.Context, args git.
+	GetStatusesArgs) (*[]git.
+	GitStatus, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetStatuses(ctx, args)
+}
+
+func (c *lazyGitClient) GetSuggestions(ctx context.Context, args git.
+	GetSuggestionsArgs) (*[]git.
+	GitSuggestion, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetSuggestions(ctx, args)
+}
+
+func (c *lazyGitClient) GetThreads(ctx context.Context, args git.
+	GetThreadsArgs) (*[]git.
+	GitPullRequestCommentThread, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetThreads(ctx, args)
+}
+
+func (c *lazyGitClient) GetTree(ctx context.Context, args git.
+	GetTreeArgs) (*git.
+	GitTreeRef, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTree(ctx, args)
+}
+
+func (c *lazyGitClient) GetTreeZip(ctx context.Context, args git.
+	GetTreeZipArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTreeZip(ctx, args)
+}
+
+func (c *lazyGitClient) QueryImportRequests(ctx context.Context, args git.
+	QueryImportRequestsArgs) (*[]git.
+	GitImportRequest, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.QueryImportRequests(ctx, args)
+}
+
+func (c *lazyGitClient) RestoreRepositoryFromRecycleBin(ctx context.Context, args git.
+	RestoreRepositoryFromRecycleBinArgs) (*git.
+	GitRepository, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.RestoreRepositoryFromRecycleBin(ctx, args)
+}
+
+func (c *lazyGitClient) SharePullRequest(ctx context.Context, args git.
+	SharePullRequestArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.SharePullRequest(ctx, args)
+}
+
+func (c *lazyGitClient) UpdateComment(ctx context.Context, args git.
+	UpdateCommentArgs) (*git.
+	Comment, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateComment(ctx, args)
+}
+
+func (c *lazyGitClient) UpdateImportRequest(ctx context.Context, args git.
+	UpdateImportRequestArgs) (*git.
+	GitImportRequest, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateImportRequest(ctx, args)
+}
+
+func (c *lazyGitClient) UpdatePullRequest(ctx context.Context, args git.
+	UpdatePullRequestArgs) (*git.
+	GitPullRequest, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdatePullRequest(ctx, args)
+}
+
+func (c *lazyGitClient) UpdatePullRequestIterationStatuses(ctx context.Context, args git.
+	UpdatePullRequestIterationStatusesArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.UpdatePullRequestIterationStatuses(ctx, args)
+}
+
+func (c *lazyGitClient) UpdatePullRequestProperties(ctx context.Context, args git.
+	UpdatePullRequestPropertiesArgs) (interface{}, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdatePullRequestProperties(ctx, args)
+}
+
+func (c *lazyGitClient) UpdatePullRequestReviewer(ctx context.Context, args git.
+	UpdatePullRequestReviewerArgs) (*git.
+	IdentityRefWithVote, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdatePullRequestReviewer(ctx, args)
+}
+
+func (c *lazyGitClient) UpdatePullRequestReviewers(ctx context.Context, args git.
+	UpdatePullRequestReviewersArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.UpdatePullRequestReviewers(ctx, args)
+}
+
+func (c *lazyGitClient) UpdatePullRequestStatuses(ctx context.Context, args git.
+	UpdatePullRequestStatusesArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.UpdatePullRequestStatuses(ctx, args)
+}
+
+func (c *lazyGitClient) UpdateRef(ctx context.Context, args git.
+	UpdateRefArgs) (*git.
+	GitRef, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateRef(ctx, args)
+}
+
+func (c *lazyGitClient) UpdateRefs(ctx context.Context, args git.
+	UpdateRefsArgs) (*[]git.
+	GitRefUpdateResult, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateRefs(ctx, args)
+}
+
+func (c *lazyGitClient) UpdateRepository(ctx context.Context, args git.
+	UpdateRepositoryArgs) (*git.
+	GitRepository, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateRepository(ctx, args)
+}
+
+func (c *lazyGitClient) UpdateThread(ctx context.Context, args git.
+	UpdateThreadArgs) (*git.
+	GitPullRequestCommentThread, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateThread(ctx, args)
+}
+
+// lazyGraphClient creates the graph client of the SDK on its first request
+type lazyGraphClient struct {
+	lazyClient
+}
+
+func (c *lazyGraphClient) client() (graph.Client, error) {
+	client, err := c.unwrap()
+	if err != nil {
+		return nil, err
+	}
+	return client.(graph.Client), nil
+}
+
+func (c *lazyGraphClient) AddMembership(ctx context.Context, args graph.
+	AddMembershipArgs) (*graph.
+	GraphMembership, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AddMembership(ctx, args)
+}
+
+func (c *lazyGraphClient) CheckMembershipExistence(ctx context.Context, args graph.
+	CheckMembershipExistenceArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.CheckMembershipExistence(ctx, args)
+}
+
+func (c *lazyGraphClient) CreateGroup(ctx context.Context, args graph.
+	CreateGroupArgs) (*graph.
+	GraphGroup, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateGroup(ctx, args)
+}
+
+func (c *lazyGraphClient) CreateUser(ctx context.Context, args graph.
+	CreateUserArgs) (*graph.
+	GraphUser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateUser(ctx, args)
+}
+
+func (c *lazyGraphClient) DeleteAvatar(ctx context.Context, args graph.
+	DeleteAvatarArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteAvatar(ctx, args)
+}
+
+func (c *lazyGraphClient) DeleteGroup(ctx context.Context, args graph.
+	DeleteGroupArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteGroup(ctx, args)
+}
+
+func (c *lazyGraphClient) DeleteUser(ctx context.Context, args graph.
+	DeleteUserArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteUser(ctx, args)
+}
+
+func (c *lazyGraphClient) GetAvatar(ctx context.Context, args graph.
+	GetAvatarArgs) (*profile.Avatar, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAvatar(ctx, args)
+}
+
+func (c *lazyGraphClient) GetDescriptor(ctx context.Context, args graph.
+	GetDescriptorArgs) (*graph.
+	GraphDescriptorResult, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDescriptor(ctx, args)
+}
+
+func (c *lazyGraphClient) GetGroup(ctx context.Context, args graph.
+	GetGroupArgs) (*graph.
+	GraphGroup, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetGroup(ctx, args)
+}
+
+func (c *lazyGraphClient) GetMembership(ctx context.Context, args graph.
+	GetMembershipArgs) (*graph.
+	GraphMembership, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetMembership(ctx, args)
+}
+
+func (c *lazyGraphClient) GetMembershipState(ctx context.Context, args graph.
+	GetMembershipStateArgs) (*graph.
+	GraphMembershipState, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetMembershipState(ctx, args)
+}
+
+func (c *lazyGraphClient) GetProviderInfo(ctx context.Context, args graph.
+	GetProviderInfoArgs) (*graph.
+	GraphProviderInfo, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetProviderInfo(ctx, args)
+}
+
+func (c *lazyGraphClient) GetStorageKey(ctx context.Context, args graph.
+	GetStorageKeyArgs) (*graph.
+	GraphStorageKeyResult, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetStorageKey(ctx, args)
+}
+
+func (c *lazyGraphClient) GetUser(ctx context.Context, args graph.
+	GetUserArgs) (*graph.
+	GraphUser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetUser(ctx, args)
+}
+
+func (c *lazyGraphClient) ListGroups(ctx context.Context, args graph.
+	ListGroupsArgs) (*graph.
+	PagedGraphGroups, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ListGroups(ctx, args)
+}
+
+func (c *lazyGraphClient) ListMemberships(ctx context.Context, args graph.
+	ListMembershipsArgs) (*[]graph.
+	GraphMembership, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ListMemberships(ctx, args)
+}
+
+func (c *lazyGraphClient) ListUsers(ctx context.Context, args graph.
+	ListUsersArgs) (*graph.
+	PagedGraphUsers, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ListUsers(ctx, args)
+}
+
+func (c *lazyGraphClient) LookupSubjects(ctx context.Context, args graph.
+	LookupSubjectsArgs) (*map[string]graph.GraphSubject, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.LookupSubjects(ctx, args)
+}
+
+func (c *lazyGraphClient) QuerySubjects(ctx context.Context, args graph.
+	QuerySubjectsArgs) (*[]graph.
+	GraphSubject, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.QuerySubjects(ctx, args)
+}
+
+func (c *lazyGraphClient) RemoveMembership(ctx context.Context, args graph.
+	RemoveMembershipArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.RemoveMembership(ctx, args)
+}
+
+func (c *lazyGraphClient) RequestAccess(ctx context.Context, args graph.
+	RequestAccessArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.RequestAccess(ctx, args)
+}
+
+func (c *lazyGraphClient) SetAvatar(ctx context.Context, args graph.
+	SetAvatarArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.SetAvatar(ctx, args)
+}
+
+func (c *lazyGraphClient) UpdateGroup(ctx context.Context, args graph.
+	UpdateGroupArgs) (*graph.
+	GraphGroup, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateGroup(ctx, args)
+}
+
+func (c *lazyGraphClient) UpdateUser(ctx context.Context, args graph.
+	UpdateUserArgs) (*graph.
+	GraphUser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateUser(ctx, args)
+}
+
+// lazyIdentityClient creates the identity client of the SDK on its first request
+type lazyIdentityClient struct {
+	lazyClient
+}
+
+func (c *lazyIdentityClient) client() (identity.Client, error) {
+	client, err := c.unwrap()
+	if err != nil {
+		return nil, err
+	}
+	return client.(identity.Client), nil
+}
+
+func (c *lazyIdentityClient) AddMember(ctx context.Context, args identity.
+	AddMemberArgs) (*bool, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AddMember(ctx, args)
+}
+
+func (c *lazyIdentityClient) CreateGroups(ctx context.Context, args identity.
+	CreateGroupsArgs) (*[]identity.
+	Identity, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateGroups(ctx, args)
+}
+
+func (c *lazyIdentityClient) CreateIdentity(ctx context.Context, args identity.
+	CreateIdentityArgs) (*identity.
+	Identity, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateIdentity(ctx, args)
+}
+
+func (c *lazyIdentityClient) CreateOrBindWithClaims(ctx context.Context, args identity.
+	CreateOrBindWithClaimsArgs) (*identity.
+	Identity, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateOrBindWithClaims(ctx, args)
+}
+
+func (c *lazyIdentityClient) CreateScope(ctx context.Context, args identity.
+	CreateScopeArgs) (*identity.
+	IdentityScope, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateScope(ctx, args)
+}
+
+func (c *lazyIdentityClient) DeleteGroup(ctx context.Context, args identity.
+	DeleteGroupArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteGroup(ctx, args)
+}
+
+func (c *lazyIdentityClient) DeleteScope(ctx context.Context, args identity.
+	DeleteScopeArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteScope(ctx, args)
+}
+
+func (c *lazyIdentityClient) ForceRemoveMember(ctx context.Context, args identity.
+	ForceRemoveMemberArgs) (*bool, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ForceRemoveMember(ctx, args)
+}
+
+func (c *lazyIdentityClient) GetDescriptorById(ctx context.Context, args identity.
+	GetDescriptorByIdArgs) (*string, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDescriptorById(ctx, args)
+}
+
+func (c *lazyIdentityClient) GetIdentityChanges(ctx context.Context, args identity.
+	GetIdentityChangesArgs) (*identity.
+	ChangedIdentities, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetIdentityChanges(ctx, args)
+}
+
+func (c *lazyIdentityClient) GetIdentitySnapshot(ctx context.Context, args identity.
+	GetIdentitySnapshotArgs) (*identity.
+	IdentitySnapshot, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetIdentitySnapshot(ctx, args)
+}
+
+func (c *lazyIdentityClient) GetMaxSequenceId(ctx context.Context, args identity.
+	GetMaxSequenceIdArgs) (*uint64, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetMaxSequenceId(ctx, args)
+}
+
+func (c *lazyIdentityClient) GetScopeById(ctx context.Context, args identity.
+	GetScopeByIdArgs) (*identity.
+	IdentityScope, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetScopeById(ctx, args)
+}
+
+func (c *lazyIdentityClient) GetScopeByName(ctx context.Context, args identity.
+	GetScopeByNameArgs) (*identity.
+	IdentityScope, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetScopeByName(ctx, args)
+}
+
+func (c *lazyIdentityClient) GetSelf(ctx context.Context, args identity.
+	GetSelfArgs) (*identity.
+	IdentitySelf, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetSelf(ctx, args)
+}
+
+func (c *lazyIdentityClient) GetSignedInToken(ctx context.Context, args identity.
+	GetSignedInTokenArgs) (*delegatedauthorization.AccessTokenResult, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetSignedInToken(ctx, args)
+}
+
+func (c *lazyIdentityClient) GetSignoutToken(ctx context.Context, args identity.
+	GetSignoutTokenArgs) (*delegatedauthorization.AccessTokenResult, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetSignoutToken(ctx, args)
+}
+
+func (c *lazyIdentityClient) GetTenant(ctx context.Context, args identity.
+	GetTenantArgs) (*identity.
+	TenantInfo, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTenant(ctx, args)
+}
+
+func (c *lazyIdentityClient) GetUserIdentityIdsByDomainId(ctx context.Context, args identity.
+	GetUserIdentityIdsByDomainIdArgs) (*[]uuid.UUID, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetUserIdentityIdsByDomainId(ctx, args)
+}
+
+func (c *lazyIdentityClient) ListGroups(ctx context.Context, args identity.
+	ListGroupsArgs) (*[]identity.
+	Identity, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ListGroups(ctx, args)
+}
+
+func (c *lazyIdentityClient) ReadIdentities(ctx context.Context, args identity.
+	ReadIdentitiesArgs) (*[]identity.
+	Identity, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ReadIdentities(ctx, args)
+}
+
+func (c *lazyIdentityClient) ReadIdentitiesByScope(ctx context.Context, args identity.
+	ReadIdentitiesByScopeArgs) (*[]identity.
+	Identity, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ReadIdentitiesByScope(ctx, args)
+}
+
+func (c *lazyIdentityClient) ReadIdentity(ctx context.Context, args identity.
+	ReadIdentityArgs) (*identity.
+	Identity, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ReadIdentity(ctx, args)
+}
+
+func (c *lazyIdentityClient) ReadIdentityBatch(ctx context.Context, args identity.
+	ReadIdentityBatchArgs) (*[]identity.
+	Identity, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ReadIdentityBatch(ctx, args)
+}
+
+func (c *lazyIdentityClient) ReadMember(ctx context.Context, args identity.
+	ReadMemberArgs) (*string, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ReadMember(ctx, args)
+}
+
+func (c *lazyIdentityClient) ReadMemberOf(ctx context.Context, args identity.
+	ReadMemberOfArgs) (*string, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ReadMemberOf(ctx, args)
+}
+
+func (c *lazyIdentityClient) ReadMembers(ctx context.Context, args identity.
+	ReadMembersArgs) (*[]string, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ReadMembers(ctx, args)
+}
+
+func (c *lazyIdentityClient) ReadMembersOf(ctx context.Context, args identity.
+	ReadMembersOfArgs) (*[]string, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ReadMembersOf(ctx, args)
+}
+
+func (c *lazyIdentityClient) RemoveMember(ctx context.Context, args identity.
+	RemoveMemberArgs) (*bool, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.RemoveMember(ctx, args)
+}
+
+func (c *lazyIdentityClient) UpdateIdentities(ctx context.Context, args identity.
+	UpdateIdentitiesArgs) (*[]identity.
+	IdentityUpdateData, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateIdentities(ctx, args)
+}
+
+func (c *lazyIdentityClient) UpdateIdentity(ctx context.Context, args identity.
+	UpdateIdentityArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.UpdateIdentity(ctx, args)
+}
+
+func (c *lazyIdentityClient) UpdateScope(ctx context.Context, args identity.
+	UpdateScopeArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.UpdateScope(ctx, args)
+}
+
+// lazyMemberentitlementmanagementClient creates the memberentitlementmanagement client of the SDK on its first request
+type lazyMemberentitlementmanagementClient struct {
+	lazyClient
+}
+
+func (c *lazyMemberentitlementmanagementClient) client() (memberentitlementmanagement.Client, error) {
+	client, err := c.unwrap()
+	if err != nil {
+		return nil, err
+	}
+	return client.(memberentitlementmanagement.Client), nil
+}
+
+func (c *lazyMemberentitlementmanagementClient) AddGroupEntitlement(ctx context.Context, args memberentitlementmanagement.
+	AddGroupEntitlementArgs) (*memberentitlementmanagement.
+	GroupEntitlementOperationReference, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AddGroupEntitlement(ctx, args)
+}
+
+func (c *lazyMemberentitlementmanagementClient) AddMemberToGroup(ctx context.Context, args memberentitlementmanagement.
+	AddMemberToGroupArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.AddMemberToGroup(ctx, args)
+}
+
+func (c *lazyMemberentitlementmanagementClient) AddUserEntitlement(ctx context.Context, args memberentitlementmanagement.
+	AddUserEntitlementArgs) (*memberentitlementmanagement.
+	UserEntitlementsPostResponse, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AddUserEntitlement(ctx, args)
+}
+
+func (c *lazyMemberentitlementmanagementClient) DeleteGroupEntitlement(ctx context.Context, args memberentitlementmanagement.
+	DeleteGroupEntitlementArgs) (*memberentitlementmanagement.
+	GroupEntitlementOperationReference, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.DeleteGroupEntitlement(ctx, args)
+}
+
+func (c *lazyMemberentitlementmanagementClient) DeleteUserEntitlement(ctx context.Context, args memberentitlementmanagement.
+	DeleteUserEntitlementArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteUserEntitlement(ctx, args)
+}
+
+func (c *lazyMemberentitlementmanagementClient) GetGroupEntitlement(ctx context.Context, args memberentitlementmanagement.
+	GetGroupEntitlementArgs) (*memberentitlementmanagement.
+	GroupEntitlement, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetGroupEntitlement(ctx, args)
+}
+
+func (c *lazyMemberentitlementmanagementClient) GetGroupEntitlements(ctx context.Context, args memberentitlementmanagement.
+	GetGroupEntitlementsArgs) (*[]memberentitlementmanagement.
+	GroupEntitlement, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetGroupEntitlements(ctx, args)
+}
+
+func (c *lazyMemberentitlementmanagementClient) GetGroupMembers(ctx context.Context, args memberentitlementmanagement.
+	GetGroupMembersArgs) (*memberentitlementmanagement.
+	PagedGraphMemberList, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetGroupMembers(ctx, args)
+}
+
+func (c *lazyMemberentitlementmanagementClient) GetUserEntitlement(ctx context.Context, args memberentitlementmanagement.
+	GetUserEntitlementArgs) (*memberentitlementmanagement.
+	UserEntitlement, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetUserEntitlement(ctx, args)
+}
+
+func (c *lazyMemberentitlementmanagementClient) GetUsersSummary(ctx context.Context, args memberentitlementmanagement.
+	GetUsersSummaryArgs) (*memberentitlementmanagement.
+	UsersSummary, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetUsersSummary(ctx, args)
+}
+
+func (c *lazyMemberentitlementmanagementClient) RemoveMemberFromGroup(ctx context.Context, args memberentitlementmanagement.
+	RemoveMemberFromGroupArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.RemoveMemberFromGroup(ctx, args)
+}
+
+func (c *lazyMemberentitlementmanagementClient) SearchUserEntitlements(ctx context.Context, args memberentitlementmanagement.
+	SearchUserEntitlementsArgs) (*memberentitlementmanagement.
+	PagedGraphMemberList, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.SearchUserEntitlements(ctx, args)
+}
+
+func (c *lazyMemberentitlementmanagementClient) UpdateGroupEntitlement(ctx context.Context, args memberentitlementmanagement.
+	UpdateGroupEntitlementArgs) (*memberentitlementmanagement.
+	GroupEntitlementOperationReference, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateGroupEntitlement(ctx, args)
+}
+
+func (c *lazyMemberentitlementmanagementClient) UpdateUserEntitlement(ctx context.Context, args memberentitlementmanagement.
+	UpdateUserEntitlementArgs) (*memberentitlementmanagement.
+	UserEntitlementsPatchResponse, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateUserEntitlement(ctx, args)
+}
+
+func (c *lazyMemberentitlementmanagementClient) UpdateUserEntitlements(ctx context.Context, args memberentitlementmanagement.
+	UpdateUserEntitlementsArgs) (*memberentitlementmanagement.
+	UserEntitlementOperationReference, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateUserEntitlements(ctx, args)
+}
+
+// lazyOperationsClient creates the operations client of the SDK on its first request
+type lazyOperationsClient struct {
+	lazyClient
+}
+
+func (c *lazyOperationsClient) client() (operations.Client, error) {
+	client, err := c.unwrap()
+	if err != nil {
+		return nil, err
+	}
+	return client.(operations.Client), nil
+}
+
+func (c *lazyOperationsClient) GetOperation(ctx context.Context, args operations.
+	GetOperationArgs) (*operations.
+	Operation, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetOperation(ctx, args)
+}
+
+// lazyPolicyClient creates the policy client of the SDK on its first request
+type lazyPolicyClient struct {
+	lazyClient
+}
+
+func (c *lazyPolicyClient) client() (policy.Client, error) {
+	client, err := c.unwrap()
+	if err != nil {
+		return nil, err
+	}
+	return client.(policy.Client), nil
+}
+
+func (c *lazyPolicyClient) CreatePolicyConfiguration(ctx context.Context, args policy.
+	CreatePolicyConfigurationArgs) (*policy.
+	PolicyConfiguration, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreatePolicyConfiguration(ctx, args)
+}
+
+func (c *lazyPolicyClient) DeletePolicyConfiguration(ctx context.Context, args policy.
+	DeletePolicyConfigurationArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeletePolicyConfiguration(ctx, args)
+}
+
+func (c *lazyPolicyClient) GetPolicyConfiguration(ctx context.Context, args policy.
+	GetPolicyConfigurationArgs) (*policy.
+	PolicyConfiguration, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPolicyConfiguration(ctx, args)
+}
+
+func (c *lazyPolicyClient) GetPolicyConfigurationRevision(ctx context.Context, args policy.
+	GetPolicyConfigurationRevisionArgs) (*policy.
+	PolicyConfiguration, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPolicyConfigurationRevision(ctx, args)
+}
+
+func (c *lazyPolicyClient) GetPolicyConfigurationRevisions(ctx context.Context, args policy.
+	GetPolicyConfigurationRevisionsArgs) (*[]policy.
+	PolicyConfiguration, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPolicyConfigurationRevisions(ctx, args)
+}
+
+func (c *lazyPolicyClient) GetPolicyConfigurations(ctx context.Context, args policy.
+	GetPolicyConfigurationsArgs) (*policy.
+	GetPolicyConfigurationsResponseValue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPolicyConfigurations(ctx, args)
+}
+
+func (c *lazyPolicyClient) GetPolicyEvaluation(ctx context.Context, args policy.
+	GetPolicyEvaluationArgs) (*policy.
+	PolicyEvaluationRecord, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPolicyEvaluation(ctx, args)
+}
+
+func (c *lazyPolicyClient) GetPolicyEvaluations(ctx context.Context, args policy.
+	GetPolicyEvaluationsArgs) (*[]policy.
+	PolicyEvaluationRecord, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPolicyEvaluations(ctx, args)
+}
+
+func (c *lazyPolicyClient) GetPolicyType(ctx context.Context, args policy.
+	GetPolicyTypeArgs) (*policy.
+	PolicyType, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPolicyType(ctx, args)
+}
+
+func (c *lazyPolicyClient) GetPolicyTypes(ctx context.Context, args policy.
+	GetPolicyTypesArgs) (*[]policy.
+	PolicyType, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPolicyTypes(ctx, args)
+}
+
+func (c *lazyPolicyClient) RequeuePolicyEvaluation(ctx context.Context, args policy.
+	RequeuePolicyEvaluationArgs) (*policy.
+	PolicyEvaluationRecord, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.RequeuePolicyEvaluation(ctx, args)
+}
+
+func (c *lazyPolicyClient) UpdatePolicyConfiguration(ctx context.Context, args policy.
+	UpdatePolicyConfigurationArgs) (*policy.
+	PolicyConfiguration, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdatePolicyConfiguration(ctx, args)
+}
+
+// lazyReleaseClient creates the release client of the SDK on its first request
+type lazyReleaseClient struct {
+	lazyClient
+}
+
+func (c *lazyReleaseClient) client() (release.Client, error) {
+	client, err := c.unwrap()
+	if err != nil {
+		return nil, err
+	}
+	return client.(release.Client), nil
+}
+
+func (c *lazyReleaseClient) CreateFolder(ctx context.Context, args release.
+	CreateFolderArgs) (*release.
+	Folder, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateFolder(ctx, args)
+}
+
+func (c *lazyReleaseClient) CreateRelease(ctx context.Context, args release.
+	CreateReleaseArgs) (*release.
+	Release, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateRelease(ctx, args)
+}
+
+func (c *lazyReleaseClient) CreateReleaseDefinition(ctx context.Context, args release.
+	CreateReleaseDefinitionArgs) (*release.
+	ReleaseDefinition, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateReleaseDefinition(ctx, args)
+}
+
+func (c *lazyReleaseClient) DeleteFolder(ctx context.Context, args release.
+	DeleteFolderArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteFolder(ctx, args)
+}
+
+func (c *lazyReleaseClient) DeleteReleaseDefinition(ctx context.Context, args release.
+	DeleteReleaseDefinitionArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteReleaseDefinition(ctx, args)
+}
+
+func (c *lazyReleaseClient) GetApprovals(ctx context.Context, args release.
+	GetApprovalsArgs) (*release.
+	GetApprovalsResponseValue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetApprovals(ctx, args)
+}
+
+func (c *lazyReleaseClient) GetDefinitionRevision(ctx context.Context, args release.
+	GetDefinitionRevisionArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDefinitionRevision(ctx, args)
+}
+
+func (c *lazyReleaseClient) GetDeployments(ctx context.Context, args release.
+	GetDeploymentsArgs) (*release.
+	GetDeploymentsResponseValue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDeployments(ctx, args)
+}
+
+func (c *lazyReleaseClient) GetFolders(ctx context.Context, args release.
+	GetFoldersArgs) (*[]release.
+	Folder, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetFolders(ctx, args)
+}
+
+func (c *lazyReleaseClient) GetLogs(ctx context.Context, args release.
+	GetLogsArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetLogs(ctx, args)
+}
+
+func (c *lazyReleaseClient) GetManualIntervention(ctx context.Context, args release.
+	GetManualInterventionArgs) (*release.
+	ManualIntervention, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetManualIntervention(ctx, args)
+}
+
+func (c *lazyReleaseClient) GetManualInterventions(ctx context.Context, args release.
+	GetManualInterventionsArgs) (*[]release.
+	ManualIntervention, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetManualInterventions(ctx, args)
+}
+
+func (c *lazyReleaseClient) GetRelease(ctx context.Context, args release.
+	GetReleaseArgs) (*release.
+	Release, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRelease(ctx, args)
+}
+
+func (c *lazyReleaseClient) GetReleaseDefinition(ctx context.Context, args release.
+	GetReleaseDefinitionArgs) (*release.
+	ReleaseDefinition, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetReleaseDefinition(ctx, args)
+}
+
+func (c *lazyReleaseClient) GetReleaseDefinitionHistory(ctx context.Context, args release.
+	GetReleaseDefinitionHistoryArgs) (*[]release.
+	ReleaseDefinitionRevision, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetReleaseDefinitionHistory(ctx, args)
+}
+
+func (c *lazyReleaseClient) GetReleaseDefinitions(ctx context.Context, args release.
+	GetReleaseDefinitionsArgs) (*release.
+	GetReleaseDefinitionsResponseValue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetReleaseDefinitions(ctx, args)
+}
+
+func (c *lazyReleaseClient) GetReleaseEnvironment(ctx context.Context, args release.
+	GetReleaseEnvironmentArgs) (*release.
+	ReleaseEnvironment, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetReleaseEnvironment(ctx, args)
+}
+
+func (c *lazyReleaseClient) GetReleaseRevision(ctx context.Context, args release.
+	GetReleaseRevisionArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetReleaseRevision(ctx, args)
+}
+
+func (c *lazyReleaseClient) GetReleases(ctx context.Context, args release.
+	GetReleasesArgs) (*release.
+	GetReleasesResponseValue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetReleases(ctx, args)
+}
+
+func (c *lazyReleaseClient) GetReleaseTaskAttachmentContent(ctx context.Context, args release.
+	GetReleaseTaskAttachmentContentArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetReleaseTaskAttachmentContent(ctx, args)
+}
+
+func (c *lazyReleaseClient) GetReleaseTaskAttachments(ctx context.Context, args release.
+	GetReleaseTaskAttachmentsArgs) (*[]release.
+	ReleaseTaskAttachment, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetReleaseTaskAttachments(ctx, args)
+}
+
+func (c *lazyReleaseClient) GetTaskLog(ctx context.Context, args release.
+	GetTaskLogArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTaskLog(ctx, args)
+}
+
+func (c *lazyReleaseClient) UpdateFolder(ctx context.Context, args release.
+	UpdateFolderArgs) (*release.
+	Folder, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateFolder(ctx, args)
+}
+
+func (c *lazyReleaseClient) UpdateGates(ctx context.Context, args release.
+	UpdateGatesArgs) (*release.
+	ReleaseGates, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateGates(ctx, args)
+}
+
+func (c *lazyReleaseClient) UpdateManualIntervention(ctx context.Context, args release.
+	UpdateManualInterventionArgs) (*release.
+	ManualIntervention, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateManualIntervention(ctx, args)
+}
+
+func (c *lazyReleaseClient) UpdateRelease(ctx context.Context, args release.
+	UpdateReleaseArgs) (*release.
+	Release, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateRelease(ctx, args)
+}
+
+func (c *lazyReleaseClient) UpdateReleaseApproval(ctx context.Context, args release.
+	UpdateReleaseApprovalArgs) (*release.
+	ReleaseApproval, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateReleaseApproval(ctx, args)
+}
+
+func (c *lazyReleaseClient) UpdateReleaseDefinition(ctx context.Context, args release.
+	UpdateReleaseDefinitionArgs) (*release.
+	ReleaseDefinition, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateReleaseDefinition(ctx, args)
+}
+
+func (c *lazyReleaseClient) UpdateReleaseEnvironment(ctx context.Context, args release.
+	UpdateReleaseEnvironmentArgs) (*release.
+	ReleaseEnvironment, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateReleaseEnvironment(ctx, args)
+}
+
+func (c *lazyReleaseClient) UpdateReleaseResource(ctx context.Context, args release.
+	UpdateReleaseResourceArgs) (*release.
+	Release, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateReleaseResource(ctx, args)
+}
+
+// lazySecurityClient creates the security client of the SDK on its first request
+type lazySecurityClient struct {
+	lazyClient
+}
+
+func (c *lazySecurityClient) client() (security.Client, error) {
+	client, err := c.unwrap()
+	if err != nil {
+		return nil, err
+	}
+	return client.(security.Client), nil
+}
+
+func (c *lazySecurityClient) HasPermissions(ctx context.Context, args security.
+	HasPermissionsArgs) (*[]bool, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.HasPermissions(ctx, args)
+}
+
+func (c *lazySecurityClient) HasPermissionsBatch(ctx context.Context, args security.
+	HasPermissionsBatchArgs) (*security.
+	PermissionEvaluationBatch, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.HasPermissionsBatch(ctx, args)
+}
+
+func (c *lazySecurityClient) QueryAccessControlLists(ctx context.Context, args security.
+	QueryAccessControlListsArgs) (*[]security.
+	AccessControlList, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.QueryAccessControlLists(ctx, args)
+}
+
+func (c *lazySecurityClient) QuerySecurityNamespaces(ctx context.Context, args security.
+	QuerySecurityNamespacesArgs) (*[]security.
+	SecurityNamespaceDescription, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.QuerySecurityNamespaces(ctx, args)
+}
+
+func (c *lazySecurityClient) RemoveAccessControlEntries(ctx context.Context, args security.
+	RemoveAccessControlEntriesArgs) (*bool, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.RemoveAccessControlEntries(ctx, args)
+}
+
+func (c *lazySecurityClient) RemoveAccessControlLists(ctx context.Context, args security.
+	RemoveAccessControlListsArgs) (*bool, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.RemoveAccessControlLists(ctx, args)
+}
+
+func (c *lazySecurityClient) RemovePermission(ctx context.Context, args security.
+	RemovePermissionArgs) (*security.
+	AccessControlEntry, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.RemovePermission(ctx, args)
+}
+
+func (c *lazySecurityClient) SetAccessControlEntries(ctx context.Context, args security.
+	SetAccessControlEntriesArgs) (*[]security.
+	AccessControlEntry, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.SetAccessControlEntries(ctx, args)
+}
+
+func (c *lazySecurityClient) SetAccessControlLists(ctx context.Context, args security.
+	SetAccessControlListsArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.SetAccessControlLists(ctx, args)
+}
+
+// lazyServiceendpointClient creates the serviceendpoint client of the SDK on its first request
+type lazyServiceendpointClient struct {
+	lazyClient
+}
+
+func (c *lazyServiceendpointClient) client() (serviceendpoint.Client, error) {
+	client, err := c.unwrap()
+	if err != nil {
+		return nil, err
+	}
+	return client.(serviceendpoint.Client), nil
+}
+
+func (c *lazyServiceendpointClient) CreateServiceEndpoint(ctx context.Context, args serviceendpoint.
+	CreateServiceEndpointArgs) (*serviceendpoint.
+	ServiceEndpoint, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateServiceEndpoint(ctx, args)
+}
+
+func (c *lazyServiceendpointClient) DeleteServiceEndpoint(ctx context.Context, args serviceendpoint.
+	DeleteServiceEndpointArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteServiceEndpoint(ctx, args)
+}
+
+func (c *lazyServiceendpointClient) ExecuteServiceEndpointRequest(ctx context.Context, args serviceendpoint.
+	ExecuteServiceEndpointRequestArgs) (*serviceendpoint.
+	ServiceEndpointRequestResult, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ExecuteServiceEndpointRequest(ctx, args)
+}
+
+func (c *lazyServiceendpointClient) GetServiceEndpointDetails(ctx context.Context, args serviceendpoint.
+	GetServiceEndpointDetailsArgs) (*serviceendpoint.
+	ServiceEndpoint, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetServiceEndpointDetails(ctx, args)
+}
+
+func (c *lazyServiceendpointClient) GetServiceEndpointExecutionRecords(ctx context.Context, args serviceendpoint.
+	GetServiceEndpointExecutionRecordsArgs) (*serviceendpoint.
+	GetServiceEndpointExecutionRecordsResponseValue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetServiceEndpointExecutionRecords(ctx, args)
+}
+
+func (c *lazyServiceendpointClient) GetServiceEndpoints(ctx context.Context, args serviceendpoint.
+	GetServiceEndpointsArgs) (*[]serviceendpoint.
+	ServiceEndpoint, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetServiceEndpoints(ctx, args)
+}
+
+func (c *lazyServiceendpointClient) GetServiceEndpointsByNames(ctx context.Context, args serviceendpoint.
+	GetServiceEndpointsByNamesArgs) (*[]serviceendpoint.
+	ServiceEndpoint, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetServiceEndpointsByNames(ctx, args)
+}
+
+func (c *lazyServiceendpointClient) GetServiceEndpointsWithRefreshedAuthentication(ctx context.Context, args serviceendpoint.
+	GetServiceEndpointsWithRefreshedAuthenticationArgs) (*[]serviceendpoint.
+	ServiceEndpoint, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetServiceEndpointsWithRefreshedAuthentication(ctx, args)
+}
+
+func (c *lazyServiceendpointClient) GetServiceEndpointTypes(ctx context.Context, args serviceendpoint.
+	GetServiceEndpointTypesArgs) (*[]serviceendpoint.
+	ServiceEndpointType, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetServiceEndpointTypes(ctx, args)
+}
+
+func (c *lazyServiceendpointClient) ShareServiceEndpoint(ctx context.Context, args serviceendpoint.
+	ShareServiceEndpointArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.ShareServiceEndpoint(ctx, args)
+}
+
+func (c *lazyServiceendpointClient) UpdateServiceEndpoint(ctx context.Context, args serviceendpoint.
+	UpdateServiceEndpointArgs) (*serviceendpoint.
+	ServiceEndpoint, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateServiceEndpoint(ctx, args)
+}
+
+func (c *lazyServiceendpointClient) UpdateServiceEndpoints(ctx context.Context, args serviceendpoint.
+	UpdateServiceEndpointsArgs) (*[]serviceendpoint.
+	ServiceEndpoint, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateServiceEndpoints(ctx, args)
+}
+
+// lazyTaskagentClient creates the taskagent client of the SDK on its first request
+type lazyTaskagentClient struct {
+	lazyClient
+}
+
+func (c *lazyTaskagentClient) client() (taskagent.Client, error) {
+	client, err := c.unwrap()
+	if err != nil {
+		return nil, err
+	}
+	return client.(taskagent.Client), nil
+}
+
+func (c *lazyTaskagentClient) AddAgent(ctx context.Context, args taskagent.
+	AddAgentArgs) (*taskagent.
+	TaskAgent, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AddAgent(ctx, args)
+}
+
+func (c *lazyTaskagentClient) AddAgentCloud(ctx context.Context, args taskagent.
+	AddAgentCloudArgs) (*taskagent.
+	TaskAgentCloud, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AddAgentCloud(ctx, args)
+}
+
+func (c *lazyTaskagentClient) AddAgentPool(ctx context.Context, args taskagent.
+	AddAgentPoolArgs) (*taskagent.
+	TaskAgentPool, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AddAgentPool(ctx, args)
+}
+
+func (c *lazyTaskagentClient) AddAgentQueue(ctx context.Context, args taskagent.
+	AddAgentQueueArgs) (*taskagent.
+	TaskAgentQueue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AddAgentQueue(ctx, args)
+}
+
+func (c *lazyTaskagentClient) AddDeploymentGroup(ctx context.Context, args taskagent.
+	AddDeploymentGroupArgs) (*taskagent.
+	DeploymentGroup, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AddDeploymentGroup(ctx, args)
+}
+
+func (c *lazyTaskagentClient) AddEnvironment(ctx context.Context, args taskagent.
+	AddEnvironmentArgs) (*taskagent.
+	EnvironmentInstance, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AddEnvironment(ctx, args)
+}
+
+func (c *lazyTaskagentClient) AddKubernetesResource(ctx context.Context, args taskagent.
+	AddKubernetesResourceArgs) (*taskagent.
+	KubernetesResource, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AddKubernetesResource(ctx, args)
+}
+
+func (c *lazyTaskagentClient) AddTaskGroup(ctx context.Context, args taskagent.
+	AddTaskGroupArgs) (*taskagent.
+	TaskGroup, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AddTaskGroup(ctx, args)
+}
+
+func (c *lazyTaskagentClient) AddVariableGroup(ctx context.Context, args taskagent.
+	AddVariableGroupArgs) (*taskagent.
+	VariableGroup, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AddVariableGroup(ctx, args)
+}
+
+func (c *lazyTaskagentClient) DeleteAgent(ctx context.Context, args taskagent.
+	DeleteAgentArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteAgent(ctx, args)
+}
+
+func (c *lazyTaskagentClient) DeleteAgentCloud(ctx context.Context, args taskagent.
+	DeleteAgentCloudArgs) (*taskagent.
+	TaskAgentCloud, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.DeleteAgentCloud(ctx, args)
+}
+
+func (c *lazyTaskagentClient) DeleteAgentPool(ctx context.Context, args taskagent.
+	DeleteAgentPoolArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteAgentPool(ctx, args)
+}
+
+func (c *lazyTaskagentClient) DeleteAgentQueue(ctx context.Context, args taskagent.
+	DeleteAgentQueueArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteAgentQueue(ctx, args)
+}
+
+func (c *lazyTaskagentClient) DeleteDeploymentGroup(ctx context.Context, args taskagent.
+	DeleteDeploymentGroupArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteDeploymentGroup(ctx, args)
+}
+
+func (c *lazyTaskagentClient) DeleteDeploymentTarget(ctx context.Context, args taskagent.
+	DeleteDeploymentTargetArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteDeploymentTarget(ctx, args)
+}
+
+func (c *lazyTaskagentClient) DeleteEnvironment(ctx context.Context, args taskagent.
+	DeleteEnvironmentArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteEnvironment(ctx, args)
+}
+
+func (c *lazyTaskagentClient) DeleteKubernetesResource(ctx context.Context, args taskagent.
+	DeleteKubernetesResourceArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteKubernetesResource(ctx, args)
+}
+
+func (c *lazyTaskagentClient) DeleteTaskGroup(ctx context.Context, args taskagent.
+	DeleteTaskGroupArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteTaskGroup(ctx, args)
+}
+
+func (c *lazyTaskagentClient) DeleteVariableGroup(ctx context.Context, args taskagent.
+	DeleteVariableGroupArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteVariableGroup(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetAgent(ctx context.Context, args taskagent.
+	GetAgentArgs) (*taskagent.
+	TaskAgent, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAgent(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetAgentCloud(ctx context.Context, args taskagent.
+	GetAgentCloudArgs) (*taskagent.
+	TaskAgentCloud, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAgentCloud(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetAgentCloudRequests(ctx context.Context, args taskagent.
+	GetAgentCloudRequestsArgs) (*[]taskagent.
+	TaskAgentCloudRequest, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAgentCloudRequests(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetAgentClouds(ctx context.Context, args taskagent.
+	GetAgentCloudsArgs) (*[]taskagent.
+	TaskAgentCloud, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAgentClouds(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetAgentCloudTypes(ctx context.Context, args taskagent.
+	GetAgentCloudTypesArgs) (*[]taskagent.
+	TaskAgentCloudType, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAgentCloudTypes(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetAgentPool(ctx context.Context, args taskagent.
+	GetAgentPoolArgs) (*taskagent.
+	TaskAgentPool, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAgentPool(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetAgentPools(ctx context.Context, args taskagent.
+	GetAgentPoolsArgs) (*[]taskagent.
+	TaskAgentPool, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAgentPools(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetAgentPoolsByIds(ctx context.Context, args taskagent.
+	GetAgentPoolsByIdsArgs) (*[]taskagent.
+	TaskAgentPool, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAgentPoolsByIds(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetAgentQueue(ctx context.Context, args taskagent.
+	GetAgentQueueArgs) (*taskagent.
+	TaskAgentQueue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAgentQueue(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetAgentQueues(ctx context.Context, args taskagent.
+	GetAgentQueuesArgs) (*[]taskagent.
+	TaskAgentQueue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAgentQueues(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetAgentQueuesByIds(ctx context.Context, args taskagent.
+	GetAgentQueuesByIdsArgs) (*[]taskagent.
+	TaskAgentQueue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAgentQueuesByIds(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetAgentQueuesByNames(ctx context.Context, args taskagent.
+	GetAgentQueuesByNamesArgs) (*[]taskagent.
+	TaskAgentQueue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAgentQueuesByNames(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetAgentQueuesForPools(ctx context.Context, args taskagent.
+	GetAgentQueuesForPoolsArgs) (*[]taskagent.
+	TaskAgentQueue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAgentQueuesForPools(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetAgents(ctx context.Context, args taskagent.
+	GetAgentsArgs) (*[]taskagent.
+	TaskAgent, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAgents(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetDeploymentGroup(ctx context.Context, args taskagent.
+	GetDeploymentGroupArgs) (*taskagent.
+	DeploymentGroup, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDeploymentGroup(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetDeploymentGroups(ctx context.Context, args taskagent.
+	GetDeploymentGroupsArgs) (*taskagent.
+	GetDeploymentGroupsResponseValue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDeploymentGroups(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetDeploymentTarget(ctx context.Context, args taskagent.
+	GetDeploymentTargetArgs) (*taskagent.
+	DeploymentMachine, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDeploymentTarget(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetDeploymentTargets(ctx context.Context, args taskagent.
+	GetDeploymentTargetsArgs) (*taskagent.
+	GetDeploymentTargetsResponseValue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDeploymentTargets(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetEnvironmentById(ctx context.Context, args taskagent.
+	GetEnvironmentByIdArgs) (*taskagent.
+	EnvironmentInstance, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetEnvironmentById(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetEnvironmentDeploymentExecutionRecords(ctx context.Context, args taskagent.
+	GetEnvironmentDeploymentExecutionRecordsArgs) (*taskagent.
+	GetEnvironmentDeploymentExecutionRecordsResponseValue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetEnvironmentDeploymentExecutionRecords(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetEnvironments(ctx context.Context, args taskagent.
+	GetEnvironmentsArgs) (*taskagent.
+	GetEnvironmentsResponseValue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetEnvironments(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetKubernetesResource(ctx context.Context, args taskagent.
+	GetKubernetesResourceArgs) (*taskagent.
+	KubernetesResource, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetKubernetesResource(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetTaskGroups(ctx context.Context, args taskagent.
+	GetTaskGroupsArgs) (*[]taskagent.
+	TaskGroup, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTaskGroups(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetVariableGroup(ctx context.Context, args taskagent.
+	GetVariableGroupArgs) (*taskagent.
+	VariableGroup, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetVariableGroup(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetVariableGroups(ctx context.Context, args taskagent.
+	GetVariableGroupsArgs) (*[]taskagent.
+	VariableGroup, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetVariableGroups(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetVariableGroupsById(ctx context.Context, args taskagent.
+	GetVariableGroupsByIdArgs) (*[]taskagent.
+	VariableGroup, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetVariableGroupsById(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetVirtualMachines(ctx context.Context, args taskagent.
+	GetVirtualMachinesArgs) (*taskagent.
+	GetVirtualMachinesResponseValue, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetVirtualMachines(ctx, args)
+}
+
+func (c *lazyTaskagentClient) GetYamlSchema(ctx context.Context, args taskagent.
+	GetYamlSchemaArgs) (interface{}, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetYamlSchema(ctx, args)
+}
+
+func (c *lazyTaskagentClient) ReplaceAgent(ctx context.Context, args taskagent.
+	ReplaceAgentArgs) (*taskagent.
+	TaskAgent, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ReplaceAgent(ctx, args)
+}
+
+func (c *lazyTaskagentClient) ShareVariableGroup(ctx context.Context, args taskagent.
+	ShareVariableGroupArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.ShareVariableGroup(ctx, args)
+}
+
+func (c *lazyTaskagentClient) UpdateAgent(ctx context.Context, args taskagent.
+	UpdateAgentArgs) (*taskagent.
+	TaskAgent, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateAgent(ctx, args)
+}
+
+func (c *lazyTaskagentClient) UpdateAgentCloud(ctx context.Context, args taskagent.
+	UpdateAgentCloudArgs) (*taskagent.
+	TaskAgentCloud, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateAgentCloud(ctx, args)
+}
+
+func (c *lazyTaskagentClient) UpdateAgentPool(ctx context.Context, args taskagent.
+	UpdateAgentPoolArgs) (*taskagent.
+	TaskAgentPool, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateAgentPool(ctx, args)
+}
+
+func (c *lazyTaskagentClient) UpdateDeploymentGroup(ctx context.Context, args taskagent.
+	UpdateDeploymentGroupArgs) (*taskagent.
+	DeploymentGroup, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateDeploymentGroup(ctx, args)
+}
+
+func (c *lazyTaskagentClient) UpdateDeploymentTargets(ctx context.Context, args taskagent.
+	UpdateDeploymentTargetsArgs) (*[]taskagent.
+	DeploymentMachine, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateDeploymentTargets(ctx, args)
+}
+
+func (c *lazyTaskagentClient) UpdateEnvironment(ctx context.Context, args taskagent.
+	UpdateEnvironmentArgs) (*taskagent.
+	EnvironmentInstance, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateEnvironment(ctx, args)
+}
+
+func (c *lazyTaskagentClient) UpdateTaskGroup(ctx context.Context, args taskagent.
+	UpdateTaskGroupArgs) (*taskagent.
+	TaskGroup, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateTaskGroup(ctx, args)
+}
+
+func (c *lazyTaskagentClient) UpdateVariableGroup(ctx context.Context, args taskagent.
+	UpdateVariableGroupArgs) (*taskagent.
+	VariableGroup, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateVariableGroup(ctx, args)
+}
+
+func (c *lazyTaskagentClient) UpdateVirtualMachines(ctx context.Context, args taskagent.
+	UpdateVirtualMachinesArgs) (*[]taskagent.
+	VirtualMachine, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateVirtualMachines(ctx, args)
+}
+
+// lazyWorkitemtrackingClient creates the workitemtracking client of the SDK on its first request
+type lazyWorkitemtrackingClient struct {
+	lazyClient
+}
+
+func (c *lazyWorkitemtrackingClient) client() (workitemtracking.Client, error) {
+	client, err := c.unwrap()
+	if err != nil {
+		return nil, err
+	}
+	return client.(workitemtracking.Client), nil
+}
+
+func (c *lazyWorkitemtrackingClient) AddComment(ctx context.Context, args workitemtracking.
+	AddCommentArgs) (*workitemtracking.
+	Comment, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.AddComment(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) CreateAttachment(ctx context.Context, args workitemtracking.
+	CreateAttachmentArgs) (*workitemtracking.
+	AttachmentReference, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateAttachment(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) CreateCommentReaction(ctx context.Context, args workitemtracking.
+	CreateCommentReactionArgs) (*workitemtracking.
+	CommentReaction, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateCommentReaction(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) CreateField(ctx context.Context, args workitemtracking.
+	CreateFieldArgs) (*workitemtracking.
+	WorkItemField, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateField(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) CreateOrUpdateClassificationNode(ctx context.Context, args workitemtracking.
+	CreateOrUpdateClassificationNodeArgs) (*workitemtracking.
+	WorkItemClassificationNode, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateOrUpdateClassificationNode(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) CreateQuery(ctx context.Context, args workitemtracking.
+	CreateQueryArgs) (*workitemtracking.
+	QueryHierarchyItem, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateQuery(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) CreateTemplate(ctx context.Context, args workitemtracking.
+	CreateTemplateArgs) (*workitemtracking.
+	WorkItemTemplate, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateTemplate(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) CreateWorkItem(ctx context.Context, args workitemtracking.
+	CreateWorkItemArgs) (*workitemtracking.
+	WorkItem, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateWorkItem(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) DeleteClassificationNode(ctx context.Context, args workitemtracking.
+	DeleteClassificationNodeArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteClassificationNode(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) DeleteComment(ctx context.Context, args workitemtracking.
+	DeleteCommentArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteComment(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) DeleteCommentReaction(ctx context.Context, args workitemtracking.
+	DeleteCommentReactionArgs) (*workitemtracking.
+	CommentReaction, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.DeleteCommentReaction(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) DeleteField(ctx context.Context, args workitemtracking.
+	DeleteFieldArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteField(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) DeleteQuery(ctx context.Context, args workitemtracking.
+	DeleteQueryArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteQuery(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) DeleteTag(ctx context.Context, args workitemtracking.
+	DeleteTagArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteTag(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) DeleteTemplate(ctx context.Context, args workitemtracking.
+	DeleteTemplateArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DeleteTemplate(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) DeleteWorkItem(ctx context.Context, args workitemtracking.
+	DeleteWorkItemArgs) (*workitemtracking.
+	WorkItemDelete, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.DeleteWorkItem(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) DestroyWorkItem(ctx context.Context, args workitemtracking.
+	DestroyWorkItemArgs) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return client.DestroyWorkItem(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetAttachmentContent(ctx context.Context, args workitemtracking.
+	GetAttachmentContentArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAttachmentContent(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetAttachmentZip(ctx context.Context, args workitemtracking.
+	GetAttachmentZipArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetAttachmentZip(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetClassificationNode(ctx context.Context, args workitemtracking.
+	GetClassificationNodeArgs) (*workitemtracking.
+	WorkItemClassificationNode, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetClassificationNode(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetClassificationNodes(ctx context.Context, args workitemtracking.
+	GetClassificationNodesArgs) (*[]workitemtracking.
+	WorkItemClassificationNode, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetClassificationNodes(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetComment(ctx context.Context, args workitemtracking.
+	GetCommentArgs) (*workitemtracking.
+	Comment, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetComment(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetCommentReactions(ctx context.Context, args workitemtracking.
+	GetCommentReactionsArgs) (*[]workitemtracking.
+	CommentReaction, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetCommentReactions(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetComments(ctx context.Context, args workitemtracking.
+	GetCommentsArgs) (*workitemtracking.
+	CommentList, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetComments(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetCommentsBatch(ctx context.Context, args workitemtracking.
+	GetCommentsBatchArgs) (*workitemtracking.
+	CommentList, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetCommentsBatch(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetCommentVersion(ctx context.Context, args workitemtracking.
+	GetCommentVersionArgs) (*workitemtracking.
+	CommentVersion, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetCommentVersion(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetCommentVersions(ctx context.Context, args workitemtracking.
+	GetCommentVersionsArgs) (*[]workitemtracking.
+	CommentVersion, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetCommentVersions(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetDeletedWorkItem(ctx context.Context, args workitemtracking.
+	GetDeletedWorkItemArgs) (*workitemtracking.
+	WorkItemDelete, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDeletedWorkItem(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetDeletedWorkItems(ctx context.Context, args workitemtracking.
+	GetDeletedWorkItemsArgs) (*[]workitemtracking.
+	WorkItemDeleteReference, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDeletedWorkItems(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetDeletedWorkItemShallowReferences(ctx context.Context, args workitemtracking.
+	GetDeletedWorkItemShallowReferencesArgs) (*[]workitemtracking.
+	WorkItemDeleteShallowReference, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDeletedWorkItemShallowReferences(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetEngagedUsers(ctx context.Context, args workitemtracking.
+	GetEngagedUsersArgs) (*[]webapi.IdentityRef, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetEngagedUsers(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetField(ctx context.Context, args workitemtracking.
+	GetFieldArgs) (*workitemtracking.
+	WorkItemField, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetField(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetFields(ctx context.Context, args workitemtracking.
+	GetFieldsArgs) (*[]workitemtracking.
+	WorkItemField, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetFields(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetQueries(ctx context.Context, args workitemtracking.
+	GetQueriesArgs) (*[]workitemtracking.
+	QueryHierarchyItem, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetQueries(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetQueriesBatch(ctx context.Context, args workitemtracking.
+	GetQueriesBatchArgs) (*[]workitemtracking.
+	QueryHierarchyItem, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetQueriesBatch(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetQuery(ctx context.Context, args workitemtracking.
+	GetQueryArgs) (*workitemtracking.
+	QueryHierarchyItem, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetQuery(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetQueryResultCount(ctx context.Context, args workitemtracking.
+	GetQueryResultCountArgs) (*int, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetQueryResultCount(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetRecentActivityData(ctx context.Context, args workitemtracking.
+	GetRecentActivityDataArgs) (*[]workitemtracking.
+	AccountRecentActivityWorkItemModel2, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRecentActivityData(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetRelationType(ctx context.Context, args workitemtracking.
+	GetRelationTypeArgs) (*workitemtracking.
+	WorkItemRelationType, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRelationType(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetRelationTypes(ctx context.Context, args workitemtracking.
+	GetRelationTypesArgs) (*[]workitemtracking.
+	WorkItemRelationType, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRelationTypes(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetReportingLinksByLinkType(ctx context.Context, args workitemtracking.
+	GetReportingLinksByLinkTypeArgs) (*workitemtracking.
+	ReportingWorkItemLinksBatch, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetReportingLinksByLinkType(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetRevision(ctx context.Context, args workitemtracking.
+	GetRevisionArgs) (*workitemtracking.
+	WorkItem, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRevision(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetRevisions(ctx context.Context, args workitemtracking.
+	GetRevisionsArgs) (*[]workitemtracking.
+	WorkItem, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRevisions(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetRootNodes(ctx context.Context, args workitemtracking.
+	GetRootNodesArgs) (*[]workitemtracking.
+	WorkItemClassificationNode, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRootNodes(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetTag(ctx context.Context, args workitemtracking.
+	GetTagArgs) (*workitemtracking.
+	WorkItemTagDefinition, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTag(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetTags(ctx context.Context, args workitemtracking.
+	GetTagsArgs) (*[]workitemtracking.
+	WorkItemTagDefinition, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTags(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetTemplate(ctx context.Context, args workitemtracking.
+	GetTemplateArgs) (*workitemtracking.
+	WorkItemTemplate, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTemplate(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetTemplates(ctx context.Context, args workitemtracking.
+	GetTemplatesArgs) (*[]workitemtracking.
+	WorkItemTemplateReference, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTemplates(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetUpdate(ctx context.Context, args workitemtracking.
+	GetUpdateArgs) (*workitemtracking.
+	WorkItemUpdate, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetUpdate(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetUpdates(ctx context.Context, args workitemtracking.
+	GetUpdatesArgs) (*[]workitemtracking.
+	WorkItemUpdate, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetUpdates(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetWorkArtifactLinkTypes(ctx context.Context, args workitemtracking.
+	GetWorkArtifactLinkTypesArgs) (*[]workitemtracking.
+	WorkArtifactLink, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetWorkArtifactLinkTypes(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetWorkItem(ctx context.Context, args workitemtracking.
+	GetWorkItemArgs) (*workitemtracking.
+	WorkItem, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetWorkItem(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetWorkItemIconJson(ctx context.Context, args workitemtracking.
+	GetWorkItemIconJsonArgs) (*workitemtracking.
+	WorkItemIcon, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetWorkItemIconJson(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetWorkItemIcons(ctx context.Context, args workitemtracking.
+	GetWorkItemIconsArgs) (*[]workitemtracking.
+	WorkItemIcon, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetWorkItemIcons(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetWorkItemIconSvg(ctx context.Context, args workitemtracking.
+	GetWorkItemIconSvgArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetWorkItemIconSvg(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetWorkItemIconXaml(ctx context.Context, args workitemtracking.
+	GetWorkItemIconXamlArgs) (io.ReadCloser, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetWorkItemIconXaml(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetWorkItemNextStatesOnCheckinAction(ctx context.Context, args workitemtracking.
+	GetWorkItemNextStatesOnCheckinActionArgs) (*[]workitemtracking.
+	WorkItemNextStateOnTransition, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetWorkItemNextStatesOnCheckinAction(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetWorkItems(ctx context.Context, args workitemtracking.
+	GetWorkItemsArgs) (*[]workitemtracking.
+	WorkItem, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetWorkItems(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetWorkItemsBatch(ctx context.Context, args workitemtracking.
+	GetWorkItemsBatchArgs) (*[]workitemtracking.
+	WorkItem, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetWorkItemsBatch(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetWorkItemTemplate(ctx context.Context, args workitemtracking.
+	GetWorkItemTemplateArgs) (*workitemtracking.
+	WorkItem, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetWorkItemTemplate(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetWorkItemType(ctx context.Context, args workitemtracking.
+	GetWorkItemTypeArgs) (*workitemtracking.
+	WorkItemType, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetWorkItemType(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetWorkItemTypeCategories(ctx context.Context, args workitemtracking.
+	GetWorkItemTypeCategoriesArgs) (*[]workitemtracking.
+	WorkItemTypeCategory, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetWorkItemTypeCategories(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetWorkItemTypeCategory(ctx context.Context, args workitemtracking.
+	GetWorkItemTypeCategoryArgs) (*workitemtracking.
+	WorkItemTypeCategory, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetWorkItemTypeCategory(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetWorkItemTypeFieldsWithReferences(ctx context.Context, args workitemtracking.
+	GetWorkItemTypeFieldsWithReferencesArgs) (*[]workitemtracking.
+	WorkItemTypeFieldWithReferences, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetWorkItemTypeFieldsWithReferences(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetWorkItemTypeFieldWithReferences(ctx context.Context, args workitemtracking.
+	GetWorkItemTypeFieldWithReferencesArgs) (*workitemtracking.
+	WorkItemTypeFieldWithReferences, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetWorkItemTypeFieldWithReferences(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetWorkItemTypes(ctx context.Context, args workitemtracking.
+	GetWorkItemTypesArgs) (*[]workitemtracking.
+	WorkItemType, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetWorkItemTypes(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) GetWorkItemTypeStates(ctx context.Context, args workitemtracking.
+	GetWorkItemTypeStatesArgs) (*[]workitemtracking.
+	WorkItemStateColor, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetWorkItemTypeStates(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) MigrateProjectsProcess(ctx context.Context, args workitemtracking.
+	MigrateProjectsProcessArgs) (*workitemtracking.
+	ProcessMigrationResultModel, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.MigrateProjectsProcess(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) QueryById(ctx context.Context, args workitemtracking.
+	QueryByIdArgs) (*workitemtracking.
+	WorkItemQueryResult, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.QueryById(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) QueryByWiql(ctx context.Context, args workitemtracking.
+	QueryByWiqlArgs) (*workitemtracking.
+	WorkItemQueryResult, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.QueryByWiql(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) QueryWorkItemsForArtifactUris(ctx context.Context, args workitemtracking.
+	QueryWorkItemsForArtifactUrisArgs) (*workitemtracking.
+	ArtifactUriQueryResult, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.QueryWorkItemsForArtifactUris(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) ReadReportingDiscussions(ctx context.Context, args workitemtracking.
+	ReadReportingDiscussionsArgs) (*workitemtracking.
+	ReportingWorkItemRevisionsBatch, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ReadReportingDiscussions(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) ReadReportingRevisionsGet(ctx context.Context, args workitemtracking.
+	ReadReportingRevisionsGetArgs) (*workitemtracking.
+	ReportingWorkItemRevisionsBatch, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ReadReportingRevisionsGet(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) ReadReportingRevisionsPost(ctx context.Context, args workitemtracking.
+	ReadReportingRevisionsPostArgs) (*workitemtracking.
+	ReportingWorkItemRevisionsBatch, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ReadReportingRevisionsPost(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) ReplaceTemplate(ctx context.Context, args workitemtracking.
+	ReplaceTemplateArgs) (*workitemtracking.
+	WorkItemTemplate, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ReplaceTemplate(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) RestoreWorkItem(ctx context.Context, args workitemtracking.
+	RestoreWorkItemArgs) (*workitemtracking.
+	WorkItemDelete, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.RestoreWorkItem(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) SearchQueries(ctx context.Context, args workitemtracking.
+	SearchQueriesArgs) (*workitemtracking.
+	QueryHierarchyItemsResult, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.SearchQueries(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) UpdateClassificationNode(ctx context.Context, args workitemtracking.
+	UpdateClassificationNodeArgs) (*workitemtracking.
+	WorkItemClassificationNode, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateClassificationNode(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) UpdateComment(ctx context.Context, args workitemtracking.
+	UpdateCommentArgs) (*workitemtracking.
+	Comment, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateComment(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) UpdateField(ctx context.Context, args workitemtracking.
+	UpdateFieldArgs) (*workitemtracking.
+	WorkItemField, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateField(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) UpdateQuery(ctx context.Context, args workitemtracking.
+	UpdateQueryArgs) (*workitemtracking.
+	QueryHierarchyItem, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateQuery(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) UpdateTag(ctx context.Context, args workitemtracking.
+	UpdateTagArgs) (*workitemtracking.
+	WorkItemTagDefinition, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateTag(ctx, args)
+}
+
+func (c *lazyWorkitemtrackingClient) UpdateWorkItem(ctx context.Context, args workitemtracking.
+	UpdateWorkItemArgs) (*workitemtracking.
+	WorkItem, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateWorkItem(ctx, args)
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/graph"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that the client of an API area is created by its first request and then reused
+func TestLazyClient_CreatedOnFirstUse(t *testing.T) {
+	var authorization string
+	collection := newCollectionServer(t, &authorization)
+	defer collection.Close()
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		collection.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	clients, err := GetAzdoClient(&AuthConfig{PersonalAccessToken: "pat"}, &TransportConfig{}, server.URL+"/tfs/DefaultCollection", "0.12.0")
+	require.Nil(t, err)
+	connected := atomic.LoadInt32(&requests)
+	require.Nil(t, clients.CoreClient.(*lazyCoreClient).sdk)
+	require.Nil(t, clients.BuildClient.(*lazyBuildClient).sdk)
+
+	coreClient, err := UnwrapClient(clients.CoreClient)
+	require.Nil(t, err)
+	require.IsType(t, &core.ClientImpl{}, coreClient)
+	require.True(t, atomic.LoadInt32(&requests) > connected, "the resource areas must be looked up by the first client")
+	require.Nil(t, clients.BuildClient.(*lazyBuildClient).sdk, "the clients of other API areas must not be created")
+
+	resolved := atomic.LoadInt32(&requests)
+	sameClient, err := UnwrapClient(clients.CoreClient)
+	require.Nil(t, err)
+	require.True(t, coreClient == sameClient)
+	buildClient, err := UnwrapClient(clients.BuildClient)
+	require.Nil(t, err)
+	require.IsType(t, &build.ClientImpl{}, buildClient)
+	require.Equal(t, resolved, atomic.LoadInt32(&requests), "the resource areas must be looked up once")
+}
+
+// verifies that the SDK client is reachable behind the identity cache of a lazy client
+func TestLazyClient_UnwrapCachedClient(t *testing.T) {
+	var authorization string
+	server := newCollectionServer(t, &authorization)
+	defer server.Close()
+
+	clients, err := GetAzdoClient(&AuthConfig{PersonalAccessToken: "pat"}, &TransportConfig{IdentityCacheTTL: DefaultIdentityCacheTTL}, server.URL+"/tfs/DefaultCollection", "0.12.0")
+	require.Nil(t, err)
+	require.IsType(t, &cachedGraphClient{}, clients.GraphClient)
+
+	graphClient, err := UnwrapClient(clients.GraphClient)
+	require.Nil(t, err)
+	require.IsType(t, &graph.ClientImpl{}, graphClient)
+}
+
+// verifies that a client that failed to be created is created again by the next request
+func TestLazyClient_RetriesFailedCreation(t *testing.T) {
+	attempts := 0
+	lazy := &lazyCoreClient{lazyClient{create: func() (interface{}, error) {
+		attempts++
+		if attempts == 1 {
+			return nil, errors.New("resource areas unavailable")
+		}
+		return &core.ClientImpl{}, nil
+	}}}
+
+	_, err := lazy.GetProjects(context.Background(), core.GetProjectsArgs{})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "resource areas unavailable")
+
+	sdkClient, err := UnwrapClient(lazy)
+	require.Nil(t, err)
+	require.IsType(t, &core.ClientImpl{}, sdkClient)
+	require.Equal(t, 2, attempts)
+}
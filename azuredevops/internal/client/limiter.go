@@ -0,0 +1,159 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// the API areas of the clients of AggregatedClient, used to limit the concurrent requests per area
+var apiAreas = []string{
+	"build",
+	"core",
+	"featuremanagement",
+	"git",
+	"graph",
+	"identity",
+	"memberentitlementmanagement",
+	"operations",
+	"policy",
+	"release",
+	"security",
+	"serviceendpoint",
+	"taskagent",
+	"workitemtracking",
+}
+
+// RequestLimiter caps the requests sent to Azure DevOps by all resources of the provider
+type RequestLimiter struct {
+	requests chan struct{}
+	areas    map[string]chan struct{}
+	budget   *rateBudget
+}
+
+func newRequestLimiter(organizationURL string, config *TransportConfig) (*RequestLimiter, error) {
+	limiter := &RequestLimiter{
+		areas: map[string]chan struct{}{},
+	}
+	if config.MaxConcurrentRequests > 0 {
+		limiter.requests = make(chan struct{}, config.MaxConcurrentRequests)
+	}
+	for area, limit := range config.MaxConcurrentRequestsPerArea {
+		if !isAPIArea(area) {
+			return nil, fmt.Errorf("unknown API area %q, expected one of %s", area, strings.Join(apiAreas, ", "))
+		}
+		if limit > 0 {
+			limiter.areas[area] = make(chan struct{}, limit)
+		}
+	}
+	if config.MaxRequestsPerSecond > 0 {
+		limiter.budget = getRateBudget(organizationURL, config.MaxRequestsPerSecond)
+	}
+	return limiter, nil
+}
+
+// acquire waits until the request can be sent, the returned function must be called once the request has completed
+func (l *RequestLimiter) acquire(ctx context.Context, area string) (func(), error) {
+	var acquired []chan struct{}
+	release := func() {
+		for _, slots := range acquired {
+			<-slots
+		}
+	}
+
+	// the area slot is taken first so that a request waiting for its area does not block other areas
+	for _, slots := range []chan struct{}{l.areas[area], l.requests} {
+		if slots == nil {
+			continue
+		}
+		select {
+		case slots <- struct{}{}:
+			acquired = append(acquired, slots)
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		}
+	}
+
+	if l.budget != nil {
+		if err := l.budget.wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+	return release, nil
+}
+
+// limitedTransport sends a request once the limiter allows it
+type limitedTransport struct {
+	limiter *RequestLimiter
+	area    string
+	base    http.RoundTripper
+}
+
+func (t *limitedTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	release, err := t.limiter.acquire(request.Context(), t.area)
+	if err != nil {
+		return nil, err
+	}
+	// the slot is released once the response headers arrive, not every caller of the SDK closes the response body
+	defer release()
+	return t.base.RoundTrip(request)
+}
+
+// rateBudget spaces the requests to an organization evenly to stay within the requests per second
+type rateBudget struct {
+	lock     sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// the rate budgets are shared by all provider configurations of an organization
+var rateBudgets = map[string]*rateBudget{}
+var rateBudgetsLock sync.Mutex
+
+// getRateBudget returns the budget of the organization, the lowest configured rate applies
+func getRateBudget(organizationURL string, requestsPerSecond float64) *rateBudget {
+	rateBudgetsLock.Lock()
+	defer rateBudgetsLock.Unlock()
+
+	interval := time.Duration(float64(time.Second) / requestsPerSecond)
+	key := normalizeOrganizationURL(organizationURL)
+	budget, ok := rateBudgets[key]
+	if !ok {
+		budget = &rateBudget{interval: interval}
+		rateBudgets[key] = budget
+	}
+
+	budget.lock.Lock()
+	defer budget.lock.Unlock()
+	if interval > budget.interval {
+		budget.interval = interval
+	}
+	return budget
+}
+
+func (b *rateBudget) wait(ctx context.Context) error {
+	b.lock.Lock()
+	now := time.Now()
+	if b.next.Before(now) {
+		b.next = now
+	}
+	wait := b.next.Sub(now)
+	b.next = b.next.Add(b.interval)
+	b.lock.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	return sleepWithContext(ctx, wait)
+}
+
+func isAPIArea(area string) bool {
+	i := sort.SearchStrings(apiAreas, area)
+	return i < len(apiAreas) && apiAreas[i] == area
+}
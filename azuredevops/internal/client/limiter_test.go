@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newConcurrencyServer returns a server that records the highest number of requests it handled at the same time
+func newConcurrencyServer() (*httptest.Server, *int32) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	return server, &maxInFlight
+}
+
+func sendConcurrently(t *testing.T, httpClient *http.Client, url string, count int) {
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			response, err := httpClient.Get(url)
+			require.Nil(t, err)
+			response.Body.Close()
+		}()
+	}
+	wg.Wait()
+}
+
+// verifies that no more than the configured number of requests are in flight
+func TestLimiter_MaxConcurrentRequests(t *testing.T) {
+	server, maxInFlight := newConcurrencyServer()
+	defer server.Close()
+
+	limiter, err := newRequestLimiter(server.URL, &TransportConfig{MaxConcurrentRequests: 2})
+	require.Nil(t, err)
+
+	graphClient := &http.Client{Transport: &limitedTransport{limiter: limiter, area: "graph", base: http.DefaultTransport}}
+	gitClient := &http.Client{Transport: &limitedTransport{limiter: limiter, area: "git", base: http.DefaultTransport}}
+
+	var wg sync.WaitGroup
+	for _, httpClient := range []*http.Client{graphClient, gitClient} {
+		wg.Add(1)
+		go func(httpClient *http.Client) {
+			defer wg.Done()
+			sendConcurrently(t, httpClient, server.URL, 5)
+		}(httpClient)
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(2), atomic.LoadInt32(maxInFlight))
+}
+
+// verifies that the limit of an area does not apply to the other areas
+func TestLimiter_MaxConcurrentRequestsPerArea(t *testing.T) {
+	securityServer, securityMaxInFlight := newConcurrencyServer()
+	defer securityServer.Close()
+	gitServer, gitMaxInFlight := newConcurrencyServer()
+	defer gitServer.Close()
+
+	limiter, err := newRequestLimiter(securityServer.URL, &TransportConfig{
+		MaxConcurrentRequestsPerArea: map[string]int{"security": 1},
+	})
+	require.Nil(t, err)
+
+	sendConcurrently(t, &http.Client{Transport: &limitedTransport{limiter: limiter, area: "security", base: http.DefaultTransport}}, securityServer.URL, 4)
+	sendConcurrently(t, &http.Client{Transport: &limitedTransport{limiter: limiter, area: "git", base: http.DefaultTransport}}, gitServer.URL, 4)
+
+	require.Equal(t, int32(1), atomic.LoadInt32(securityMaxInFlight))
+	require.True(t, atomic.LoadInt32(gitMaxInFlight) > 1)
+}
+
+func TestLimiter_UnknownArea_ReturnsError(t *testing.T) {
+	_, err := newRequestLimiter("https://dev.azure.com/org", &TransportConfig{
+		MaxConcurrentRequestsPerArea: map[string]int{"pipelines": 1},
+	})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), `unknown API area "pipelines"`)
+}
+
+// verifies that a request waiting for a slot gives up when its context is canceled
+func TestLimiter_Acquire_CanceledContext(t *testing.T) {
+	limiter, err := newRequestLimiter("https://dev.azure.com/org", &TransportConfig{MaxConcurrentRequests: 1})
+	require.Nil(t, err)
+
+	release, err := limiter.acquire(context.Background(), "core")
+	require.Nil(t, err)
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = limiter.acquire(ctx, "core")
+	require.Equal(t, context.DeadlineExceeded, err)
+}
+
+// verifies that the requests to an organization are spaced by the rate budget
+func TestLimiter_MaxRequestsPerSecond(t *testing.T) {
+	limiter, err := newRequestLimiter("https://dev.azure.com/rate-budget", &TransportConfig{MaxRequestsPerSecond: 20})
+	require.Nil(t, err)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		release, err := limiter.acquire(context.Background(), "core")
+		require.Nil(t, err)
+		release()
+	}
+	require.True(t, time.Since(start) >= 140*time.Millisecond, "4 requests at 20 per second took %s", time.Since(start))
+}
+
+// verifies that the provider configurations of an organization share the lowest budget
+func TestLimiter_RateBudget_SharedPerOrganization(t *testing.T) {
+	first := getRateBudget("https://dev.azure.com/shared-budget", 10)
+	second := getRateBudget("https://dev.azure.com/Shared-Budget/", 5)
+	other := getRateBudget("https://dev.azure.com/other-budget", 10)
+
+	require.True(t, first == second)
+	require.False(t, first == other)
+	require.Equal(t, 200*time.Millisecond, first.interval)
+}
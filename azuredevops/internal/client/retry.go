@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a throttled or failed request is retried
+	DefaultMaxRetries = 5
+
+	// DefaultRetryMaxWait is the longest time waited before a request is retried
+	DefaultRetryMaxWait = 60 * time.Second
+
+	retryBaseWait = time.Second
+)
+
+// retryTransport retries requests that are throttled by Azure DevOps or fail with a transient error.
+// The wait time is taken from the Retry-After and X-RateLimit-Reset headers and falls back to
+// an exponential backoff with jitter.
+type retryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	maxWait    time.Duration
+	sleep      func(ctx context.Context, wait time.Duration) error
+}
+
+func newRetryTransport(base http.RoundTripper, config *TransportConfig) *retryTransport {
+	return &retryTransport{
+		base:       base,
+		maxRetries: config.MaxRetries,
+		maxWait:    config.RetryMaxWait,
+		sleep:      sleepWithContext,
+	}
+}
+
+func (t *retryTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		attemptRequest, err := rewindRequest(request, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := t.base.RoundTrip(attemptRequest)
+		if attempt >= t.maxRetries || !shouldRetry(request, response, err) {
+			return response, err
+		}
+
+		wait := t.retryWait(response, attempt)
+		if err != nil {
+			log.Printf("[DEBUG] %s %s failed, retrying in %s: %+v", request.Method, request.URL.Path, wait, err)
+		} else {
+			log.Printf("[DEBUG] %s %s returned %d, retrying in %s", request.Method, request.URL.Path, response.StatusCode, wait)
+			// the connection can only be reused if the body is read to the end
+			io.Copy(ioutil.Discard, response.Body)
+			response.Body.Close()
+		}
+
+		if err := t.sleep(request.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// rewindRequest returns the request to send for the attempt, the body of a request can only be read once
+func rewindRequest(request *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || request.Body == nil || request.Body == http.NoBody {
+		return request, nil
+	}
+	body, err := request.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	rewound := request.Clone(request.Context())
+	rewound.Body = body
+	return rewound, nil
+}
+
+// shouldRetry reports whether the request can be sent again.
+// Only idempotent requests are retried after a server error, a throttled request was rejected
+// before it was processed and is retried regardless of the method.
+func shouldRetry(request *http.Request, response *http.Response, err error) bool {
+	// a request body that can not be rewound can not be sent again
+	if request.Body != nil && request.Body != http.NoBody && request.GetBody == nil {
+		return false
+	}
+	if request.Context().Err() != nil {
+		return false
+	}
+	if err != nil {
+		return isIdempotent(request.Method)
+	}
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(request.Method)
+	}
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryWait returns the time to wait before the next attempt
+func (t *retryTransport) retryWait(response *http.Response, attempt int) time.Duration {
+	if response != nil {
+		if wait, ok := retryAfter(response.Header, time.Now()); ok {
+			if wait > t.maxWait {
+				return t.maxWait
+			}
+			return wait
+		}
+	}
+
+	// full jitter spreads the retries of concurrent requests
+	backoff := float64(retryBaseWait) * math.Pow(2, float64(attempt))
+	if backoff > float64(t.maxWait) {
+		backoff = float64(t.maxWait)
+	}
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+// retryAfter returns the wait time requested by the service in the Retry-After header,
+// or the time until the rate limit is reset when the remaining budget is exhausted
+func retryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	if value := header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil {
+			return nonNegative(time.Duration(seconds) * time.Second), true
+		}
+		if date, err := http.ParseTime(value); err == nil {
+			return nonNegative(date.Sub(now)), true
+		}
+	}
+
+	if header.Get("X-RateLimit-Remaining") == "0" {
+		if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			return nonNegative(time.Unix(reset, 0).Sub(now)), true
+		}
+	}
+	return 0, false
+}
+
+func nonNegative(wait time.Duration) time.Duration {
+	if wait < 0 {
+		return 0
+	}
+	return wait
+}
+
+func sleepWithContext(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,164 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newThrottlingServer returns a server answering the requests with the given status codes, the last status code is repeated
+func newThrottlingServer(t *testing.T, header http.Header, statusCodes ...int) (*httptest.Server, *[]string) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.Nil(t, err)
+		bodies = append(bodies, string(body))
+
+		statusCode := statusCodes[len(statusCodes)-1]
+		if len(bodies) <= len(statusCodes) {
+			statusCode = statusCodes[len(bodies)-1]
+		}
+		if statusCode != http.StatusOK {
+			for key, values := range header {
+				w.Header()[key] = values
+			}
+		}
+		w.WriteHeader(statusCode)
+	}))
+	return server, &bodies
+}
+
+func newTestRetryClient(maxRetries int) (*http.Client, *[]time.Duration) {
+	var waits []time.Duration
+	transport := newRetryTransport(http.DefaultTransport, &TransportConfig{
+		MaxRetries:   maxRetries,
+		RetryMaxWait: 30 * time.Second,
+	})
+	transport.sleep = func(ctx context.Context, wait time.Duration) error {
+		waits = append(waits, wait)
+		return ctx.Err()
+	}
+	return &http.Client{Transport: transport}, &waits
+}
+
+// verifies that a throttled request is retried after the time requested by the service
+func TestRetry_Throttled_HonorsRetryAfter(t *testing.T) {
+	server, bodies := newThrottlingServer(t, http.Header{"Retry-After": {"7"}}, http.StatusTooManyRequests, http.StatusOK)
+	defer server.Close()
+
+	httpClient, waits := newTestRetryClient(3)
+	response, err := httpClient.Get(server.URL)
+	require.Nil(t, err)
+	response.Body.Close()
+
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Len(t, *bodies, 2)
+	require.Equal(t, []time.Duration{7 * time.Second}, *waits)
+}
+
+// verifies that a throttled request with a body is sent again with the same body
+func TestRetry_ThrottledPost_ReplaysBody(t *testing.T) {
+	server, bodies := newThrottlingServer(t, http.Header{"Retry-After": {"1"}}, http.StatusTooManyRequests, http.StatusOK)
+	defer server.Close()
+
+	httpClient, _ := newTestRetryClient(3)
+	response, err := httpClient.Post(server.URL, "application/json", bytes.NewReader([]byte(`{"name":"project"}`)))
+	require.Nil(t, err)
+	response.Body.Close()
+
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, []string{`{"name":"project"}`, `{"name":"project"}`}, *bodies)
+}
+
+// verifies that a request which is not idempotent is not retried after a server error
+func TestRetry_ServiceUnavailablePost_IsNotRetried(t *testing.T) {
+	server, bodies := newThrottlingServer(t, nil, http.StatusServiceUnavailable, http.StatusOK)
+	defer server.Close()
+
+	httpClient, waits := newTestRetryClient(3)
+	response, err := httpClient.Post(server.URL, "application/json", bytes.NewReader([]byte(`{}`)))
+	require.Nil(t, err)
+	response.Body.Close()
+
+	require.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+	require.Len(t, *bodies, 1)
+	require.Empty(t, *waits)
+}
+
+// verifies that the last response is returned once the retries are exhausted and the backoff is capped
+func TestRetry_ServiceUnavailableGet_StopsAfterMaxRetries(t *testing.T) {
+	server, bodies := newThrottlingServer(t, nil, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	httpClient, waits := newTestRetryClient(6)
+	response, err := httpClient.Get(server.URL)
+	require.Nil(t, err)
+	response.Body.Close()
+
+	require.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+	require.Len(t, *bodies, 7)
+	require.Len(t, *waits, 6)
+	for attempt, wait := range *waits {
+		require.True(t, wait >= 0)
+		require.True(t, wait <= 30*time.Second, "attempt %d waited %s", attempt, wait)
+		require.True(t, wait <= time.Duration(1<<uint(attempt))*time.Second, "attempt %d waited %s", attempt, wait)
+	}
+}
+
+// verifies that requests are not retried once the context is canceled
+func TestRetry_CanceledContext_StopsRetrying(t *testing.T) {
+	server, bodies := newThrottlingServer(t, http.Header{"Retry-After": {"1"}}, http.StatusTooManyRequests)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	httpClient, _ := newTestRetryClient(5)
+	httpClient.Transport.(*retryTransport).sleep = func(context.Context, time.Duration) error {
+		cancel()
+		return context.Canceled
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.Nil(t, err)
+	_, err = httpClient.Do(request)
+	require.NotNil(t, err)
+	require.Len(t, *bodies, 1)
+}
+
+func TestRetry_RetryAfter(t *testing.T) {
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	wait, ok := retryAfter(http.Header{"Retry-After": {"30"}}, now)
+	require.True(t, ok)
+	require.Equal(t, 30*time.Second, wait)
+
+	wait, ok = retryAfter(http.Header{"Retry-After": {now.Add(time.Minute).Format(http.TimeFormat)}}, now)
+	require.True(t, ok)
+	require.Equal(t, time.Minute, wait)
+
+	wait, ok = retryAfter(http.Header{
+		"X-Ratelimit-Remaining": {"0"},
+		"X-Ratelimit-Reset":     {strconv.FormatInt(now.Add(45*time.Second).Unix(), 10)},
+	}, now)
+	require.True(t, ok)
+	require.Equal(t, 45*time.Second, wait)
+
+	_, ok = retryAfter(http.Header{
+		"X-Ratelimit-Remaining": {"10"},
+		"X-Ratelimit-Reset":     {strconv.FormatInt(now.Add(45*time.Second).Unix(), 10)},
+	}, now)
+	require.False(t, ok)
+}
+
+// verifies that a wait requested by the service is capped by the configured maximum
+func TestRetry_RetryWait_CappedByMaxWait(t *testing.T) {
+	transport := newRetryTransport(http.DefaultTransport, &TransportConfig{MaxRetries: 1, RetryMaxWait: 10 * time.Second})
+	wait := transport.retryWait(&http.Response{Header: http.Header{"Retry-After": {"3600"}}}, 0)
+	require.Equal(t, 10*time.Second, wait)
+}
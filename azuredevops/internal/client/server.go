@@ -0,0 +1,149 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+)
+
+const (
+	// DeploymentTypeHosted is the deployment type of Azure DevOps Services
+	DeploymentTypeHosted = "hosted"
+
+	// DeploymentTypeOnPremises is the deployment type of Azure DevOps Server
+	DeploymentTypeOnPremises = "onPremises"
+)
+
+// ServerInfo describes the Azure DevOps deployment the provider is connected to
+type ServerInfo struct {
+	DeploymentType string
+
+	// APIVersion is the highest API version provided by the server, e.g. 5.0 for Azure DevOps Server 2019
+	APIVersion string
+}
+
+// IsOnPremises reports whether the provider is connected to an Azure DevOps Server collection
+func (s *ServerInfo) IsOnPremises() bool {
+	return strings.EqualFold(s.DeploymentType, DeploymentTypeOnPremises)
+}
+
+func (s *ServerInfo) String() string {
+	name := "Azure DevOps Services"
+	if s.IsOnPremises() {
+		name = "Azure DevOps Server"
+	}
+	if s.APIVersion == "" {
+		return name
+	}
+	return fmt.Sprintf("%s, API version %s", name, s.APIVersion)
+}
+
+// connectionData is the part of the response of _apis/connectionData used by the provider
+type connectionData struct {
+	DeploymentType string `json:"deploymentType,omitempty"`
+}
+
+// getServerInfo detects the deployment type from the connection data and the API version from the
+// locations of the server. The requests of the SDK clients are negotiated down to the versions of
+// these locations.
+func getServerInfo(ctx context.Context, client *azuredevops.Client, baseURL string) (*ServerInfo, error) {
+	request, err := client.CreateRequestMessage(ctx, http.MethodGet, baseURL+"/_apis/connectionData", "", nil, "", azuredevops.MediaTypeApplicationJson, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.SendRequest(request)
+	if err != nil {
+		return nil, fmt.Errorf("reading the connection data of %s failed: %+v", baseURL, err)
+	}
+	var data connectionData
+	if err := client.UnmarshalBody(response, &data); err != nil {
+		return nil, fmt.Errorf("reading the connection data of %s failed: %+v", baseURL, err)
+	}
+
+	request, err = client.CreateRequestMessage(ctx, http.MethodOptions, baseURL+"/_apis", "", nil, "", azuredevops.MediaTypeApplicationJson, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err = client.SendRequest(request)
+	if err != nil {
+		return nil, fmt.Errorf("reading the API locations of %s failed: %+v", baseURL, err)
+	}
+	var locations []azuredevops.ApiResourceLocation
+	if err := client.UnmarshalCollectionBody(response, &locations); err != nil {
+		return nil, fmt.Errorf("reading the API locations of %s failed: %+v", baseURL, err)
+	}
+
+	info := &ServerInfo{DeploymentType: data.DeploymentType}
+	if version := maxAPIVersion(locations); version != nil {
+		info.APIVersion = version.String()
+	}
+	return info, nil
+}
+
+// maxAPIVersion returns the highest version of the locations, locations with a malformed version are ignored
+func maxAPIVersion(locations []azuredevops.ApiResourceLocation) *azuredevops.Version {
+	var max *azuredevops.Version
+	for _, location := range locations {
+		if location.MaxVersion == nil {
+			continue
+		}
+		version, err := azuredevops.NewVersion(*location.MaxVersion)
+		if err != nil {
+			continue
+		}
+		if max == nil || version.CompareTo(*max) > 0 {
+			max = version
+		}
+	}
+	return max
+}
+
+// UnsupportedAPIError is returned when a resource uses an API that the server does not provide
+type UnsupportedAPIError struct {
+	Resource string
+	Server   *ServerInfo
+	Err      error
+}
+
+func (e *UnsupportedAPIError) Error() string {
+	return fmt.Sprintf("%s is unsupported on this server version (%s): %v", e.Resource, e.Server, e.Err)
+}
+
+func (e *UnsupportedAPIError) Unwrap() error {
+	return e.Err
+}
+
+// the messages of the errors returned for APIs a server does not provide,
+// most resources wrap the errors of the SDK as text
+var unsupportedAPIMessages = []string{
+	" is not registered on ",
+	"is out of range for this server",
+}
+
+// UnsupportedAPI returns an UnsupportedAPIError if err was caused by an API the server does not provide,
+// any other error is returned unchanged
+func UnsupportedAPI(resource string, server *ServerInfo, err error) error {
+	if err == nil || server == nil {
+		return err
+	}
+	var unsupported *UnsupportedAPIError
+	if errors.As(err, &unsupported) {
+		return err
+	}
+
+	var locationErr *azuredevops.LocationIdNotRegisteredError
+	var resourceAreaErr *azuredevops.ResourceAreaIdNotRegisteredError
+	if errors.As(err, &locationErr) || errors.As(err, &resourceAreaErr) {
+		return &UnsupportedAPIError{Resource: resource, Server: server, Err: err}
+	}
+	for _, message := range unsupportedAPIMessages {
+		if strings.Contains(err.Error(), message) {
+			return &UnsupportedAPIError{Resource: resource, Server: server, Err: err}
+		}
+	}
+	return err
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/stretchr/testify/require"
+)
+
+// newCollectionServer returns a server that answers like an Azure DevOps Server 2019 collection without any API
+func newCollectionServer(t *testing.T, authorization *string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/tfs/defaultcollection/_apis/connectionData":
+			fmt.Fprint(w, `{"deploymentType":"onPremises"}`)
+		case r.Method == http.MethodOptions && r.URL.Path == "/tfs/defaultcollection/_apis":
+			fmt.Fprint(w, `{"count":2,"value":[
+				{"id":"00d9565f-ed9c-4a06-9a50-00e7896ccab4","area":"Location","minVersion":"1.0","maxVersion":"5.0","releasedVersion":"5.0"},
+				{"id":"4d72c0df-1e30-4a4f-b4d2-3e0d7d1f6d55","area":"Wiki","minVersion":"4.1","maxVersion":"4.1","releasedVersion":"0.0"}]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+// verifies that an Azure DevOps Server collection is detected and the APIs it lacks fail with a clear error
+func TestServer_OnPremisesCollection(t *testing.T) {
+	var authorization string
+	server := newCollectionServer(t, &authorization)
+	defer server.Close()
+
+	clients, err := GetAzdoClient(
+		&AuthConfig{Username: "builder", PersonalAccessToken: "pat"},
+		&TransportConfig{},
+		server.URL+"/tfs/DefaultCollection",
+		"0.12.0")
+	require.Nil(t, err)
+	require.True(t, clients.ServerInfo.IsOnPremises())
+	require.Equal(t, "5.0", clients.ServerInfo.APIVersion)
+	require.Equal(t, azuredevops.CreateBasicAuthHeaderValue("builder", "pat"), authorization)
+
+	_, err = clients.CoreClient.GetProjects(context.Background(), core.GetProjectsArgs{})
+	require.NotNil(t, err)
+
+	err = UnsupportedAPI("azuredevops_project", clients.ServerInfo, fmt.Errorf("failed to read projects: %+v", err))
+	var unsupported *UnsupportedAPIError
+	require.True(t, errors.As(err, &unsupported))
+	require.Contains(t, err.Error(), "azuredevops_project is unsupported on this server version (Azure DevOps Server, API version 5.0)")
+}
+
+func TestServer_UnsupportedAPI_OtherErrorsUnchanged(t *testing.T) {
+	err := errors.New("project not found")
+	require.Equal(t, err, UnsupportedAPI("azuredevops_project", &ServerInfo{DeploymentType: DeploymentTypeHosted}, err))
+	require.Nil(t, UnsupportedAPI("azuredevops_project", &ServerInfo{}, nil))
+}
@@ -0,0 +1,209 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	redacted = "REDACTED"
+
+	// larger entries are written without their bodies to keep the trace file readable
+	maxTraceEntrySize = 1024 * 1024
+)
+
+// headers that always carry credentials
+var redactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// fields of the Azure DevOps API that carry credentials, in addition to the sensitive fields of the provider schema
+var redactedAPIFields = []string{"apitoken", "password", "serviceprincipalkey", "accesstoken", "accesskey", "secretkey", "certificate", "privatekey", "secret"}
+
+// traceEntry is a line of the trace file
+type traceEntry struct {
+	Time            time.Time   `json:"time"`
+	Method          string      `json:"method"`
+	URL             string      `json:"url"`
+	RequestHeaders  http.Header `json:"request_headers"`
+	RequestBody     interface{} `json:"request_body,omitempty"`
+	Status          int         `json:"status,omitempty"`
+	ResponseHeaders http.Header `json:"response_headers,omitempty"`
+	ResponseBody    interface{} `json:"response_body,omitempty"`
+	DurationMs      int64       `json:"duration_ms"`
+	Error           string      `json:"error,omitempty"`
+}
+
+// traceTransport writes every request and response as a JSON line with the credentials redacted
+type traceTransport struct {
+	base           http.RoundTripper
+	writer         *traceWriter
+	redactedFields map[string]bool
+}
+
+func newTraceTransport(base http.RoundTripper, traceFile string, sensitiveFields []string) (*traceTransport, error) {
+	writer, err := getTraceWriter(traceFile)
+	if err != nil {
+		return nil, err
+	}
+
+	redactedFields := map[string]bool{}
+	for _, field := range append(sensitiveFields, redactedAPIFields...) {
+		redactedFields[normalizeFieldName(field)] = true
+	}
+	return &traceTransport{base: base, writer: writer, redactedFields: redactedFields}, nil
+}
+
+func (t *traceTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	entry := &traceEntry{
+		Time:           time.Now().UTC(),
+		Method:         request.Method,
+		URL:            request.URL.String(),
+		RequestHeaders: redactHeaders(request.Header),
+	}
+	if request.GetBody != nil {
+		if body, err := request.GetBody(); err == nil {
+			content, _ := ioutil.ReadAll(body)
+			body.Close()
+			entry.RequestBody = t.traceBody(content, request.Header.Get("Content-Type"))
+		}
+	}
+
+	response, err := t.base.RoundTrip(request)
+	entry.DurationMs = time.Since(entry.Time).Milliseconds()
+	if err != nil {
+		entry.Error = err.Error()
+		t.writer.write(entry)
+		return response, err
+	}
+
+	entry.Status = response.StatusCode
+	entry.ResponseHeaders = redactHeaders(response.Header)
+	if response.Body != nil {
+		content, readErr := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		// the response is passed on with a body that can be read again
+		response.Body = ioutil.NopCloser(bytes.NewReader(content))
+		if readErr != nil {
+			entry.Error = readErr.Error()
+		}
+		entry.ResponseBody = t.traceBody(content, response.Header.Get("Content-Type"))
+	}
+	t.writer.write(entry)
+	return response, nil
+}
+
+// traceBody returns the body as it is written to the trace, JSON bodies are redacted and other bodies are summarized
+func (t *traceTransport) traceBody(content []byte, contentType string) interface{} {
+	if len(content) == 0 {
+		return nil
+	}
+
+	if strings.Contains(contentType, "json") {
+		var body interface{}
+		if err := json.Unmarshal(content, &body); err == nil {
+			return t.redactJSON(body)
+		}
+	}
+	// a body that can not be parsed can not be redacted
+	return fmt.Sprintf("<%d bytes of %s>", len(content), contentType)
+}
+
+// redactJSON replaces the values of the sensitive fields, the values of secret variables and
+// the authorization parameters of service endpoints
+func (t *traceTransport) redactJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		isSecret, _ := v["isSecret"].(bool)
+		for key, fieldValue := range v {
+			switch {
+			case t.redactedFields[normalizeFieldName(key)] && fieldValue != nil:
+				v[key] = redacted
+			case isSecret && key == "value":
+				v[key] = redacted
+			case key == "authorization":
+				if authorization, ok := fieldValue.(map[string]interface{}); ok {
+					if parameters, ok := authorization["parameters"].(map[string]interface{}); ok {
+						for parameter := range parameters {
+							parameters[parameter] = redacted
+						}
+					}
+				}
+				v[key] = t.redactJSON(fieldValue)
+			default:
+				v[key] = t.redactJSON(fieldValue)
+			}
+		}
+		return v
+	case []interface{}:
+		for i := range v {
+			v[i] = t.redactJSON(v[i])
+		}
+		return v
+	}
+	return value
+}
+
+// normalizeFieldName matches the snake case names of the provider schema with the camel case names of the API
+func normalizeFieldName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+func redactHeaders(header http.Header) http.Header {
+	traced := header.Clone()
+	for _, name := range redactedHeaders {
+		if traced.Get(name) != "" {
+			traced.Set(name, redacted)
+		}
+	}
+	return traced
+}
+
+// traceWriter appends the entries to the trace file, lines of concurrent requests must not interleave
+type traceWriter struct {
+	lock sync.Mutex
+	file *os.File
+}
+
+// the trace writers are shared by all provider configurations writing to the same file
+var traceWriters = map[string]*traceWriter{}
+var traceWritersLock sync.Mutex
+
+func getTraceWriter(path string) (*traceWriter, error) {
+	traceWritersLock.Lock()
+	defer traceWritersLock.Unlock()
+
+	if writer, ok := traceWriters[path]; ok {
+		return writer, nil
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("opening the trace file %s failed: %+v", path, err)
+	}
+	writer := &traceWriter{file: file}
+	traceWriters[path] = writer
+	return writer, nil
+}
+
+func (w *traceWriter) write(entry *traceEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	if len(line) > maxTraceEntrySize {
+		entry.RequestBody = fmt.Sprintf("<truncated, %d bytes entry>", len(line))
+		entry.ResponseBody = entry.RequestBody
+		if line, err = json.Marshal(entry); err != nil {
+			return
+		}
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.file.Write(append(line, '\n'))
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readTraceEntries(t *testing.T, path string) []map[string]interface{} {
+	file, err := os.Open(path)
+	require.Nil(t, err)
+	defer file.Close()
+
+	var entries []map[string]interface{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry map[string]interface{}
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// verifies that the request and the response are traced with the credentials redacted
+func TestTrace_RedactsCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.Write([]byte(`{"id":"1","variables":{"plain":{"value":"visible"},"hidden":{"isSecret":true,"value":"s3cret"}}}`))
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "trace")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	traceFile := filepath.Join(dir, "trace.jsonl")
+
+	transport, err := newTraceTransport(http.DefaultTransport, traceFile, []string{"personal_access_token"})
+	require.Nil(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, server.URL+"/_apis/serviceendpoint/endpoints", bytes.NewReader([]byte(
+		`{"name":"github","personalAccessToken":"pat-value","authorization":{"scheme":"UsernamePassword","parameters":{"username":"user-value","password":"password-value"}}}`)))
+	require.Nil(t, err)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", "Basic dXNlcjpwYXQ=")
+
+	response, err := (&http.Client{Transport: transport}).Do(request)
+	require.Nil(t, err)
+	body, err := ioutil.ReadAll(response.Body)
+	require.Nil(t, err)
+	response.Body.Close()
+	require.Contains(t, string(body), "s3cret", "the caller must receive the response unchanged")
+
+	content, err := ioutil.ReadFile(traceFile)
+	require.Nil(t, err)
+	for _, secret := range []string{"dXNlcjpwYXQ=", "pat-value", "password-value", "user-value", "s3cret", "session=secret"} {
+		require.NotContains(t, string(content), secret)
+	}
+
+	entries := readTraceEntries(t, traceFile)
+	require.Len(t, entries, 1)
+	entry := entries[0]
+	require.Equal(t, "POST", entry["method"])
+	require.Equal(t, float64(http.StatusOK), entry["status"])
+	require.Equal(t, []interface{}{redacted}, entry["request_headers"].(map[string]interface{})["Authorization"])
+
+	requestBody := entry["request_body"].(map[string]interface{})
+	require.Equal(t, "github", requestBody["name"])
+	require.Equal(t, redacted, requestBody["personalAccessToken"])
+	authorization := requestBody["authorization"].(map[string]interface{})
+	require.Equal(t, "UsernamePassword", authorization["scheme"])
+
+	variables := entry["response_body"].(map[string]interface{})["variables"].(map[string]interface{})
+	require.Equal(t, "visible", variables["plain"].(map[string]interface{})["value"])
+	require.Equal(t, redacted, variables["hidden"].(map[string]interface{})["value"])
+}
+
+// verifies that bodies which can not be redacted are not written to the trace
+func TestTrace_SummarizesOtherBodies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte("password=secret"))
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "trace")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	traceFile := filepath.Join(dir, "trace.jsonl")
+
+	transport, err := newTraceTransport(http.DefaultTransport, traceFile, nil)
+	require.Nil(t, err)
+	response, err := (&http.Client{Transport: transport}).Get(server.URL)
+	require.Nil(t, err)
+	response.Body.Close()
+
+	entries := readTraceEntries(t, traceFile)
+	require.Len(t, entries, 1)
+	require.True(t, strings.HasPrefix(entries[0]["response_body"].(string), "<15 bytes of text/plain"))
+}
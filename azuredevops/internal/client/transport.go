@@ -0,0 +1,110 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// TransportConfig holds the settings of the http transport used to send the requests to Azure DevOps
+type TransportConfig struct {
+	MaxRetries   int
+	RetryMaxWait time.Duration
+
+	// limits of the requests sent to Azure DevOps, zero means unlimited
+	MaxConcurrentRequests        int
+	MaxConcurrentRequestsPerArea map[string]int
+	MaxRequestsPerSecond         float64
+
+	// the requests and responses are written to the trace file with the values of the sensitive fields redacted
+	TraceFile       string
+	SensitiveFields []string
+
+	// PEM encoded certificates trusted in addition to the system roots, e.g. the CA of an Azure DevOps Server
+	// or of a TLS intercepting proxy
+	CACertFile string
+	CACertPEM  string
+
+	// ProxyURL overrides the proxy taken from the HTTPS_PROXY and NO_PROXY environment variables
+	ProxyURL string
+
+	InsecureSkipVerify bool
+
+	// the PEM encoded client certificate and private key presented to servers requiring mutual TLS
+	ClientCertFile string
+	ClientKeyFile  string
+
+	// IdentityCacheTTL is the time the identities, descriptors and storage keys resolved by the resources
+	// are cached, zero disables the cache
+	IdentityCacheTTL time.Duration
+}
+
+// newBaseTransport returns the transport that sends the requests to the network
+func newBaseTransport(config *TransportConfig) (http.RoundTripper, error) {
+	if config.CACertFile == "" && config.CACertPEM == "" && config.ProxyURL == "" &&
+		!config.InsecureSkipVerify && config.ClientCertFile == "" && config.ClientKeyFile == "" {
+		return http.DefaultTransport, nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	tlsConfig, err := newTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("parsing the proxy url %s failed: %+v", config.ProxyURL, err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	return transport, nil
+}
+
+func newTLSConfig(config *TransportConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{}
+
+	if config.CACertFile != "" || config.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if config.CACertFile != "" {
+			content, err := ioutil.ReadFile(config.CACertFile)
+			if err != nil {
+				return nil, fmt.Errorf("reading the CA certificates %s failed: %+v", config.CACertFile, err)
+			}
+			if !pool.AppendCertsFromPEM(content) {
+				return nil, fmt.Errorf("the file %s does not contain a PEM encoded certificate", config.CACertFile)
+			}
+		}
+		if config.CACertPEM != "" && !pool.AppendCertsFromPEM([]byte(config.CACertPEM)) {
+			return nil, fmt.Errorf("the CA certificates are not PEM encoded")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.InsecureSkipVerify {
+		log.Printf("[WARN] The certificates of Azure DevOps are not verified, the credentials and all data sent by the provider can be intercepted. Use insecure_skip_verify for testing only.")
+		tlsConfig.InsecureSkipVerify = true
+	}
+
+	if config.ClientCertFile != "" || config.ClientKeyFile != "" {
+		if config.ClientCertFile == "" || config.ClientKeyFile == "" {
+			return nil, fmt.Errorf("both the client certificate and the client key are required for mutual TLS")
+		}
+		certificate, err := tls.LoadX509KeyPair(config.ClientCertFile, config.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading the client certificate %s failed: %+v", config.ClientCertFile, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return tlsConfig, nil
+}
@@ -0,0 +1,139 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeTempFile(t *testing.T, dir string, name string, content []byte) string {
+	path := filepath.Join(dir, name)
+	require.Nil(t, ioutil.WriteFile(path, content, 0600))
+	return path
+}
+
+func certificatePEM(server *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+func TestTransport_DefaultTransportWithoutSettings(t *testing.T) {
+	transport, err := newBaseTransport(&TransportConfig{})
+	require.Nil(t, err)
+	require.True(t, transport == http.DefaultTransport)
+}
+
+// verifies that a server certificate issued by the configured CA is trusted
+func TestTransport_CACertFile(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	_, err := (&http.Client{}).Get(server.URL)
+	require.NotNil(t, err, "the certificate of the test server must not be trusted by default")
+
+	dir, err := ioutil.TempDir("", "ca")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	transport, err := newBaseTransport(&TransportConfig{CACertFile: writeTempFile(t, dir, "ca.pem", certificatePEM(server))})
+	require.Nil(t, err)
+	response, err := (&http.Client{Transport: transport}).Get(server.URL)
+	require.Nil(t, err)
+	response.Body.Close()
+}
+
+func TestTransport_CACertPEM(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	transport, err := newBaseTransport(&TransportConfig{CACertPEM: string(certificatePEM(server))})
+	require.Nil(t, err)
+	response, err := (&http.Client{Transport: transport}).Get(server.URL)
+	require.Nil(t, err)
+	response.Body.Close()
+}
+
+func TestTransport_CACertFile_InvalidFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ca")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = newBaseTransport(&TransportConfig{CACertFile: writeTempFile(t, dir, "ca.pem", []byte("not a certificate"))})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "does not contain a PEM encoded certificate")
+}
+
+func TestTransport_InsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	transport, err := newBaseTransport(&TransportConfig{InsecureSkipVerify: true})
+	require.Nil(t, err)
+	response, err := (&http.Client{Transport: transport}).Get(server.URL)
+	require.Nil(t, err)
+	response.Body.Close()
+}
+
+// verifies that the requests are sent through the configured proxy
+func TestTransport_ProxyURL(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+	}))
+	defer proxy.Close()
+
+	transport, err := newBaseTransport(&TransportConfig{ProxyURL: proxy.URL})
+	require.Nil(t, err)
+	response, err := (&http.Client{Transport: transport}).Get("http://tfs.contoso.com/tfs/_apis")
+	require.Nil(t, err)
+	response.Body.Close()
+	require.Equal(t, "http://tfs.contoso.com/tfs/_apis", proxied)
+}
+
+// verifies that the client certificate is presented to a server requiring mutual TLS
+func TestTransport_ClientCertificate(t *testing.T) {
+	var presented int
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		presented = len(r.TLS.PeerCertificates)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "mtls")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	// the certificate of the test server is reused as the client certificate
+	key, err := x509.MarshalPKCS8PrivateKey(server.TLS.Certificates[0].PrivateKey)
+	require.Nil(t, err)
+	transport, err := newBaseTransport(&TransportConfig{
+		CACertPEM:      string(certificatePEM(server)),
+		ClientCertFile: writeTempFile(t, dir, "client.pem", certificatePEM(server)),
+		ClientKeyFile:  writeTempFile(t, dir, "client.key", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key})),
+	})
+	require.Nil(t, err)
+	response, err := (&http.Client{Transport: transport}).Get(server.URL)
+	require.Nil(t, err)
+	response.Body.Close()
+	require.Equal(t, 1, presented)
+}
+
+func TestTransport_ClientCertificate_KeyRequired(t *testing.T) {
+	_, err := newBaseTransport(&TransportConfig{ClientCertFile: "client.pem"})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "both the client certificate and the client key are required")
+}
+
+func TestTransport_ProxyURL_Invalid(t *testing.T) {
+	_, err := newBaseTransport(&TransportConfig{ProxyURL: "http://proxy:port"})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "parsing the proxy url")
+}
@@ -1,8 +1,14 @@
 package build
 
 import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"log"
 	"net/url"
 	"strconv"
 	"strings"
@@ -10,12 +16,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/model"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/validate"
+	"gopkg.in/yaml.v2"
 )
 
 const (
@@ -23,10 +31,41 @@ const (
 	bdVariableName          = "name"
 	bdVariableValue         = "value"
 	bdSecretVariableValue   = "secret_value"
+	bdSecretVariableHash    = "secret_value_hash"
+	bdSecretVariableSalt    = "secret_value_salt"
 	bdVariableIsSecret      = "is_secret"
 	bdVariableAllowOverride = "allow_override"
+
+	bdDesignerProcess = "designer_process"
+
+	bdBuildNumberFormat         = "build_number_format"
+	bdJobTimeoutInMinutes       = "job_timeout_in_minutes"
+	bdJobCancelTimeoutInMinutes = "job_cancel_timeout_in_minutes"
+	bdJobAuthorizationScope     = "job_authorization_scope"
+	bdBadgeEnabled              = "badge_enabled"
+	bdDemands                   = "demands"
+	bdTags                      = "tags"
+
+	bdRetentionRule                  = "retention_rule"
+	bdRetentionBranches              = "branches"
+	bdRetentionArtifacts             = "artifacts"
+	bdRetentionArtifactTypesToDelete = "artifact_types_to_delete"
+	bdRetentionDaysToKeep            = "days_to_keep"
+	bdRetentionMinimumToKeep         = "minimum_to_keep"
+	bdRetentionDeleteBuildRecord     = "delete_build_record"
+	bdRetentionDeleteTestResults     = "delete_test_results"
+
+	// process types of a build definition, see build.DesignerProcess and build.YamlProcess
+	processTypeDesigner = 1
+	processTypeYaml     = 2
 )
 
+// phase target types of a designer process
+var phaseTargetTypes = map[string]int{
+	"agent":  1,
+	"server": 2,
+}
+
 // ResourceBuildDefinition schema and implementation for build definition resource
 func ResourceBuildDefinition() *schema.Resource {
 	filterSchema := map[string]*schema.Schema{
@@ -65,12 +104,159 @@ func ResourceBuildDefinition() *schema.Resource {
 		},
 	}
 
+	designerTaskSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"task_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"definition_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "task",
+				ValidateFunc: validation.StringInSlice([]string{"task", "metaTask"}, false),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"ref_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"condition": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "succeeded()",
+			},
+			"continue_on_error": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"always_run": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"inputs": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"environment_variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+
+	designerPhaseSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"ref_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"condition": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "succeeded()",
+			},
+			"target_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "agent",
+				ValidateFunc: validation.StringInSlice([]string{"agent", "server"}, false),
+			},
+			"job_authorization_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(build.BuildAuthorizationScopeValues.ProjectCollection),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(build.BuildAuthorizationScopeValues.ProjectCollection),
+					string(build.BuildAuthorizationScopeValues.Project),
+				}, false),
+			},
+			"job_timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"job_cancel_timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"variable": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"allow_override": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"task": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     designerTaskSchema,
+			},
+		},
+	}
+
 	return &schema.Resource{
-		Create:   resourceBuildDefinitionCreate,
-		Read:     resourceBuildDefinitionRead,
-		Update:   resourceBuildDefinitionUpdate,
-		Delete:   resourceBuildDefinitionDelete,
-		Importer: tfhelper.ImportProjectQualifiedResource(),
+		Create:        resourceBuildDefinitionCreate,
+		Read:          resourceBuildDefinitionRead,
+		Update:        resourceBuildDefinitionUpdate,
+		Delete:        resourceBuildDefinitionDelete,
+		Importer:      tfhelper.ImportProjectQualifiedResource(),
+		CustomizeDiff: customizeBuildDefinitionDiff,
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:     schema.TypeString,
@@ -101,9 +287,116 @@ func ResourceBuildDefinition() *schema.Resource {
 					ValidateFunc: validation.IntAtLeast(1),
 				},
 			},
-			bdVariable: {
+			bdBuildNumberFormat: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			bdJobTimeoutInMinutes: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			bdJobCancelTimeoutInMinutes: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+			bdJobAuthorizationScope: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(build.BuildAuthorizationScopeValues.ProjectCollection),
+					string(build.BuildAuthorizationScopeValues.Project),
+				}, false),
+			},
+			bdBadgeEnabled: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			bdDemands: {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				Set: schema.HashString,
+			},
+			bdTags: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				Set: schema.HashString,
+			},
+			bdRetentionRule: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						bdRetentionBranches: {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotWhiteSpace,
+							},
+						},
+						bdRetentionArtifacts: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotWhiteSpace,
+							},
+						},
+						bdRetentionArtifactTypesToDelete: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotWhiteSpace,
+							},
+						},
+						bdRetentionDaysToKeep: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						bdRetentionMinimumToKeep: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						bdRetentionDeleteBuildRecord: {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						bdRetentionDeleteTestResults: {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			bdVariable: {
+				Type:             schema.TypeSet,
+				Optional:         true,
+				Set:              hashBuildVariable,
+				DiffSuppressFunc: suppressUnchangedBuildVariables,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						bdVariableName: {
@@ -132,6 +425,17 @@ func ResourceBuildDefinition() *schema.Resource {
 							Optional: true,
 							Default:  true,
 						},
+						bdSecretVariableHash: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Sensitive:   true,
+							Description: fmt.Sprintf("A HMAC-SHA256 of the attribute '%s' keyed with the attribute '%s'", bdSecretVariableValue, bdSecretVariableSalt),
+						},
+						bdSecretVariableSalt: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: fmt.Sprintf("The random salt of the attribute '%s'", bdSecretVariableHash),
+						},
 					},
 				},
 			},
@@ -149,7 +453,8 @@ func ResourceBuildDefinition() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"yml_path": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
+							Default:  "",
 						},
 						"repo_id": {
 							Type:     schema.TypeString,
@@ -188,6 +493,26 @@ func ResourceBuildDefinition() *schema.Resource {
 					},
 				},
 			},
+			bdDesignerProcess: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"agent_specification": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"phase": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     designerPhaseSchema,
+						},
+					},
+				},
+			},
 			"ci_trigger": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -441,6 +766,7 @@ func flattenBuildDefinition(d *schema.ResourceData, buildDefinition *build.Build
 	d.Set("name", *buildDefinition.Name)
 	d.Set("path", *buildDefinition.Path)
 	d.Set("repository", flattenRepository(buildDefinition))
+	d.Set(bdDesignerProcess, flattenDesignerProcess(buildDefinition))
 
 	if buildDefinition.Queue != nil && buildDefinition.Queue.Pool != nil {
 		d.Set("agent_pool_name", *buildDefinition.Queue.Pool.Name)
@@ -449,6 +775,25 @@ func flattenBuildDefinition(d *schema.ResourceData, buildDefinition *build.Build
 	d.Set("variable_groups", flattenVariableGroups(buildDefinition))
 	d.Set(bdVariable, flattenBuildVariables(d, buildDefinition))
 
+	d.Set(bdBuildNumberFormat, converter.ToString(buildDefinition.BuildNumberFormat, ""))
+	if buildDefinition.JobTimeoutInMinutes != nil {
+		d.Set(bdJobTimeoutInMinutes, *buildDefinition.JobTimeoutInMinutes)
+	}
+	if buildDefinition.JobCancelTimeoutInMinutes != nil {
+		d.Set(bdJobCancelTimeoutInMinutes, *buildDefinition.JobCancelTimeoutInMinutes)
+	}
+	if buildDefinition.JobAuthorizationScope != nil {
+		d.Set(bdJobAuthorizationScope, string(*buildDefinition.JobAuthorizationScope))
+	}
+	d.Set(bdBadgeEnabled, converter.ToBool(buildDefinition.BadgeEnabled, false))
+	d.Set(bdDemands, flattenDemands(buildDefinition))
+	if buildDefinition.Tags != nil {
+		d.Set(bdTags, *buildDefinition.Tags)
+	} else {
+		d.Set(bdTags, nil)
+	}
+	d.Set(bdRetentionRule, flattenRetentionRules(buildDefinition))
+
 	if buildDefinition.Triggers != nil {
 		triggers := flattenTriggers(buildDefinition.Triggers)
 
@@ -499,14 +844,18 @@ func flattenBuildVariables(d *schema.ResourceData, buildDefinition *build.BuildD
 		variable = map[string]interface{}{
 			bdVariableName:          varName,
 			bdVariableValue:         converter.ToString(varVal.Value, ""),
+			bdSecretVariableValue:   "",
 			bdVariableIsSecret:      isSecret,
 			bdVariableAllowOverride: converter.ToBool(varVal.AllowOverride, false),
+			bdSecretVariableHash:    "",
+			bdSecretVariableSalt:    "",
 		}
 
-		//read secret variable from state if exist
+		// the service never returns secret values, only a salted hash of the configured value is kept
+		// in the state so that a rotated secret is planned as an update
 		if isSecret {
 			if stateVal := tfhelper.FindMapInSetWithGivenKeyValue(d, bdVariable, bdVariableName, varName); stateVal != nil {
-				variable = stateVal
+				variable[bdSecretVariableHash], variable[bdSecretVariableSalt] = flattenSecretVariableHash(stateVal)
 			}
 		}
 		variables[index] = variable
@@ -516,6 +865,135 @@ func flattenBuildVariables(d *schema.ResourceData, buildDefinition *build.BuildD
 	return variables
 }
 
+// hashBuildVariable computes the set hash of a variable. The secret value is only represented by its stored
+// hash, a configured secret variable never has the set hash of the state and the changes are compared by
+// suppressUnchangedBuildVariables instead.
+func hashBuildVariable(v interface{}) int {
+	var buf bytes.Buffer
+	variable := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", variable[bdVariableName].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", variable[bdVariableValue].(string)))
+	if hash, ok := variable[bdSecretVariableHash].(string); ok {
+		buf.WriteString(fmt.Sprintf("%s-", hash))
+	}
+	buf.WriteString(fmt.Sprintf("%t-", variable[bdVariableIsSecret].(bool)))
+	buf.WriteString(fmt.Sprintf("%t-", variable[bdVariableAllowOverride].(bool)))
+	return tfhelper.HashString(buf.String())
+}
+
+// suppressUnchangedBuildVariables suppresses the changes of the variables if the configured variables
+// match the state, the configured secrets are compared with the hashes of the state
+func suppressUnchangedBuildVariables(_, _, _ string, d *schema.ResourceData) bool {
+	if d.Id() == "" {
+		return false
+	}
+	return buildVariablesUnchanged(d.GetChange(bdVariable))
+}
+
+func buildVariablesUnchanged(oldVariables interface{}, newVariables interface{}) bool {
+	oldList := oldVariables.(*schema.Set).List()
+	newList := newVariables.(*schema.Set).List()
+	if len(oldList) != len(newList) {
+		return false
+	}
+
+	stateVariables := map[string]map[string]interface{}{}
+	for _, variable := range oldList {
+		variableMap := variable.(map[string]interface{})
+		stateVariables[variableMap[bdVariableName].(string)] = variableMap
+	}
+	for _, variable := range newList {
+		configured := variable.(map[string]interface{})
+		state, ok := stateVariables[configured[bdVariableName].(string)]
+		if !ok ||
+			state[bdVariableIsSecret] != configured[bdVariableIsSecret] ||
+			state[bdVariableAllowOverride] != configured[bdVariableAllowOverride] {
+			return false
+		}
+		if !configured[bdVariableIsSecret].(bool) {
+			if state[bdVariableValue] != configured[bdVariableValue] {
+				return false
+			}
+			continue
+		}
+		if !secretVariableUnchanged(configured[bdSecretVariableValue].(string), state) {
+			return false
+		}
+	}
+	return true
+}
+
+// secretVariableUnchanged reports whether the configured secret is the secret whose hash is kept in the state
+func secretVariableUnchanged(secretValue string, state map[string]interface{}) bool {
+	hash, _ := state[bdSecretVariableHash].(string)
+	salt, _ := state[bdSecretVariableSalt].(string)
+	if secretValue == "" || hash == "" {
+		return secretValue == "" && hash == ""
+	}
+	expected, err := hex.DecodeString(hash)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(expected, hashSecretVariableValue(secretValue, salt))
+}
+
+// flattenSecretVariableHash returns the hash and the salt of the secret of a variable. A configured secret is
+// hashed with a new random salt, the hash of a variable read from the state is kept.
+func flattenSecretVariableHash(variable map[string]interface{}) (string, string) {
+	secretValue, _ := variable[bdSecretVariableValue].(string)
+	if secretValue == "" {
+		hash, _ := variable[bdSecretVariableHash].(string)
+		salt, _ := variable[bdSecretVariableSalt].(string)
+		return hash, salt
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		log.Printf("[WARN] Unable to generate the salt of the secret variable %s, the secret is planned as changed: %+v", variable[bdVariableName], err)
+		return "", ""
+	}
+	saltValue := hex.EncodeToString(salt)
+	return hex.EncodeToString(hashSecretVariableValue(secretValue, saltValue)), saltValue
+}
+
+func hashSecretVariableValue(secretValue string, salt string) []byte {
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(secretValue))
+	return mac.Sum(nil)
+}
+
+func flattenDemands(buildDefinition *build.BuildDefinition) []string {
+	if buildDefinition.Demands == nil {
+		return nil
+	}
+
+	demands := make([]string, 0, len(*buildDefinition.Demands))
+	for _, demand := range *buildDefinition.Demands {
+		demands = append(demands, fmt.Sprintf("%v", demand))
+	}
+	return demands
+}
+
+func flattenRetentionRules(buildDefinition *build.BuildDefinition) []interface{} {
+	if buildDefinition.RetentionRules == nil {
+		return nil
+	}
+
+	rules := make([]interface{}, 0, len(*buildDefinition.RetentionRules))
+	for _, rule := range *buildDefinition.RetentionRules {
+		rules = append(rules, map[string]interface{}{
+			bdRetentionBranches:              converter.ToStringSlice(rule.Branches, []string{}),
+			bdRetentionArtifacts:             converter.ToStringSlice(rule.Artifacts, []string{}),
+			bdRetentionArtifactTypesToDelete: converter.ToStringSlice(rule.ArtifactTypesToDelete, []string{}),
+			bdRetentionDaysToKeep:            converter.ToInt(rule.DaysToKeep, 0),
+			bdRetentionMinimumToKeep:         converter.ToInt(rule.MinimumToKeep, 0),
+			bdRetentionDeleteBuildRecord:     converter.ToBool(rule.DeleteBuildRecord, false),
+			bdRetentionDeleteTestResults:     converter.ToBool(rule.DeleteTestResults, false),
+		})
+	}
+	return rules
+}
+
 func flattenVariableGroups(buildDefinition *build.BuildDefinition) []int {
 	if buildDefinition.VariableGroups == nil {
 		return nil
@@ -538,7 +1016,9 @@ func flattenRepository(buildDefinition *build.BuildDefinition) interface{} {
 	// available from the compiler is `interface{}` so we can probe for known
 	// implementations
 	if processMap, ok := buildDefinition.Process.(map[string]interface{}); ok {
-		yamlFilePath = processMap["yamlFilename"].(string)
+		if yamlFilename, ok := processMap["yamlFilename"].(string); ok {
+			yamlFilePath = yamlFilename
+		}
 	}
 	if yamlProcess, ok := buildDefinition.Process.(*build.YamlProcess); ok {
 		yamlFilePath = *yamlProcess.YamlFilename
@@ -574,6 +1054,122 @@ func flattenRepository(buildDefinition *build.BuildDefinition) interface{} {
 	return repo
 }
 
+// flattenDesignerProcess returns the designer_process block of a classic build definition
+// and nil for any other kind of process
+func flattenDesignerProcess(buildDefinition *build.BuildDefinition) interface{} {
+	if buildDefinition.Process == nil {
+		return nil
+	}
+
+	// The process is deserialized into `interface{}` by the SDK, its type tells the kind of process
+	var process build.DesignerProcess
+	if err := converter.ThroughJSON(buildDefinition.Process, &process); err != nil {
+		return nil
+	}
+	if process.Type == nil || *process.Type != processTypeDesigner {
+		return nil
+	}
+
+	agentSpecification := ""
+	if process.Target != nil && process.Target.AgentSpecification != nil {
+		agentSpecification = converter.ToString(process.Target.AgentSpecification.Identifier, "")
+	}
+
+	phases := []interface{}{}
+	if process.Phases != nil {
+		for _, phase := range *process.Phases {
+			phases = append(phases, flattenDesignerPhase(&phase))
+		}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"agent_specification": agentSpecification,
+		"phase":               phases,
+	}}
+}
+
+func flattenDesignerPhase(phase *build.Phase) map[string]interface{} {
+	targetType := ""
+	if phase.Target != nil && phase.Target.Type != nil {
+		for name, value := range phaseTargetTypes {
+			if value == *phase.Target.Type {
+				targetType = name
+			}
+		}
+	}
+
+	jobAuthorizationScope := ""
+	if phase.JobAuthorizationScope != nil {
+		jobAuthorizationScope = string(*phase.JobAuthorizationScope)
+	}
+
+	variables := []interface{}{}
+	if phase.Variables != nil {
+		for name, variable := range *phase.Variables {
+			variables = append(variables, map[string]interface{}{
+				"name":           name,
+				"value":          converter.ToString(variable.Value, ""),
+				"allow_override": converter.ToBool(variable.AllowOverride, false),
+			})
+		}
+	}
+
+	tasks := []interface{}{}
+	if phase.Steps != nil {
+		for _, step := range *phase.Steps {
+			tasks = append(tasks, flattenDesignerStep(&step))
+		}
+	}
+
+	return map[string]interface{}{
+		"name":                          converter.ToString(phase.Name, ""),
+		"ref_name":                      converter.ToString(phase.RefName, ""),
+		"condition":                     converter.ToString(phase.Condition, ""),
+		"target_type":                   targetType,
+		"job_authorization_scope":       jobAuthorizationScope,
+		"job_timeout_in_minutes":        converter.ToInt(phase.JobTimeoutInMinutes, 0),
+		"job_cancel_timeout_in_minutes": converter.ToInt(phase.JobCancelTimeoutInMinutes, 0),
+		"variable":                      variables,
+		"task":                          tasks,
+	}
+}
+
+func flattenDesignerStep(step *build.BuildDefinitionStep) map[string]interface{} {
+	task := map[string]interface{}{
+		"display_name":          converter.ToString(step.DisplayName, ""),
+		"ref_name":              converter.ToString(step.RefName, ""),
+		"enabled":               converter.ToBool(step.Enabled, true),
+		"condition":             converter.ToString(step.Condition, ""),
+		"continue_on_error":     converter.ToBool(step.ContinueOnError, false),
+		"always_run":            converter.ToBool(step.AlwaysRun, false),
+		"timeout_in_minutes":    converter.ToInt(step.TimeoutInMinutes, 0),
+		"inputs":                map[string]interface{}{},
+		"environment_variables": map[string]interface{}{},
+	}
+	if step.Task != nil {
+		if step.Task.Id != nil {
+			task["task_id"] = step.Task.Id.String()
+		}
+		task["version"] = converter.ToString(step.Task.VersionSpec, "")
+		task["definition_type"] = converter.ToString(step.Task.DefinitionType, "task")
+	}
+	if step.Inputs != nil {
+		inputs := map[string]interface{}{}
+		for k, v := range *step.Inputs {
+			inputs[k] = v
+		}
+		task["inputs"] = inputs
+	}
+	if step.Environment != nil {
+		environment := map[string]interface{}{}
+		for k, v := range *step.Environment {
+			environment[k] = v
+		}
+		task["environment_variables"] = environment
+	}
+	return task
+}
+
 func flattenBuildDefinitionBranchOrPathFilter(m []interface{}) []interface{} {
 	var include []string
 	var exclude []string
@@ -882,6 +1478,36 @@ func expandBuildDefinitionTriggerList(d []interface{}, t build.DefinitionTrigger
 	return vs
 }
 
+func expandDemands(d *schema.ResourceData) *[]interface{} {
+	demands := []interface{}{}
+	for _, demand := range tfhelper.ExpandStringSet(d.Get(bdDemands).(*schema.Set)) {
+		demands = append(demands, demand)
+	}
+	return &demands
+}
+
+func expandRetentionRules(d *schema.ResourceData) *[]build.RetentionPolicy {
+	configured := d.Get(bdRetentionRule).([]interface{})
+	if len(configured) == 0 {
+		return nil
+	}
+
+	rules := make([]build.RetentionPolicy, 0, len(configured))
+	for _, rule := range configured {
+		ruleMap := rule.(map[string]interface{})
+		rules = append(rules, build.RetentionPolicy{
+			Branches:              expandStringList(ruleMap[bdRetentionBranches].([]interface{})),
+			Artifacts:             expandStringList(ruleMap[bdRetentionArtifacts].([]interface{})),
+			ArtifactTypesToDelete: expandStringList(ruleMap[bdRetentionArtifactTypesToDelete].([]interface{})),
+			DaysToKeep:            converter.Int(ruleMap[bdRetentionDaysToKeep].(int)),
+			MinimumToKeep:         converter.Int(ruleMap[bdRetentionMinimumToKeep].(int)),
+			DeleteBuildRecord:     converter.Bool(ruleMap[bdRetentionDeleteBuildRecord].(bool)),
+			DeleteTestResults:     converter.Bool(ruleMap[bdRetentionDeleteTestResults].(bool)),
+		})
+	}
+	return &rules
+}
+
 func expandVariableGroups(d *schema.ResourceData) *[]build.VariableGroup {
 	variableGroupsInterface := d.Get("variable_groups").(*schema.Set).List()
 	variableGroups := make([]build.VariableGroup, len(variableGroupsInterface))
@@ -917,7 +1543,10 @@ func expandVariables(d *schema.ResourceData) (*map[string]build.BuildDefinitionV
 		var val *string
 
 		if *isSecret {
-			val = converter.String(varAsMap[bdSecretVariableValue].(string))
+			// an unchanged secret is not part of the state, a null value keeps the secret of the definition
+			if secretValue := varAsMap[bdSecretVariableValue].(string); secretValue != "" {
+				val = converter.String(secretValue)
+			}
 		} else {
 			val = converter.String(varAsMap[bdVariableValue].(string))
 		}
@@ -931,6 +1560,115 @@ func expandVariables(d *schema.ResourceData) (*map[string]build.BuildDefinitionV
 	return &expandedVars, nil
 }
 
+// expandProcess returns a designer process if the designer_process block is configured and
+// a YAML process otherwise
+func expandProcess(d *schema.ResourceData, repository map[string]interface{}) (interface{}, error) {
+	designerProcesses := d.Get(bdDesignerProcess).([]interface{})
+	if len(designerProcesses) == 0 {
+		yamlFilename := repository["yml_path"].(string)
+		if yamlFilename == "" {
+			return nil, fmt.Errorf("yml_path must be set on the repository unless a %s is configured", bdDesignerProcess)
+		}
+		return &build.YamlProcess{
+			YamlFilename: converter.String(yamlFilename),
+		}, nil
+	}
+
+	designerProcess := designerProcesses[0].(map[string]interface{})
+	configuredPhases := designerProcess["phase"].([]interface{})
+	phases := make([]build.Phase, 0, len(configuredPhases))
+	for _, configuredPhase := range configuredPhases {
+		phase, err := expandDesignerPhase(configuredPhase.(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		phases = append(phases, *phase)
+	}
+
+	process := &build.DesignerProcess{
+		Type:   converter.Int(processTypeDesigner),
+		Phases: &phases,
+	}
+	if agentSpecification := designerProcess["agent_specification"].(string); agentSpecification != "" {
+		process.Target = &build.DesignerProcessTarget{
+			AgentSpecification: &build.AgentSpecification{
+				Identifier: converter.String(agentSpecification),
+			},
+		}
+	}
+	return process, nil
+}
+
+func expandDesignerPhase(phase map[string]interface{}) (*build.Phase, error) {
+	variables := map[string]build.BuildDefinitionVariable{}
+	for _, configuredVariable := range phase["variable"].(*schema.Set).List() {
+		variable := configuredVariable.(map[string]interface{})
+		name := variable["name"].(string)
+		if _, ok := variables[name]; ok {
+			return nil, fmt.Errorf("Unexpectedly found duplicate variable with name %s in phase %s", name, phase["name"].(string))
+		}
+		variables[name] = build.BuildDefinitionVariable{
+			Value:         converter.String(variable["value"].(string)),
+			AllowOverride: converter.Bool(variable["allow_override"].(bool)),
+		}
+	}
+
+	configuredTasks := phase["task"].([]interface{})
+	steps := make([]build.BuildDefinitionStep, 0, len(configuredTasks))
+	for _, configuredTask := range configuredTasks {
+		steps = append(steps, *expandDesignerStep(configuredTask.(map[string]interface{})))
+	}
+
+	jobAuthorizationScope := build.BuildAuthorizationScope(phase["job_authorization_scope"].(string))
+	expandedPhase := &build.Phase{
+		Name:                      converter.String(phase["name"].(string)),
+		Condition:                 converter.String(phase["condition"].(string)),
+		JobAuthorizationScope:     &jobAuthorizationScope,
+		JobTimeoutInMinutes:       converter.Int(phase["job_timeout_in_minutes"].(int)),
+		JobCancelTimeoutInMinutes: converter.Int(phase["job_cancel_timeout_in_minutes"].(int)),
+		Target: &build.PhaseTarget{
+			Type: converter.Int(phaseTargetTypes[phase["target_type"].(string)]),
+		},
+		Variables: &variables,
+		Steps:     &steps,
+	}
+	if refName := phase["ref_name"].(string); refName != "" {
+		expandedPhase.RefName = converter.String(refName)
+	}
+	return expandedPhase, nil
+}
+
+func expandDesignerStep(task map[string]interface{}) *build.BuildDefinitionStep {
+	inputs := map[string]string{}
+	for k, v := range task["inputs"].(map[string]interface{}) {
+		inputs[k] = v.(string)
+	}
+	environment := map[string]string{}
+	for k, v := range task["environment_variables"].(map[string]interface{}) {
+		environment[k] = v.(string)
+	}
+
+	step := &build.BuildDefinitionStep{
+		Task: &build.TaskDefinitionReference{
+			Id:             converter.UUID(task["task_id"].(string)),
+			VersionSpec:    converter.String(task["version"].(string)),
+			DefinitionType: converter.String(task["definition_type"].(string)),
+		},
+		DisplayName:      converter.String(task["display_name"].(string)),
+		Enabled:          converter.Bool(task["enabled"].(bool)),
+		Condition:        converter.String(task["condition"].(string)),
+		ContinueOnError:  converter.Bool(task["continue_on_error"].(bool)),
+		AlwaysRun:        converter.Bool(task["always_run"].(bool)),
+		TimeoutInMinutes: converter.Int(task["timeout_in_minutes"].(int)),
+		Inputs:           &inputs,
+		Environment:      &environment,
+	}
+	if refName := task["ref_name"].(string); refName != "" {
+		step.RefName = converter.String(refName)
+	}
+	return step
+}
+
 func expandBuildDefinition(d *schema.ResourceData) (*build.BuildDefinition, string, error) {
 	projectID := d.Get("project_id").(string)
 	repositories := d.Get("repository").([]interface{})
@@ -999,6 +1737,13 @@ func expandBuildDefinition(d *schema.ResourceData) (*build.BuildDefinition, stri
 		return nil, "", fmt.Errorf("Error expanding varibles: %+v", err)
 	}
 
+	process, err := expandProcess(d, repository)
+	if err != nil {
+		return nil, "", err
+	}
+
+	tags := tfhelper.ExpandStringSet(d.Get(bdTags).(*schema.Set))
+
 	buildDefinition := build.BuildDefinition{
 		Id:       buildDefinitionReference,
 		Name:     converter.String(d.Get("name").(string)),
@@ -1016,15 +1761,34 @@ func expandBuildDefinition(d *schema.ResourceData) (*build.BuildDefinition, stri
 				"reportBuildStatus":  strconv.FormatBool(repository["report_build_status"].(bool)),
 			},
 		},
-		Process: &build.YamlProcess{
-			YamlFilename: converter.String(repository["yml_path"].(string)),
-		},
+		Process:        process,
 		QueueStatus:    &build.DefinitionQueueStatusValues.Enabled,
 		Type:           &build.DefinitionTypeValues.Build,
 		Quality:        &build.DefinitionQualityValues.Definition,
 		VariableGroups: expandVariableGroups(d),
 		Variables:      variables,
 		Triggers:       &buildTriggers,
+
+		BadgeEnabled:   converter.Bool(d.Get(bdBadgeEnabled).(bool)),
+		Demands:        expandDemands(d),
+		Tags:           &tags,
+		RetentionRules: expandRetentionRules(d),
+	}
+
+	// the job settings are left to the service unless they are configured or were read before
+	if jobTimeoutInMinutes, ok := d.GetOkExists(bdJobTimeoutInMinutes); ok {
+		buildDefinition.JobTimeoutInMinutes = converter.Int(jobTimeoutInMinutes.(int))
+	}
+	if jobCancelTimeoutInMinutes, ok := d.GetOk(bdJobCancelTimeoutInMinutes); ok {
+		buildDefinition.JobCancelTimeoutInMinutes = converter.Int(jobCancelTimeoutInMinutes.(int))
+	}
+	if jobAuthorizationScope, ok := d.GetOk(bdJobAuthorizationScope); ok {
+		scope := build.BuildAuthorizationScope(jobAuthorizationScope.(string))
+		buildDefinition.JobAuthorizationScope = &scope
+	}
+
+	if buildNumberFormat, ok := d.GetOk(bdBuildNumberFormat); ok {
+		buildDefinition.BuildNumberFormat = converter.String(buildNumberFormat.(string))
 	}
 
 	if agentPoolName, ok := d.GetOk("agent_pool_name"); ok {
@@ -1059,8 +1823,116 @@ func validateServiceConnectionIDExistsIfNeeded(d *schema.ResourceData) error {
 	return nil
 }
 
+// the variables settable at queue time are validated when the variables or the repository of the definition change
+func customizeBuildDefinitionDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChange("repository") && buildVariablesUnchanged(d.GetChange(bdVariable)) {
+		return nil
+	}
+	return validateQueueTimeVariables(m.(*client.AggregatedClient), d)
+}
+
+/**
+ * variables that can be set at queue time must not shadow the runtime parameters declared in the
+ * YAML file of the pipeline, because the parameter would silently win over the queue time value.
+ * The YAML file can only be read for Azure Repos, other repositories are not validated.
+ */
+func validateQueueTimeVariables(clients *client.AggregatedClient, d *schema.ResourceDiff) error {
+	repositories := d.Get("repository").([]interface{})
+	if len(d.Get(bdDesignerProcess).([]interface{})) > 0 || len(repositories) == 0 {
+		return nil
+	}
+
+	var overridable []string
+	for _, variable := range d.Get(bdVariable).(*schema.Set).List() {
+		variableMap := variable.(map[string]interface{})
+		if variableMap[bdVariableAllowOverride].(bool) {
+			overridable = append(overridable, variableMap[bdVariableName].(string))
+		}
+	}
+	if len(overridable) == 0 {
+		return nil
+	}
+
+	repository := repositories[0].(map[string]interface{})
+	if !strings.EqualFold(repository["repo_type"].(string), string(model.RepoTypeValues.TfsGit)) {
+		log.Printf("[WARN] The variables %s can be set at queue time but are not validated against the parameters of the YAML file, the YAML file of %s repositories can't be read",
+			strings.Join(overridable, ", "), repository["repo_type"].(string))
+		return nil
+	}
+	for _, key := range []string{"project_id", "repository.0.repo_id", "repository.0.branch_name", "repository.0.yml_path"} {
+		if !d.NewValueKnown(key) {
+			// the variables are validated once the repository is known
+			return nil
+		}
+	}
+
+	yamlItem, err := clients.GitReposClient.GetItem(clients.Ctx, git.GetItemArgs{
+		Project:        converter.String(d.Get("project_id").(string)),
+		RepositoryId:   converter.String(repository["repo_id"].(string)),
+		Path:           converter.String(repository["yml_path"].(string)),
+		IncludeContent: converter.Bool(true),
+		VersionDescriptor: &git.GitVersionDescriptor{
+			Version:     converter.String(strings.TrimPrefix(repository["branch_name"].(string), "refs/heads/")),
+			VersionType: &git.GitVersionTypeValues.Branch,
+		},
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			log.Printf("[DEBUG] YAML file %s not found, queue time variables are not validated", repository["yml_path"].(string))
+			return nil
+		}
+		return fmt.Errorf("error reading the YAML file of the build definition: %+v", err)
+	}
+
+	parameters, err := parseYamlParameterNames(converter.ToString(yamlItem.Content, ""))
+	if err != nil {
+		return fmt.Errorf("error parsing the YAML file of the build definition: %+v", err)
+	}
+	for _, variable := range overridable {
+		for _, parameter := range parameters {
+			if strings.EqualFold(variable, parameter) {
+				return fmt.Errorf("variable %s can be set at queue time but is shadowed by the YAML parameter %s, set allow_override to false or use the parameter instead", variable, parameter)
+			}
+		}
+	}
+	return nil
+}
+
+// parseYamlParameterNames returns the names of the parameters declared by a pipeline YAML file.
+// Parameters are either a list of runtime parameters or, for templates, a map of default values.
+func parseYamlParameterNames(content string) ([]string, error) {
+	var pipeline struct {
+		Parameters interface{} `yaml:"parameters"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &pipeline); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	switch parameters := pipeline.Parameters.(type) {
+	case []interface{}:
+		for _, parameter := range parameters {
+			if parameterMap, ok := parameter.(map[interface{}]interface{}); ok {
+				if name, ok := parameterMap["name"].(string); ok {
+					names = append(names, name)
+				}
+			}
+		}
+	case map[interface{}]interface{}:
+		for name := range parameters {
+			names = append(names, fmt.Sprintf("%v", name))
+		}
+	}
+	return names, nil
+}
+
 func buildVariableGroup(id int) *build.VariableGroup {
 	return &build.VariableGroup{
 		Id: &id,
 	}
 }
+
+func expandStringList(values []interface{}) *[]string {
+	list := tfhelper.ExpandStringList(values)
+	return &list
+}
@@ -6,14 +6,19 @@ package build
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
@@ -117,11 +122,17 @@ var testBuildDefinition = build.BuildDefinition{
 			Name: converter.String("BuildPoolName"),
 		},
 	},
-	QueueStatus:    &build.DefinitionQueueStatusValues.Enabled,
-	Type:           &build.DefinitionTypeValues.Build,
-	Quality:        &build.DefinitionQualityValues.Definition,
-	Triggers:       &[]interface{}{},
-	VariableGroups: &[]build.VariableGroup{},
+	QueueStatus:               &build.DefinitionQueueStatusValues.Enabled,
+	Type:                      &build.DefinitionTypeValues.Build,
+	Quality:                   &build.DefinitionQualityValues.Definition,
+	Triggers:                  &[]interface{}{},
+	VariableGroups:            &[]build.VariableGroup{},
+	JobTimeoutInMinutes:       converter.Int(60),
+	JobCancelTimeoutInMinutes: converter.Int(5),
+	JobAuthorizationScope:     &build.BuildAuthorizationScopeValues.ProjectCollection,
+	BadgeEnabled:              converter.Bool(false),
+	Demands:                   &[]interface{}{},
+	Tags:                      &[]string{},
 }
 
 // This definition matches the overall structure of what a configured Bitbucket git repository would
@@ -155,10 +166,16 @@ var testBuildDefinitionBitbucketWithCITrigger = build.BuildDefinition{
 			Name: converter.String("BuildPoolName"),
 		},
 	},
-	QueueStatus:    &build.DefinitionQueueStatusValues.Enabled,
-	Type:           &build.DefinitionTypeValues.Build,
-	Quality:        &build.DefinitionQualityValues.Definition,
-	VariableGroups: &[]build.VariableGroup{},
+	QueueStatus:               &build.DefinitionQueueStatusValues.Enabled,
+	Type:                      &build.DefinitionTypeValues.Build,
+	Quality:                   &build.DefinitionQualityValues.Definition,
+	VariableGroups:            &[]build.VariableGroup{},
+	JobTimeoutInMinutes:       converter.Int(60),
+	JobCancelTimeoutInMinutes: converter.Int(5),
+	JobAuthorizationScope:     &build.BuildAuthorizationScopeValues.ProjectCollection,
+	BadgeEnabled:              converter.Bool(false),
+	Demands:                   &[]interface{}{},
+	Tags:                      &[]string{},
 }
 
 // This definition matches the overall structure of what a configured GitHub Enterprise git repository would
@@ -193,10 +210,16 @@ var testBuildDefinitionGitHubEnterpriseWithCITrigger = build.BuildDefinition{
 			Name: converter.String("BuildPoolName"),
 		},
 	},
-	QueueStatus:    &build.DefinitionQueueStatusValues.Enabled,
-	Type:           &build.DefinitionTypeValues.Build,
-	Quality:        &build.DefinitionQualityValues.Definition,
-	VariableGroups: &[]build.VariableGroup{},
+	QueueStatus:               &build.DefinitionQueueStatusValues.Enabled,
+	Type:                      &build.DefinitionTypeValues.Build,
+	Quality:                   &build.DefinitionQualityValues.Definition,
+	VariableGroups:            &[]build.VariableGroup{},
+	JobTimeoutInMinutes:       converter.Int(60),
+	JobCancelTimeoutInMinutes: converter.Int(5),
+	JobAuthorizationScope:     &build.BuildAuthorizationScopeValues.ProjectCollection,
+	BadgeEnabled:              converter.Bool(false),
+	Demands:                   &[]interface{}{},
+	Tags:                      &[]string{},
 }
 
 // This definition matches the overall structure of what a configured Bitbucket git repository would
@@ -227,10 +250,16 @@ func testBuildDefinitionBitbucket() build.BuildDefinition {
 				Name: converter.String("BuildPoolName"),
 			},
 		},
-		QueueStatus:    &build.DefinitionQueueStatusValues.Enabled,
-		Type:           &build.DefinitionTypeValues.Build,
-		Quality:        &build.DefinitionQualityValues.Definition,
-		VariableGroups: &[]build.VariableGroup{},
+		QueueStatus:               &build.DefinitionQueueStatusValues.Enabled,
+		Type:                      &build.DefinitionTypeValues.Build,
+		Quality:                   &build.DefinitionQualityValues.Definition,
+		VariableGroups:            &[]build.VariableGroup{},
+		JobTimeoutInMinutes:       converter.Int(60),
+		JobCancelTimeoutInMinutes: converter.Int(5),
+		JobAuthorizationScope:     &build.BuildAuthorizationScopeValues.ProjectCollection,
+		BadgeEnabled:              converter.Bool(false),
+		Demands:                   &[]interface{}{},
+		Tags:                      &[]string{},
 	}
 }
 
@@ -263,10 +292,16 @@ func testBuildDefinitionGitHubEnterprise() build.BuildDefinition {
 				Name: converter.String("BuildPoolName"),
 			},
 		},
-		QueueStatus:    &build.DefinitionQueueStatusValues.Enabled,
-		Type:           &build.DefinitionTypeValues.Build,
-		Quality:        &build.DefinitionQualityValues.Definition,
-		VariableGroups: &[]build.VariableGroup{},
+		QueueStatus:               &build.DefinitionQueueStatusValues.Enabled,
+		Type:                      &build.DefinitionTypeValues.Build,
+		Quality:                   &build.DefinitionQualityValues.Definition,
+		VariableGroups:            &[]build.VariableGroup{},
+		JobTimeoutInMinutes:       converter.Int(60),
+		JobCancelTimeoutInMinutes: converter.Int(5),
+		JobAuthorizationScope:     &build.BuildAuthorizationScopeValues.ProjectCollection,
+		BadgeEnabled:              converter.Bool(false),
+		Demands:                   &[]interface{}{},
+		Tags:                      &[]string{},
 	}
 }
 
@@ -437,6 +472,222 @@ func TestBuildDefinition_ExpandFlatten_Roundtrip(t *testing.T) {
 	}
 }
 
+// creates a build definition with the job, badge, demand, tag and retention options configured
+func testBuildDefinitionWithOptions() build.BuildDefinition {
+	buildDefinition := testBuildDefinition
+	buildDefinition.BuildNumberFormat = converter.String("$(Date:yyyyMMdd)$(Rev:.r)")
+	buildDefinition.JobTimeoutInMinutes = converter.Int(120)
+	buildDefinition.JobCancelTimeoutInMinutes = converter.Int(10)
+	buildDefinition.JobAuthorizationScope = &build.BuildAuthorizationScopeValues.Project
+	buildDefinition.BadgeEnabled = converter.Bool(true)
+	buildDefinition.Demands = &[]interface{}{"Agent.OS -equals Linux", "docker"}
+	buildDefinition.Tags = &[]string{"governance", "production"}
+	buildDefinition.RetentionRules = &[]build.RetentionPolicy{
+		{
+			Branches:              &[]string{"+refs/heads/*"},
+			Artifacts:             &[]string{},
+			ArtifactTypesToDelete: &[]string{"FilePath", "SymbolStore"},
+			DaysToKeep:            converter.Int(30),
+			MinimumToKeep:         converter.Int(5),
+			DeleteBuildRecord:     converter.Bool(true),
+			DeleteTestResults:     converter.Bool(false),
+		},
+	}
+	return buildDefinition
+}
+
+// verifies that the job settings of the service are kept unless they are configured
+func TestBuildDefinition_Expand_JobSettingsOnlyIfSet(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, secretVariableConfig("secret-value"))
+	buildDefinition, _, err := expandBuildDefinition(resourceData)
+	require.Nil(t, err)
+	require.Nil(t, buildDefinition.JobTimeoutInMinutes)
+	require.Nil(t, buildDefinition.JobCancelTimeoutInMinutes)
+	require.Nil(t, buildDefinition.JobAuthorizationScope)
+
+	config := secretVariableConfig("secret-value")
+	config[bdJobTimeoutInMinutes] = 0
+	config[bdJobAuthorizationScope] = "project"
+	resourceData = schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, config)
+	buildDefinition, _, err = expandBuildDefinition(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, 0, *buildDefinition.JobTimeoutInMinutes)
+	require.Nil(t, buildDefinition.JobCancelTimeoutInMinutes)
+	require.Equal(t, build.BuildAuthorizationScopeValues.Project, *buildDefinition.JobAuthorizationScope)
+}
+
+// verifies that the flatten/expand round trip keeps the job, badge, demand, tag and retention options
+func TestBuildDefinition_ExpandFlatten_Options_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	buildDefinition := testBuildDefinitionWithOptions()
+	flattenBuildDefinition(resourceData, &buildDefinition, testProjectID)
+
+	buildDefinitionAfterRoundTrip, _, err := expandBuildDefinition(resourceData)
+	require.Nil(t, err)
+
+	sort.Strings(*buildDefinitionAfterRoundTrip.Tags)
+	sort.Slice(*buildDefinitionAfterRoundTrip.Demands, func(i, j int) bool {
+		demands := *buildDefinitionAfterRoundTrip.Demands
+		return demands[i].(string) < demands[j].(string)
+	})
+	require.Equal(t, buildDefinition, *buildDefinitionAfterRoundTrip)
+}
+
+// verifies that the options are sent to the service when the build definition is created
+func TestBuildDefinition_Create_SendsOptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	buildDefinition := testBuildDefinitionWithOptions()
+	buildDefinition.Demands = &[]interface{}{"docker"}
+	buildDefinition.Tags = &[]string{"governance"}
+	flattenBuildDefinition(resourceData, &buildDefinition, testProjectID)
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := build.CreateDefinitionArgs{Definition: &buildDefinition, Project: &testProjectID}
+	buildClient.
+		EXPECT().
+		CreateDefinition(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("CreateDefinition() Failed")).
+		Times(1)
+
+	err := resourceBuildDefinitionCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateDefinition() Failed")
+}
+
+// This definition matches the overall structure of a classic (designer) build definition
+var testDesignerBuildDefinition = build.BuildDefinition{
+	Id:       converter.Int(100),
+	Revision: converter.Int(1),
+	Name:     converter.String("Name"),
+	Path:     converter.String("\\"),
+	Repository: &build.BuildRepository{
+		Url:           converter.String("https://github.com/RepoId.git"),
+		Id:            converter.String("RepoId"),
+		Name:          converter.String("RepoId"),
+		DefaultBranch: converter.String("RepoBranchName"),
+		Type:          converter.String("GitHub"),
+		Properties: &map[string]string{
+			"connectedServiceId": "ServiceConnectionID",
+			"apiUrl":             "https://api.github.com/repos/RepoId",
+			"reportBuildStatus":  "true",
+		},
+	},
+	Process: &build.DesignerProcess{
+		Type: converter.Int(processTypeDesigner),
+		Target: &build.DesignerProcessTarget{
+			AgentSpecification: &build.AgentSpecification{
+				Identifier: converter.String("ubuntu-20.04"),
+			},
+		},
+		Phases: &[]build.Phase{
+			{
+				Name:                      converter.String("Agent job 1"),
+				RefName:                   converter.String("Job_1"),
+				Condition:                 converter.String("succeeded()"),
+				JobAuthorizationScope:     &build.BuildAuthorizationScopeValues.ProjectCollection,
+				JobTimeoutInMinutes:       converter.Int(60),
+				JobCancelTimeoutInMinutes: converter.Int(5),
+				Target: &build.PhaseTarget{
+					Type: converter.Int(1),
+				},
+				Variables: &map[string]build.BuildDefinitionVariable{
+					"configuration": {
+						Value:         converter.String("release"),
+						AllowOverride: converter.Bool(true),
+					},
+				},
+				Steps: &[]build.BuildDefinitionStep{
+					{
+						Task: &build.TaskDefinitionReference{
+							Id:             converter.UUID("d9bafed4-0b18-4f58-968d-86655b4d2ce9"),
+							VersionSpec:    converter.String("2.*"),
+							DefinitionType: converter.String("task"),
+						},
+						DisplayName:      converter.String("Run script"),
+						RefName:          converter.String("CmdLine1"),
+						Enabled:          converter.Bool(true),
+						Condition:        converter.String("succeeded()"),
+						ContinueOnError:  converter.Bool(false),
+						AlwaysRun:        converter.Bool(false),
+						TimeoutInMinutes: converter.Int(0),
+						Inputs: &map[string]string{
+							"script": "echo $(configuration)",
+						},
+						Environment: &map[string]string{
+							"DEBUG": "1",
+						},
+					},
+				},
+			},
+		},
+	},
+	Queue: &build.AgentPoolQueue{
+		Name: converter.String("BuildPoolName"),
+		Pool: &build.TaskAgentPoolReference{
+			Name: converter.String("BuildPoolName"),
+		},
+	},
+	QueueStatus:               &build.DefinitionQueueStatusValues.Enabled,
+	Type:                      &build.DefinitionTypeValues.Build,
+	Quality:                   &build.DefinitionQualityValues.Definition,
+	Triggers:                  &[]interface{}{},
+	VariableGroups:            &[]build.VariableGroup{},
+	JobTimeoutInMinutes:       converter.Int(60),
+	JobCancelTimeoutInMinutes: converter.Int(5),
+	JobAuthorizationScope:     &build.BuildAuthorizationScopeValues.ProjectCollection,
+	BadgeEnabled:              converter.Bool(false),
+	Demands:                   &[]interface{}{},
+	Tags:                      &[]string{},
+}
+
+// verifies that the flatten/expand round trip yields the same designer build definition
+func TestBuildDefinition_ExpandFlatten_DesignerProcess_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	flattenBuildDefinition(resourceData, &testDesignerBuildDefinition, testProjectID)
+	buildDefinitionAfterRoundTrip, projectID, err := expandBuildDefinition(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, testDesignerBuildDefinition, *buildDefinitionAfterRoundTrip)
+	require.Equal(t, testProjectID, projectID)
+	require.Equal(t, "", resourceData.Get("repository.0.yml_path"))
+}
+
+// verifies that a designer process read back from the service, as untyped JSON, is flattened
+func TestBuildDefinition_Flatten_DesignerProcessFromService(t *testing.T) {
+	var process interface{}
+	require.Nil(t, converter.ThroughJSON(testDesignerBuildDefinition.Process, &process))
+	buildDefinition := testDesignerBuildDefinition
+	buildDefinition.Process = process
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	flattenBuildDefinition(resourceData, &buildDefinition, testProjectID)
+
+	require.Equal(t, "ubuntu-20.04", resourceData.Get("designer_process.0.agent_specification"))
+	require.Equal(t, "Job_1", resourceData.Get("designer_process.0.phase.0.ref_name"))
+	require.Equal(t, "agent", resourceData.Get("designer_process.0.phase.0.target_type"))
+	require.Equal(t, "d9bafed4-0b18-4f58-968d-86655b4d2ce9", resourceData.Get("designer_process.0.phase.0.task.0.task_id"))
+	require.Equal(t, "echo $(configuration)", resourceData.Get("designer_process.0.phase.0.task.0.inputs.script"))
+}
+
+// verifies that the YAML file is required when no designer process is configured
+func TestBuildDefinition_Expand_RequiresYamlPathWithoutDesignerProcess(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	flattenBuildDefinition(resourceData, &testBuildDefinition, testProjectID)
+	resourceData.Set("repository", []interface{}{map[string]interface{}{
+		"repo_id":   "RepoId",
+		"repo_type": "GitHub",
+		"yml_path":  "",
+	}})
+
+	_, _, err := expandBuildDefinition(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "yml_path must be set")
+}
+
 // verifies that an expand will fail if there is insufficient configuration data found in the resource
 func TestBuildDefinition_Expand_FailsIfNotEnoughData(t *testing.T) {
 	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
@@ -549,6 +800,239 @@ func TestExpandVariables_CatchesDuplicateVariables(t *testing.T) {
 	require.Contains(t, err.Error(), "Unexpectedly found duplicate variable with name")
 }
 
+// secretVariableConfig returns the configuration of a build definition with a single secret variable
+func secretVariableConfig(secretValue string) map[string]interface{} {
+	return map[string]interface{}{
+		"project_id": testProjectID,
+		"name":       "Name",
+		"repository": []interface{}{
+			map[string]interface{}{
+				"repo_type": "TfsGit",
+				"repo_id":   "RepoId",
+				"yml_path":  "azure-pipelines.yml",
+			},
+		},
+		bdVariable: []interface{}{
+			map[string]interface{}{
+				bdVariableName:        "secret",
+				bdSecretVariableValue: secretValue,
+				bdVariableIsSecret:    true,
+			},
+		},
+	}
+}
+
+// secretVariableState returns the state of a build definition with a single secret variable
+// applied with the given secret value
+func secretVariableState(t *testing.T, secretValue string) *terraform.InstanceState {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, secretVariableConfig(secretValue))
+	resourceData.SetId("100")
+
+	buildDefinition := testBuildDefinition
+	buildDefinition.Variables = &map[string]build.BuildDefinitionVariable{
+		"secret": {
+			IsSecret:      converter.Bool(true),
+			AllowOverride: converter.Bool(true),
+		},
+	}
+	resourceData.Set(bdVariable, flattenBuildVariables(resourceData, &buildDefinition))
+	return resourceData.State()
+}
+
+// secretVariableDiff returns the planned changes of the variables
+func secretVariableDiff(t *testing.T, state *terraform.InstanceState, secretValue string) map[string]*terraform.ResourceAttrDiff {
+	diff, err := ResourceBuildDefinition().Diff(state, terraform.NewResourceConfigRaw(secretVariableConfig(secretValue)), yamlFileClients(t, "steps:\n- script: echo\n"))
+	require.Nil(t, err)
+
+	variableDiff := map[string]*terraform.ResourceAttrDiff{}
+	if diff != nil {
+		for key, attributeDiff := range diff.Attributes {
+			if strings.HasPrefix(key, bdVariable+".") {
+				variableDiff[key] = attributeDiff
+			}
+		}
+	}
+	return variableDiff
+}
+
+// yamlFileClients returns clients whose repositories contain the YAML file with the given content
+func yamlFileClients(t *testing.T, content string) *client.AggregatedClient {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	gitClient.
+		EXPECT().
+		GetItem(gomock.Any(), gomock.Any()).
+		Return(&git.GitItem{Content: converter.String(content)}, nil).
+		AnyTimes()
+	return &client.AggregatedClient{GitReposClient: gitClient, Ctx: context.Background()}
+}
+
+// verifies that a variable settable at queue time cannot be shadowed by a YAML runtime parameter
+func TestBuildDefinition_Diff_QueueTimeVariableShadowedByParameter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: gitClient, Ctx: context.Background()}
+
+	expectedArgs := git.GetItemArgs{
+		Project:        converter.String(testProjectID),
+		RepositoryId:   converter.String("RepoId"),
+		Path:           converter.String("azure-pipelines.yml"),
+		IncludeContent: converter.Bool(true),
+		VersionDescriptor: &git.GitVersionDescriptor{
+			Version:     converter.String("master"),
+			VersionType: &git.GitVersionTypeValues.Branch,
+		},
+	}
+	gitClient.
+		EXPECT().
+		GetItem(clients.Ctx, expectedArgs).
+		Return(&git.GitItem{
+			Content: converter.String("parameters:\n- name: secret\n  type: string\n  default: dev\nsteps:\n- script: echo ${{ parameters.secret }}\n"),
+		}, nil).
+		Times(1)
+
+	_, err := ResourceBuildDefinition().Diff(nil, terraform.NewResourceConfigRaw(secretVariableConfig("secret-value")), clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "shadowed by the YAML parameter secret")
+}
+
+// verifies that the YAML file is not read if no variable can be set at queue time
+func TestBuildDefinition_Diff_QueueTimeVariablesSkippedWithoutOverridableVariables(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: gitClient, Ctx: context.Background()}
+	gitClient.
+		EXPECT().
+		GetItem(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	config := secretVariableConfig("secret-value")
+	config[bdVariable].([]interface{})[0].(map[string]interface{})[bdVariableAllowOverride] = false
+	_, err := ResourceBuildDefinition().Diff(nil, terraform.NewResourceConfigRaw(config), clients)
+	require.Nil(t, err)
+}
+
+// verifies that the YAML file of repositories outside of Azure Repos is not read
+func TestBuildDefinition_Diff_QueueTimeVariablesSkippedForOtherRepositories(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: gitClient, Ctx: context.Background()}
+	gitClient.
+		EXPECT().
+		GetItem(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	config := secretVariableConfig("secret-value")
+	config["repository"].([]interface{})[0].(map[string]interface{})["repo_type"] = "GitHub"
+	_, err := ResourceBuildDefinition().Diff(nil, terraform.NewResourceConfigRaw(config), clients)
+	require.Nil(t, err)
+}
+
+func TestBuildDefinition_ParseYamlParameterNames(t *testing.T) {
+	names, err := parseYamlParameterNames("parameters:\n- name: first\n- name: second\n")
+	require.Nil(t, err)
+	require.ElementsMatch(t, []string{"first", "second"}, names)
+
+	names, err = parseYamlParameterNames("parameters:\n  first: a\n  second: b\n")
+	require.Nil(t, err)
+	require.ElementsMatch(t, []string{"first", "second"}, names)
+
+	names, err = parseYamlParameterNames("steps:\n- script: echo\n")
+	require.Nil(t, err)
+	require.Empty(t, names)
+}
+
+// verifies that only the hash of a secret variable is kept in the state
+func TestBuildDefinition_Flatten_SecretVariableHash(t *testing.T) {
+	state := secretVariableState(t, "secret-value")
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	resourceData.Set(bdVariable, flattenBuildVariables(secretVariableResourceData(t, state), &build.BuildDefinition{
+		Variables: &map[string]build.BuildDefinitionVariable{
+			"secret": {IsSecret: converter.Bool(true), AllowOverride: converter.Bool(true)},
+		},
+	}))
+
+	variables := resourceData.Get(bdVariable).(*schema.Set).List()
+	require.Len(t, variables, 1)
+	variable := variables[0].(map[string]interface{})
+	require.Equal(t, "", variable[bdSecretVariableValue])
+	require.NotEmpty(t, variable[bdSecretVariableHash])
+	require.NotEmpty(t, variable[bdSecretVariableSalt])
+	for _, value := range state.Attributes {
+		require.NotEqual(t, "secret-value", value)
+	}
+}
+
+// verifies that the hash of a secret is salted, the same secret is stored with different hashes
+func TestBuildDefinition_Flatten_SecretVariableHashIsSalted(t *testing.T) {
+	hashes := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		variables := secretVariableResourceData(t, secretVariableState(t, "secret-value")).Get(bdVariable).(*schema.Set).List()
+		require.Len(t, variables, 1)
+		hashes[variables[0].(map[string]interface{})[bdSecretVariableHash].(string)] = true
+	}
+	require.Len(t, hashes, 2)
+
+	unsalted := sha256.Sum256([]byte("secret-value"))
+	require.False(t, hashes[hex.EncodeToString(unsalted[:])])
+}
+
+// verifies that an unchanged secret variable plans no changes
+func TestBuildDefinition_Diff_UnchangedSecretVariable(t *testing.T) {
+	state := secretVariableState(t, "secret-value")
+	require.Empty(t, secretVariableDiff(t, state, "secret-value"))
+}
+
+// verifies that a rotated secret variable plans an update
+func TestBuildDefinition_Diff_RotatedSecretVariable(t *testing.T) {
+	state := secretVariableState(t, "secret-value")
+
+	variableDiff := secretVariableDiff(t, state, "rotated-secret-value")
+	require.NotEmpty(t, variableDiff)
+	for key, attributeDiff := range variableDiff {
+		require.False(t, attributeDiff.RequiresNew, key)
+	}
+}
+
+// verifies that an unchanged secret is sent as null, so that the secret of the definition is kept
+func TestBuildDefinition_Expand_UnchangedSecretVariable(t *testing.T) {
+	resourceData := secretVariableResourceData(t, secretVariableState(t, "secret-value"))
+
+	variables, err := expandVariables(resourceData)
+	require.Nil(t, err)
+	require.Nil(t, (*variables)["secret"].Value)
+	require.True(t, *(*variables)["secret"].IsSecret)
+}
+
+// verifies that a rotated secret is sent to the service
+func TestBuildDefinition_Expand_RotatedSecretVariable(t *testing.T) {
+	state := secretVariableState(t, "secret-value")
+	diff, err := ResourceBuildDefinition().Diff(state, terraform.NewResourceConfigRaw(secretVariableConfig("rotated-secret-value")), yamlFileClients(t, "steps:\n- script: echo\n"))
+	require.Nil(t, err)
+	resourceData, err := schema.InternalMap(ResourceBuildDefinition().Schema).Data(state, diff)
+	require.Nil(t, err)
+
+	variables, err := expandVariables(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, "rotated-secret-value", *(*variables)["secret"].Value)
+}
+
+// secretVariableResourceData returns the resource data read from the state
+func secretVariableResourceData(t *testing.T, state *terraform.InstanceState) *schema.ResourceData {
+	resourceData, err := schema.InternalMap(ResourceBuildDefinition().Schema).Data(state, nil)
+	require.Nil(t, err)
+	return resourceData
+}
+
 func sortBuildDefinition(b build.BuildDefinition) build.BuildDefinition {
 	if b.Triggers == nil {
 		return b
@@ -0,0 +1,213 @@
+package build
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/validate"
+)
+
+const (
+	bfProjectID           = "project_id"
+	bfPath                = "path"
+	bfDescription         = "description"
+	bfAllowDeleteNonEmpty = "allow_delete_non_empty"
+)
+
+// ResourceBuildFolder schema and implementation for build folder resource
+func ResourceBuildFolder() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceBuildFolderCreate,
+		Read:     resourceBuildFolderRead,
+		Update:   resourceBuildFolderUpdate,
+		Delete:   resourceBuildFolderDelete,
+		Importer: tfhelper.ImportProjectQualifiedResource(),
+		Schema: map[string]*schema.Schema{
+			bfProjectID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			bfPath: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFolderPath,
+			},
+			bfDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			bfAllowDeleteNonEmpty: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceBuildFolderCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(bfProjectID).(string)
+	path := d.Get(bfPath).(string)
+
+	createdFolder, err := clients.BuildClient.CreateFolder(clients.Ctx, build.CreateFolderArgs{
+		Project: converter.String(projectID),
+		Path:    converter.String(path),
+		Folder:  expandBuildFolder(d),
+	})
+	if err != nil {
+		return fmt.Errorf(" creating build folder %s: %+v", path, err)
+	}
+
+	d.SetId(converter.ToString(createdFolder.Path, path))
+	return resourceBuildFolderRead(d, m)
+}
+
+func resourceBuildFolderRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(bfProjectID).(string)
+
+	folder, err := getBuildFolder(clients, projectID, d.Id())
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading build folder %s: %+v", d.Id(), err)
+	}
+	if folder == nil {
+		log.Printf("[INFO] Build folder %s not found. Removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set(bfPath, converter.ToString(folder.Path, ""))
+	d.Set(bfDescription, converter.ToString(folder.Description, ""))
+	return nil
+}
+
+func resourceBuildFolderUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(bfProjectID).(string)
+
+	// the folder is addressed by its current path, a changed path renames the folder
+	updatedFolder, err := clients.BuildClient.UpdateFolder(clients.Ctx, build.UpdateFolderArgs{
+		Project: converter.String(projectID),
+		Path:    converter.String(d.Id()),
+		Folder:  expandBuildFolder(d),
+	})
+	if err != nil {
+		return fmt.Errorf(" updating build folder %s: %+v", d.Id(), err)
+	}
+
+	d.SetId(converter.ToString(updatedFolder.Path, d.Get(bfPath).(string)))
+	return resourceBuildFolderRead(d, m)
+}
+
+func resourceBuildFolderDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(bfProjectID).(string)
+
+	// deleting a folder deletes all build definitions below it
+	if !d.Get(bfAllowDeleteNonEmpty).(bool) {
+		definitions, err := getBuildDefinitionsInFolder(clients, projectID, d.Id())
+		if err != nil {
+			return fmt.Errorf(" listing build definitions of folder %s: %+v", d.Id(), err)
+		}
+		if len(definitions) > 0 {
+			return fmt.Errorf(" build folder %s contains %d build definition(s) (%s), set %s to true to delete them with the folder",
+				d.Id(), len(definitions), strings.Join(definitions, ", "), bfAllowDeleteNonEmpty)
+		}
+	}
+
+	err := clients.BuildClient.DeleteFolder(clients.Ctx, build.DeleteFolderArgs{
+		Project: converter.String(projectID),
+		Path:    converter.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf(" deleting build folder %s: %+v", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandBuildFolder(d *schema.ResourceData) *build.Folder {
+	return &build.Folder{
+		Path:        converter.String(d.Get(bfPath).(string)),
+		Description: converter.String(d.Get(bfDescription).(string)),
+	}
+}
+
+// getBuildFolder returns the folder with the given path or nil if the folder does not exist
+func getBuildFolder(clients *client.AggregatedClient, projectID string, path string) (*build.Folder, error) {
+	folders, err := clients.BuildClient.GetFolders(clients.Ctx, build.GetFoldersArgs{
+		Project: converter.String(projectID),
+		Path:    converter.String(path),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if folders == nil {
+		return nil, nil
+	}
+
+	for _, folder := range *folders {
+		if folder.Path != nil && strings.EqualFold(*folder.Path, path) {
+			return &folder, nil
+		}
+	}
+	return nil, nil
+}
+
+// getBuildDefinitionsInFolder returns the names of the build definitions in the folder and its sub folders
+func getBuildDefinitionsInFolder(clients *client.AggregatedClient, projectID string, path string) ([]string, error) {
+	var definitions []string
+	continuationToken := ""
+	for {
+		args := build.GetDefinitionsArgs{
+			Project: converter.String(projectID),
+		}
+		if continuationToken != "" {
+			args.ContinuationToken = converter.String(continuationToken)
+		}
+		response, err := clients.BuildClient.GetDefinitions(clients.Ctx, args)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, definition := range response.Value {
+			if definition.Path != nil && isBuildFolderOrSubFolder(*definition.Path, path) {
+				definitions = append(definitions, converter.ToString(definition.Name, ""))
+			}
+		}
+
+		continuationToken = response.ContinuationToken
+		if continuationToken == "" {
+			return definitions, nil
+		}
+	}
+}
+
+func isBuildFolderOrSubFolder(path string, folder string) bool {
+	path = strings.ToLower(path)
+	folder = strings.ToLower(folder)
+	return path == folder || strings.HasPrefix(path, folder+`\`)
+}
+
+func validateFolderPath(i interface{}, k string) ([]string, []error) {
+	if v, ok := i.(string); ok && v == `\` {
+		return nil, []error{fmt.Errorf("%q must not be the root folder", k)}
+	}
+	return validate.Path(i, k)
+}
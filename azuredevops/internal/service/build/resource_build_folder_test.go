@@ -0,0 +1,179 @@
+//go:build (all || resource_build_folder) && !exclude_resource_build_folder
+// +build all resource_build_folder
+// +build !exclude_resource_build_folder
+
+package build
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testBuildFolderProjectID = "2d1a7b3f-3d5c-4d9c-9f0e-8c3b6a1e5f42"
+var testBuildFolderPath = `\Team\Pipelines`
+
+func getBuildFolderResourceData(t *testing.T, allowDeleteNonEmpty bool) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, ResourceBuildFolder().Schema, map[string]interface{}{
+		bfProjectID:           testBuildFolderProjectID,
+		bfPath:                testBuildFolderPath,
+		bfDescription:         "description",
+		bfAllowDeleteNonEmpty: allowDeleteNonEmpty,
+	})
+	d.SetId(testBuildFolderPath)
+	return d
+}
+
+// verifies that an error on create is not swallowed
+func TestBuildFolder_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := build.CreateFolderArgs{
+		Project: converter.String(testBuildFolderProjectID),
+		Path:    converter.String(testBuildFolderPath),
+		Folder: &build.Folder{
+			Path:        converter.String(testBuildFolderPath),
+			Description: converter.String("description"),
+		},
+	}
+	buildClient.
+		EXPECT().
+		CreateFolder(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("CreateFolder() Failed")).
+		Times(1)
+
+	err := resourceBuildFolderCreate(getBuildFolderResourceData(t, false), clients)
+	require.Contains(t, err.Error(), "CreateFolder() Failed")
+}
+
+// verifies that a folder missing from the service is removed from the state
+func TestBuildFolder_Read_RemovesMissingFolder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetFolders(clients.Ctx, gomock.Any()).
+		Return(&[]build.Folder{
+			{Path: converter.String(testBuildFolderPath + `\Sub`)},
+		}, nil).
+		Times(1)
+
+	d := getBuildFolderResourceData(t, false)
+	err := resourceBuildFolderRead(d, clients)
+	require.Nil(t, err)
+	require.Empty(t, d.Id())
+}
+
+// verifies that a renamed folder is addressed by its previous path
+func TestBuildFolder_Update_RenamesFolder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, Ctx: context.Background()}
+
+	d := getBuildFolderResourceData(t, false)
+	d.SetId(`\Team\Old`)
+
+	expectedArgs := build.UpdateFolderArgs{
+		Project: converter.String(testBuildFolderProjectID),
+		Path:    converter.String(`\Team\Old`),
+		Folder: &build.Folder{
+			Path:        converter.String(testBuildFolderPath),
+			Description: converter.String("description"),
+		},
+	}
+	buildClient.
+		EXPECT().
+		UpdateFolder(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("UpdateFolder() Failed")).
+		Times(1)
+
+	err := resourceBuildFolderUpdate(d, clients)
+	require.Contains(t, err.Error(), "UpdateFolder() Failed")
+}
+
+// verifies that a folder containing build definitions is not deleted without opting in
+func TestBuildFolder_Delete_RefusesNonEmptyFolder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetDefinitions(clients.Ctx, gomock.Any()).
+		Return(&build.GetDefinitionsResponseValue{
+			Value: []build.BuildDefinitionReference{
+				{Name: converter.String("other"), Path: converter.String(`\Team\PipelinesOther`)},
+				{Name: converter.String("nested"), Path: converter.String(`\team\pipelines\Sub`)},
+			},
+		}, nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		DeleteFolder(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := resourceBuildFolderDelete(getBuildFolderResourceData(t, false), clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "contains 1 build definition(s) (nested)")
+}
+
+// verifies that the definitions are not checked if deleting a non empty folder is allowed
+func TestBuildFolder_Delete_AllowsNonEmptyFolder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetDefinitions(gomock.Any(), gomock.Any()).
+		Times(0)
+	buildClient.
+		EXPECT().
+		DeleteFolder(clients.Ctx, build.DeleteFolderArgs{
+			Project: converter.String(testBuildFolderProjectID),
+			Path:    converter.String(testBuildFolderPath),
+		}).
+		Return(nil).
+		Times(1)
+
+	d := getBuildFolderResourceData(t, true)
+	err := resourceBuildFolderDelete(d, clients)
+	require.Nil(t, err)
+	require.Empty(t, d.Id())
+}
+
+func TestBuildFolder_ValidateFolderPath(t *testing.T) {
+	_, errors := validateFolderPath(`\`, bfPath)
+	require.NotEmpty(t, errors)
+
+	_, errors = validateFolderPath(`\Team\`, bfPath)
+	require.NotEmpty(t, errors)
+
+	_, errors = validateFolderPath(`\Team\Pipelines`, bfPath)
+	require.Empty(t, errors)
+}
@@ -0,0 +1,390 @@
+package build
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+const (
+	brProjectID         = "project_id"
+	brDefinitionID      = "definition_id"
+	brBranch            = "branch"
+	brParameters        = "parameters"
+	brVariables         = "variables"
+	brTriggers          = "triggers"
+	brWaitForCompletion = "wait_for_completion"
+	brRunID             = "run_id"
+	brBuildNumber       = "build_number"
+	brStatus            = "status"
+	brResult            = "result"
+	brArtifacts         = "artifacts"
+)
+
+// ResourceBuildRun schema and implementation for build run resource
+func ResourceBuildRun() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBuildRunCreate,
+		Read:   resourceBuildRunRead,
+		Delete: resourceBuildRunDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			brProjectID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			brDefinitionID: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			brBranch: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				// the service returns the full name of the branch, e.g. refs/heads/main for main
+				DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+					return strings.TrimPrefix(old, "refs/heads/") == strings.TrimPrefix(new, "refs/heads/")
+				},
+			},
+			brParameters: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			brVariables: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			brTriggers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			brWaitForCompletion: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			brRunID: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			brBuildNumber: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			brStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			brResult: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			brArtifacts: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"download_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBuildRunCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(brProjectID).(string)
+
+	buildToQueue, err := expandBuildRun(d)
+	if err != nil {
+		return err
+	}
+
+	var queuedBuild *build.Build
+	templateParameters := expandStringMap(d.Get(brParameters).(map[string]interface{}))
+	if len(templateParameters) > 0 {
+		queuedBuild, err = azDOBuildQueueBuild(clients.Ctx, clients.BuildClient, azDOBuildQueueBuildArgs{
+			Build: &azDOBuildWithTemplateParameters{
+				Build:              *buildToQueue,
+				TemplateParameters: templateParameters,
+			},
+			Project: converter.String(projectID),
+		})
+	} else {
+		queuedBuild, err = clients.BuildClient.QueueBuild(clients.Ctx, build.QueueBuildArgs{
+			Build:   buildToQueue,
+			Project: converter.String(projectID),
+		})
+	}
+	if err != nil {
+		return fmt.Errorf(" queuing build of definition %d: %+v", d.Get(brDefinitionID).(int), err)
+	}
+
+	d.SetId(strconv.Itoa(*queuedBuild.Id))
+
+	if d.Get(brWaitForCompletion).(bool) {
+		completedBuild, err := waitForBuildCompletion(clients, projectID, *queuedBuild.Id, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+		if completedBuild.Result != nil &&
+			(*completedBuild.Result == build.BuildResultValues.Failed || *completedBuild.Result == build.BuildResultValues.Canceled) {
+			// the run stays in the state, it is tainted and queued again on the next apply
+			if err := resourceBuildRunRead(d, m); err != nil {
+				return err
+			}
+			return fmt.Errorf(" build %s of definition %d finished with result %s",
+				converter.ToString(completedBuild.BuildNumber, d.Id()), d.Get(brDefinitionID).(int), string(*completedBuild.Result))
+		}
+	}
+
+	return resourceBuildRunRead(d, m)
+}
+
+func resourceBuildRunRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(brProjectID).(string)
+
+	buildID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing build run ID: %+v", err)
+	}
+
+	queuedBuild, err := clients.BuildClient.GetBuild(clients.Ctx, build.GetBuildArgs{
+		Project: converter.String(projectID),
+		BuildId: converter.Int(buildID),
+	})
+	// a run removed by the retention policies keeps its last known state, it must not be queued again
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			log.Printf("[INFO] Build run %d does not exist anymore. Keeping the last known state", buildID)
+			return nil
+		}
+		return fmt.Errorf(" reading build run %d: %+v", buildID, err)
+	}
+	if queuedBuild.Deleted != nil && *queuedBuild.Deleted {
+		log.Printf("[INFO] Build run %d has been deleted. Keeping the last known state", buildID)
+		return nil
+	}
+
+	artifacts, err := clients.BuildClient.GetArtifacts(clients.Ctx, build.GetArtifactsArgs{
+		Project: converter.String(projectID),
+		BuildId: converter.Int(buildID),
+	})
+	if err != nil {
+		return fmt.Errorf(" reading artifacts of build run %d: %+v", buildID, err)
+	}
+
+	flattenBuildRun(d, queuedBuild, artifacts)
+	return nil
+}
+
+// builds are part of the history of the pipeline, deleting the resource only removes it from the state
+func resourceBuildRunDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+func expandBuildRun(d *schema.ResourceData) (*build.Build, error) {
+	buildToQueue := build.Build{
+		Definition: &build.DefinitionReference{
+			Id: converter.Int(d.Get(brDefinitionID).(int)),
+		},
+	}
+
+	if branch, ok := d.GetOk(brBranch); ok {
+		buildToQueue.SourceBranch = converter.String(branch.(string))
+	}
+
+	variables := expandStringMap(d.Get(brVariables).(map[string]interface{}))
+	if len(variables) > 0 {
+		// queue time variables are sent as JSON encoded dictionary
+		parameters, err := json.Marshal(variables)
+		if err != nil {
+			return nil, fmt.Errorf(" encoding variables of the build run: %+v", err)
+		}
+		buildToQueue.Parameters = converter.String(string(parameters))
+	}
+	return &buildToQueue, nil
+}
+
+func flattenBuildRun(d *schema.ResourceData, queuedBuild *build.Build, artifacts *[]build.BuildArtifact) {
+	d.Set(brRunID, converter.ToInt(queuedBuild.Id, 0))
+	d.Set(brBuildNumber, converter.ToString(queuedBuild.BuildNumber, ""))
+	d.Set(brBranch, converter.ToString(queuedBuild.SourceBranch, ""))
+	if queuedBuild.Definition != nil && queuedBuild.Definition.Id != nil {
+		d.Set(brDefinitionID, *queuedBuild.Definition.Id)
+	}
+	if queuedBuild.Status != nil {
+		d.Set(brStatus, string(*queuedBuild.Status))
+	}
+	if queuedBuild.Result != nil {
+		d.Set(brResult, string(*queuedBuild.Result))
+	}
+
+	flattenedArtifacts := []interface{}{}
+	if artifacts != nil {
+		for _, artifact := range *artifacts {
+			artifactMap := map[string]interface{}{
+				"name": converter.ToString(artifact.Name, ""),
+			}
+			if artifact.Resource != nil {
+				artifactMap["type"] = converter.ToString(artifact.Resource.Type, "")
+				artifactMap["download_url"] = converter.ToString(artifact.Resource.DownloadUrl, "")
+			}
+			flattenedArtifacts = append(flattenedArtifacts, artifactMap)
+		}
+	}
+	d.Set(brArtifacts, flattenedArtifacts)
+}
+
+// Wait until the build is completed or the timeout is reached
+func waitForBuildCompletion(clients *client.AggregatedClient, projectID string, buildID int, timeout time.Duration) (*build.Build, error) {
+	stateConf := &resource.StateChangeConf{
+		ContinuousTargetOccurence: 1,
+		Delay:                     10 * time.Second,
+		MinTimeout:                10 * time.Second,
+		Pending: []string{
+			string(build.BuildStatusValues.None),
+			string(build.BuildStatusValues.NotStarted),
+			string(build.BuildStatusValues.Postponed),
+			string(build.BuildStatusValues.InProgress),
+			string(build.BuildStatusValues.Cancelling),
+		},
+		Target: []string{
+			string(build.BuildStatusValues.Completed),
+		},
+		Refresh: buildStatusRefreshFunc(clients, projectID, buildID),
+		Timeout: timeout,
+	}
+
+	completedBuild, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, fmt.Errorf(" waiting for build %d to complete. %v ", buildID, err)
+	}
+	return completedBuild.(*build.Build), nil
+}
+
+func buildStatusRefreshFunc(clients *client.AggregatedClient, projectID string, buildID int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		queuedBuild, err := clients.BuildClient.GetBuild(clients.Ctx, build.GetBuildArgs{
+			Project: converter.String(projectID),
+			BuildId: converter.Int(buildID),
+		})
+		if err != nil {
+			return nil, string(build.BuildStatusValues.None), err
+		}
+
+		status := build.BuildStatusValues.None
+		if queuedBuild.Status != nil {
+			status = *queuedBuild.Status
+		}
+		if status != build.BuildStatusValues.Completed {
+			log.Printf("[DEBUG] Waiting for build %d to complete. Build status %s", buildID, status)
+		}
+		return queuedBuild, string(status), nil
+	}
+}
+
+type azDOBuildWithTemplateParameters struct {
+	build.Build
+	// The runtime parameters of a YAML pipeline
+	TemplateParameters map[string]string `json:"templateParameters,omitempty"`
+}
+
+type azDOBuildQueueBuildArgs struct {
+	// (required)
+	Build *azDOBuildWithTemplateParameters
+	// (required) Project ID or project name
+	Project *string
+}
+
+// azDOBuildQueueBuild sends the request itself because build.Build does not
+// contain the runtime parameters of a YAML pipeline
+func azDOBuildQueueBuild(ctx context.Context, buildClient build.Client, args azDOBuildQueueBuildArgs) (*build.Build, error) {
+	if args.Build == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Build"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+
+	body, marshalErr := json.Marshal(*args.Build)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	locationID, _ := uuid.Parse("0cd358e1-9217-4d94-8269-1c1ee6f93dcf")
+	sdkClient, err := client.UnwrapClient(buildClient)
+	if err != nil {
+		return nil, err
+	}
+	if clientImpl, ok := sdkClient.(*build.ClientImpl); ok {
+		resp, err := clientImpl.Client.Send(ctx, http.MethodPost, locationID, "6.0", routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+		if err != nil {
+			return nil, err
+		}
+		var responseValue build.Build
+		err = clientImpl.Client.UnmarshalBody(resp, &responseValue)
+		return &responseValue, err
+	}
+	return nil, fmt.Errorf("Invalid Azure DevOps Build client implementation %T", buildClient)
+}
+
+func expandStringMap(m map[string]interface{}) map[string]string {
+	values := make(map[string]string, len(m))
+	for key, value := range m {
+		values[key] = value.(string)
+	}
+	return values
+}
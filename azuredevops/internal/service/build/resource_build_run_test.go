@@ -0,0 +1,222 @@
+//go:build (all || resource_build_run) && !exclude_resource_build_run
+// +build all resource_build_run
+// +build !exclude_resource_build_run
+
+package build
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testBuildRunProjectID = "8d6b9a2e-5c0f-4f4e-9a3c-6f1d2e7b8c90"
+
+var testBuildRun = build.Build{
+	Id:           converter.Int(42),
+	BuildNumber:  converter.String("20210101.1"),
+	SourceBranch: converter.String("refs/heads/main"),
+	Definition: &build.DefinitionReference{
+		Id: converter.Int(7),
+	},
+	Status: &build.BuildStatusValues.Completed,
+	Result: &build.BuildResultValues.Succeeded,
+}
+
+func getBuildRunResourceData(t *testing.T, waitForCompletion bool) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceBuildRun().Schema, map[string]interface{}{
+		brProjectID:         testBuildRunProjectID,
+		brDefinitionID:      7,
+		brBranch:            "refs/heads/main",
+		brVariables:         map[string]interface{}{"environment": "dev"},
+		brWaitForCompletion: waitForCompletion,
+	})
+}
+
+// verifies that the branch and the queue time variables are sent to the service
+func TestBuildRun_ExpandBuildRun(t *testing.T) {
+	buildToQueue, err := expandBuildRun(getBuildRunResourceData(t, false))
+	require.Nil(t, err)
+	require.Equal(t, 7, *buildToQueue.Definition.Id)
+	require.Equal(t, "refs/heads/main", *buildToQueue.SourceBranch)
+	require.Equal(t, `{"environment":"dev"}`, *buildToQueue.Parameters)
+}
+
+// verifies that an error on queuing the build is not swallowed
+func TestBuildRun_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		QueueBuild(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("QueueBuild() Failed")).
+		Times(1)
+
+	d := getBuildRunResourceData(t, false)
+	err := resourceBuildRunCreate(d, clients)
+	require.Contains(t, err.Error(), "QueueBuild() Failed")
+	require.Empty(t, d.Id())
+}
+
+// verifies that the run, its result and its artifacts are read after queuing without waiting
+func TestBuildRun_Create_WithoutWaiting(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		QueueBuild(clients.Ctx, gomock.Any()).
+		Return(&testBuildRun, nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		GetBuild(clients.Ctx, build.GetBuildArgs{
+			Project: converter.String(testBuildRunProjectID),
+			BuildId: converter.Int(42),
+		}).
+		Return(&testBuildRun, nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		GetArtifacts(clients.Ctx, gomock.Any()).
+		Return(&[]build.BuildArtifact{
+			{
+				Name: converter.String("drop"),
+				Resource: &build.ArtifactResource{
+					Type:        converter.String("Container"),
+					DownloadUrl: converter.String("https://dev.azure.com/drop.zip"),
+				},
+			},
+		}, nil).
+		Times(1)
+
+	d := getBuildRunResourceData(t, false)
+	err := resourceBuildRunCreate(d, clients)
+	require.Nil(t, err)
+	require.Equal(t, "42", d.Id())
+	require.Equal(t, 42, d.Get(brRunID))
+	require.Equal(t, "20210101.1", d.Get(brBuildNumber))
+	require.Equal(t, "succeeded", d.Get(brResult))
+	require.Equal(t, "drop", d.Get("artifacts.0.name"))
+	require.Equal(t, "https://dev.azure.com/drop.zip", d.Get("artifacts.0.download_url"))
+}
+
+// verifies that a build removed by the retention policies keeps its last known state
+func TestBuildRun_Read_KeepsDeletedBuild(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, Ctx: context.Background()}
+
+	deletedBuild := testBuildRun
+	deletedBuild.Deleted = converter.Bool(true)
+	buildClient.
+		EXPECT().
+		GetBuild(clients.Ctx, gomock.Any()).
+		Return(&deletedBuild, nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		GetBuild(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+
+	d := getBuildRunResourceData(t, false)
+	d.SetId("42")
+	d.Set(brBuildNumber, "20210101.1")
+	for i := 0; i < 2; i++ {
+		err := resourceBuildRunRead(d, clients)
+		require.Nil(t, err)
+		require.Equal(t, "42", d.Id())
+		require.Equal(t, "20210101.1", d.Get(brBuildNumber))
+	}
+}
+
+// verifies that a branch configured by its short name does not queue a new run
+func TestBuildRun_Diff_ShortBranchName(t *testing.T) {
+	r := ResourceBuildRun()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		brProjectID:    testBuildRunProjectID,
+		brDefinitionID: 7,
+		brBranch:       "main",
+	})
+	d.SetId("42")
+	flattenBuildRun(d, &testBuildRun, nil)
+	require.Equal(t, "refs/heads/main", d.Get(brBranch))
+
+	config := map[string]interface{}{
+		brProjectID:    testBuildRunProjectID,
+		brDefinitionID: 7,
+		brBranch:       "main",
+	}
+	diff, err := r.Diff(d.State(), terraform.NewResourceConfigRaw(config), nil)
+	require.Nil(t, err)
+	require.True(t, diff == nil || diff.Empty(), "no change must be planned, got %v", diff)
+
+	config[brBranch] = "develop"
+	diff, err = r.Diff(d.State(), terraform.NewResourceConfigRaw(config), nil)
+	require.Nil(t, err)
+	require.True(t, diff.RequiresNew())
+}
+
+// verifies that a build client that can't send the runtime parameters fails with an error instead of a panic
+func TestBuildRun_QueueBuild_UnsupportedClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, err := azDOBuildQueueBuild(context.Background(), azdosdkmocks.NewMockBuildClient(ctrl), azDOBuildQueueBuildArgs{
+		Build:   &azDOBuildWithTemplateParameters{TemplateParameters: map[string]string{"environment": "dev"}},
+		Project: converter.String(testBuildRunProjectID),
+	})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Invalid Azure DevOps Build client implementation")
+}
+
+// verifies that the refresh function reports the status of the build
+func TestBuildRun_StatusRefreshFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, Ctx: context.Background()}
+
+	runningBuild := testBuildRun
+	runningBuild.Status = &build.BuildStatusValues.InProgress
+	runningBuild.Result = nil
+	gomock.InOrder(
+		buildClient.EXPECT().GetBuild(clients.Ctx, gomock.Any()).Return(&runningBuild, nil),
+		buildClient.EXPECT().GetBuild(clients.Ctx, gomock.Any()).Return(&testBuildRun, nil),
+	)
+
+	refresh := buildStatusRefreshFunc(clients, testBuildRunProjectID, 42)
+
+	_, status, err := refresh()
+	require.Nil(t, err)
+	require.Equal(t, "inProgress", status)
+
+	_, status, err = refresh()
+	require.Nil(t, err)
+	require.Equal(t, "completed", status)
+}
@@ -0,0 +1,385 @@
+package checks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const (
+	checkProjectID          = "project_id"
+	checkTargetResourceID   = "target_resource_id"
+	checkTargetResourceType = "target_resource_type"
+	checkTimeout            = "timeout"
+	checkVersion            = "version"
+	checkDisplayName        = "display_name"
+
+	// maximum timeout (in minutes) accepted by the service, 30 days
+	checkMaxTimeout = 43200
+
+	checkConfigurationsLocationID = "86c8381e-5aee-4cde-8ae4-25c0c7f5eaea"
+	checkConfigurationsAPIVersion = "6.0-preview.1"
+
+	invalidCheckIDErrorMessageFormat = "Check ID was unexpectedly not a valid integer: %+v"
+)
+
+// checkTargetResourceTypes are the kinds of protected resources a check can be attached to
+var checkTargetResourceTypes = []string{"endpoint", "environment", "queue", "repository", "variablegroup"}
+
+// checkType identifies the family of a check configuration
+type checkType struct {
+	ID   *uuid.UUID `json:"id,omitempty"`
+	Name *string    `json:"name,omitempty"`
+}
+
+var (
+	approvalCheckType = checkType{
+		ID:   converter.UUID("8c6f20a7-a545-4486-9777-f762fafe0d4d"),
+		Name: converter.String("Approval"),
+	}
+	taskCheckType = checkType{
+		ID:   converter.UUID("fe1de3ee-a436-41b4-bb20-f6eb4cb879a7"),
+		Name: converter.String("Task Check"),
+	}
+	extendsCheckType = checkType{
+		ID:   converter.UUID("4020e66e-b0f3-47e1-bc88-48f3cc59b5f3"),
+		Name: converter.String("ExtendsCheck"),
+	}
+)
+
+// checkResource references the protected resource a check is attached to
+type checkResource struct {
+	ID   *string `json:"id,omitempty"`
+	Type *string `json:"type,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// checkConfiguration is the payload of the pipelines checks configurations API
+type checkConfiguration struct {
+	ID       *int                   `json:"id,omitempty"`
+	Version  *int                   `json:"version,omitempty"`
+	Type     *checkType             `json:"type,omitempty"`
+	Resource *checkResource         `json:"resource,omitempty"`
+	Settings map[string]interface{} `json:"settings,omitempty"`
+	Timeout  *int                   `json:"timeout,omitempty"`
+}
+
+type flatFunc func(d *schema.ResourceData, check *checkConfiguration, clients *client.AggregatedClient) error
+type expandFunc func(d *schema.ResourceData, clients *client.AggregatedClient) (*checkConfiguration, error)
+
+// genBaseCheckResource creates a Resource with the common parts that all checks require.
+func genBaseCheckResource(f flatFunc, e expandFunc) *schema.Resource {
+	return &schema.Resource{
+		Create:   genCheckCreateFunc(f, e),
+		Read:     genCheckReadFunc(f),
+		Update:   genCheckUpdateFunc(f, e),
+		Delete:   resourceCheckDelete,
+		Importer: tfhelper.ImportProjectQualifiedResourceInteger(),
+		Schema: map[string]*schema.Schema{
+			checkProjectID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsUUID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+			checkTargetResourceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			checkTargetResourceType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(checkTargetResourceTypes, false),
+			},
+			checkTimeout: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      checkMaxTimeout,
+				ValidateFunc: validation.IntBetween(1, checkMaxTimeout),
+			},
+			checkVersion: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// displayNameSchema is the schema of the name that task based checks are shown with in the UI
+func displayNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "Managed by Terraform",
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+	}
+}
+
+// doBaseExpansion performs the expansion for the 'base' attributes that are defined in the schema, above
+func doBaseExpansion(d *schema.ResourceData, t checkType, settings map[string]interface{}) *checkConfiguration {
+	check := &checkConfiguration{
+		Type: &checkType{ID: t.ID, Name: t.Name},
+		Resource: &checkResource{
+			ID:   converter.String(d.Get(checkTargetResourceID).(string)),
+			Type: converter.String(d.Get(checkTargetResourceType).(string)),
+		},
+		Settings: settings,
+		Timeout:  converter.Int(d.Get(checkTimeout).(int)),
+	}
+	if id, err := strconv.Atoi(d.Id()); err == nil {
+		check.ID = &id
+	}
+	return check
+}
+
+// doBaseFlattening performs the flattening for the 'base' attributes that are defined in the schema, above
+func doBaseFlattening(d *schema.ResourceData, check *checkConfiguration) {
+	d.SetId(strconv.Itoa(*check.ID))
+	if check.Resource != nil {
+		d.Set(checkTargetResourceID, converter.ToString(check.Resource.ID, ""))
+		d.Set(checkTargetResourceType, converter.ToString(check.Resource.Type, ""))
+	}
+	if check.Timeout != nil {
+		d.Set(checkTimeout, *check.Timeout)
+	}
+	if check.Version != nil {
+		d.Set(checkVersion, *check.Version)
+	}
+}
+
+func genCheckCreateFunc(flatFunc flatFunc, expandFunc expandFunc) func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*client.AggregatedClient)
+		check, err := expandFunc(d, clients)
+		if err != nil {
+			return fmt.Errorf(" failed expanding check configuration: %+v", err)
+		}
+
+		createdCheck, err := sendCheckConfiguration(clients.Ctx, clients.TaskAgentClient, http.MethodPost, d.Get(checkProjectID).(string), check)
+		if err != nil {
+			return fmt.Errorf(" failed creating check: %+v", err)
+		}
+
+		d.SetId(strconv.Itoa(*createdCheck.ID))
+		return genCheckReadFunc(flatFunc)(d, m)
+	}
+}
+
+func genCheckReadFunc(flatFunc flatFunc) func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*client.AggregatedClient)
+
+		checkID, err := converter.ASCIIToIntPtr(d.Id())
+		if err != nil {
+			return fmt.Errorf(invalidCheckIDErrorMessageFormat, err)
+		}
+
+		check, err := getCheckConfiguration(clients.Ctx, clients.TaskAgentClient, d.Get(checkProjectID).(string), *checkID)
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf(" failed reading check: %+v", err)
+		}
+
+		return flatFunc(d, check, clients)
+	}
+}
+
+func genCheckUpdateFunc(flatFunc flatFunc, expandFunc expandFunc) func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*client.AggregatedClient)
+		check, err := expandFunc(d, clients)
+		if err != nil {
+			return fmt.Errorf(" failed expanding check configuration: %+v", err)
+		}
+
+		if _, err := sendCheckConfiguration(clients.Ctx, clients.TaskAgentClient, http.MethodPatch, d.Get(checkProjectID).(string), check); err != nil {
+			return fmt.Errorf(" failed updating check: %+v", err)
+		}
+
+		return genCheckReadFunc(flatFunc)(d, m)
+	}
+}
+
+func resourceCheckDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	checkID, err := converter.ASCIIToIntPtr(d.Id())
+	if err != nil {
+		return fmt.Errorf(invalidCheckIDErrorMessageFormat, err)
+	}
+
+	if err := deleteCheckConfiguration(clients.Ctx, clients.TaskAgentClient, d.Get(checkProjectID).(string), *checkID); err != nil {
+		return fmt.Errorf(" failed deleting check: %+v", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// The pipelines checks API is not part of the Azure DevOps Go SDK. The requests are sent
+// through the TaskAgent client because both areas are served from the organization URL.
+func checksClientImpl(taskAgentClient taskagent.Client) (*taskagent.ClientImpl, error) {
+	sdkClient, err := client.UnwrapClient(taskAgentClient)
+	if err != nil {
+		return nil, err
+	}
+	if clientImpl, ok := sdkClient.(*taskagent.ClientImpl); ok {
+		return clientImpl, nil
+	}
+	return nil, fmt.Errorf("Invalid Azure DevOps TaskAgent client implementation %T", taskAgentClient)
+}
+
+func sendCheckConfiguration(ctx context.Context, taskAgentClient taskagent.Client, method string, project string, check *checkConfiguration) (*checkConfiguration, error) {
+	if check == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "check"}
+	}
+	if project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "project"}
+	}
+	routeValues := map[string]string{"project": project}
+	if method != http.MethodPost {
+		if check.ID == nil {
+			return nil, &azuredevops.ArgumentNilError{ArgumentName: "check.ID"}
+		}
+		routeValues["id"] = strconv.Itoa(*check.ID)
+	}
+
+	body, marshalErr := json.Marshal(*check)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	clientImpl, err := checksClientImpl(taskAgentClient)
+	if err != nil {
+		return nil, err
+	}
+	locationID, _ := uuid.Parse(checkConfigurationsLocationID)
+	resp, err := clientImpl.Client.Send(ctx, method, locationID, checkConfigurationsAPIVersion, routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue checkConfiguration
+	err = clientImpl.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+func getCheckConfiguration(ctx context.Context, taskAgentClient taskagent.Client, project string, id int) (*checkConfiguration, error) {
+	if project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "project"}
+	}
+	routeValues := map[string]string{
+		"project": project,
+		"id":      strconv.Itoa(id),
+	}
+	queryParams := url.Values{}
+	queryParams.Add("$expand", "settings")
+
+	clientImpl, err := checksClientImpl(taskAgentClient)
+	if err != nil {
+		return nil, err
+	}
+	locationID, _ := uuid.Parse(checkConfigurationsLocationID)
+	resp, err := clientImpl.Client.Send(ctx, http.MethodGet, locationID, checkConfigurationsAPIVersion, routeValues, queryParams, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue checkConfiguration
+	err = clientImpl.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+func deleteCheckConfiguration(ctx context.Context, taskAgentClient taskagent.Client, project string, id int) error {
+	if project == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "project"}
+	}
+	routeValues := map[string]string{
+		"project": project,
+		"id":      strconv.Itoa(id),
+	}
+
+	clientImpl, err := checksClientImpl(taskAgentClient)
+	if err != nil {
+		return err
+	}
+	locationID, _ := uuid.Parse(checkConfigurationsLocationID)
+	_, err = clientImpl.Client.Send(ctx, http.MethodDelete, locationID, checkConfigurationsAPIVersion, routeValues, nil, nil, "", "application/json", nil)
+	return err
+}
+
+// The task based checks (business hours, branch control, invoke REST API) share the same
+// settings layout: a reference to the task definition that evaluates the check and its inputs.
+type taskCheckDefinition struct {
+	ID      string
+	Name    string
+	Version string
+}
+
+func expandTaskCheckSettings(definition taskCheckDefinition, displayName string, inputs map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"definitionRef": map[string]interface{}{
+			"id":      definition.ID,
+			"name":    definition.Name,
+			"version": definition.Version,
+		},
+		"displayName": displayName,
+		"inputs":      inputs,
+	}
+}
+
+// flattenTaskCheckSettings returns the display name and the inputs of a task based check
+func flattenTaskCheckSettings(settings map[string]interface{}) (string, map[string]interface{}) {
+	displayName, _ := settings["displayName"].(string)
+	inputs, ok := settings["inputs"].(map[string]interface{})
+	if !ok {
+		inputs = map[string]interface{}{}
+	}
+	return displayName, inputs
+}
+
+// inputString reads a task input that the service may return either as a string or as a JSON scalar
+func inputString(inputs map[string]interface{}, key string) string {
+	switch v := inputs[key].(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// inputBool reads a boolean task input, task inputs are stored as strings by the service
+func inputBool(inputs map[string]interface{}, key string) bool {
+	switch v := inputs[key].(type) {
+	case bool:
+		return v
+	case string:
+		b, _ := strconv.ParseBool(v)
+		return b
+	default:
+		return false
+	}
+}
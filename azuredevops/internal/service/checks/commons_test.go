@@ -0,0 +1,38 @@
+//go:build (all || resource_check_branch_control || resource_check_rest_api) && !exclude_checks
+// +build all resource_check_branch_control resource_check_rest_api
+// +build !exclude_checks
+
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+)
+
+// newFailingChecksClients returns clients whose checks configurations requests fail with "<method> Failed".
+// The checks API is not part of the SDK and can't be mocked, the requests are served by a test server instead.
+func newFailingChecksClients(t *testing.T) *client.AggregatedClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodOptions {
+			fmt.Fprintf(w, `{"count":1,"value":[{"id":"%s","area":"PipelinesChecks","resourceName":"configurations","routeTemplate":"{project}/_apis/pipelines/checks/{resource}/{id}","resourceVersion":1,"minVersion":"1.0","maxVersion":"6.0","releasedVersion":"0.0"}]}`, checkConfigurationsLocationID)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"message":"%s Failed"}`, r.Method)
+	}))
+	t.Cleanup(server.Close)
+
+	connection := azuredevops.NewAnonymousConnection(server.URL)
+	return &client.AggregatedClient{
+		TaskAgentClient: &taskagent.ClientImpl{Client: *azuredevops.NewClient(connection, server.URL)},
+		Ctx:             context.Background(),
+	}
+}
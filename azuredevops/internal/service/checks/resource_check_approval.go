@@ -0,0 +1,126 @@
+package checks
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const (
+	approvalApprovers           = "approvers"
+	approvalMinRequired         = "minimum_required_approvers"
+	approvalInstructions        = "instructions"
+	approvalExecutionOrder      = "execution_order"
+	approvalRequesterCanApprove = "requester_can_approve"
+)
+
+// ResourceCheckApproval schema and implementation for approval check resource
+func ResourceCheckApproval() *schema.Resource {
+	r := genBaseCheckResource(flattenCheckApproval, expandCheckApproval)
+
+	r.Schema[approvalApprovers] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+		Set: schema.HashString,
+	}
+	r.Schema[approvalMinRequired] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      0,
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+	r.Schema[approvalInstructions] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	r.Schema[approvalExecutionOrder] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "anyOrder",
+		ValidateFunc: validation.StringInSlice([]string{"anyOrder", "inSequence"}, false),
+	}
+	r.Schema[approvalRequesterCanApprove] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+
+	return r
+}
+
+func expandCheckApproval(d *schema.ResourceData, clients *client.AggregatedClient) (*checkConfiguration, error) {
+	descriptors := tfhelper.ExpandStringSet(d.Get(approvalApprovers).(*schema.Set))
+	approvers := make([]interface{}, 0, len(descriptors))
+	for _, descriptor := range descriptors {
+		storageKey, err := clients.GraphClient.GetStorageKey(clients.Ctx, graph.GetStorageKeyArgs{
+			SubjectDescriptor: converter.String(descriptor),
+		})
+		if err != nil {
+			return nil, fmt.Errorf(" resolving approver %s: %+v", descriptor, err)
+		}
+		approvers = append(approvers, map[string]interface{}{
+			"id": storageKey.Value.String(),
+		})
+	}
+
+	settings := map[string]interface{}{
+		"approvers":                 approvers,
+		"blockedApprovers":          []interface{}{},
+		"minRequiredApprovers":      d.Get(approvalMinRequired).(int),
+		"instructions":              d.Get(approvalInstructions).(string),
+		"executionOrder":            d.Get(approvalExecutionOrder).(string),
+		"requesterCannotBeApprover": !d.Get(approvalRequesterCanApprove).(bool),
+	}
+	return doBaseExpansion(d, approvalCheckType, settings), nil
+}
+
+func flattenCheckApproval(d *schema.ResourceData, check *checkConfiguration, clients *client.AggregatedClient) error {
+	doBaseFlattening(d, check)
+
+	descriptors := []string{}
+	if approvers, ok := check.Settings["approvers"].([]interface{}); ok {
+		for _, approver := range approvers {
+			approverMap, ok := approver.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			storageKey, err := uuid.Parse(fmt.Sprintf("%v", approverMap["id"]))
+			if err != nil {
+				return fmt.Errorf(" parsing approver ID: %+v", err)
+			}
+			descriptor, err := clients.GraphClient.GetDescriptor(clients.Ctx, graph.GetDescriptorArgs{
+				StorageKey: &storageKey,
+			})
+			if err != nil {
+				return fmt.Errorf(" resolving approver %s: %+v", storageKey.String(), err)
+			}
+			descriptors = append(descriptors, *descriptor.Value)
+		}
+	}
+	d.Set(approvalApprovers, descriptors)
+
+	if minRequired, ok := check.Settings["minRequiredApprovers"].(float64); ok {
+		d.Set(approvalMinRequired, int(minRequired))
+	}
+	if instructions, ok := check.Settings["instructions"].(string); ok {
+		d.Set(approvalInstructions, instructions)
+	}
+	if executionOrder, ok := check.Settings["executionOrder"].(string); ok {
+		d.Set(approvalExecutionOrder, executionOrder)
+	}
+	if requesterCannotBeApprover, ok := check.Settings["requesterCannotBeApprover"].(bool); ok {
+		d.Set(approvalRequesterCanApprove, !requesterCannotBeApprover)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+//go:build (all || resource_check_approval) && !exclude_checks
+// +build all resource_check_approval
+// +build !exclude_checks
+
+package checks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var approvalTestProjectID = uuid.New()
+var approvalTestApproverStorageKey = uuid.New()
+var approvalTestApproverDescriptor = "aad.MDAwMDAwMDAtMDAwMC0wMDAwLTAwMDAtMDAwMDAwMDAwMDAw"
+
+func getApprovalResourceData(t *testing.T) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckApproval().Schema, nil)
+	resourceData.Set(checkProjectID, approvalTestProjectID.String())
+	resourceData.Set(checkTargetResourceID, "12")
+	resourceData.Set(checkTargetResourceType, "environment")
+	resourceData.Set(checkTimeout, 1440)
+	resourceData.Set(approvalApprovers, []string{approvalTestApproverDescriptor})
+	resourceData.Set(approvalMinRequired, 1)
+	resourceData.Set(approvalInstructions, "Approve the deployment")
+	resourceData.Set(approvalExecutionOrder, "inSequence")
+	return resourceData
+}
+
+// verifies that approvers are resolved from graph descriptors to storage keys
+func TestCheckApproval_Expand_ResolvesApprovers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		GetStorageKey(clients.Ctx, graph.GetStorageKeyArgs{SubjectDescriptor: converter.String(approvalTestApproverDescriptor)}).
+		Return(&graph.GraphStorageKeyResult{Value: &approvalTestApproverStorageKey}, nil).
+		Times(1)
+
+	check, err := expandCheckApproval(getApprovalResourceData(t), clients)
+	require.Nil(t, err)
+
+	require.Equal(t, approvalCheckType.ID, check.Type.ID)
+	require.Equal(t, "12", *check.Resource.ID)
+	require.Equal(t, "environment", *check.Resource.Type)
+	require.Equal(t, 1440, *check.Timeout)
+	require.Equal(t, []interface{}{map[string]interface{}{"id": approvalTestApproverStorageKey.String()}}, check.Settings["approvers"])
+	require.Equal(t, 1, check.Settings["minRequiredApprovers"])
+	require.Equal(t, "inSequence", check.Settings["executionOrder"])
+	require.Equal(t, true, check.Settings["requesterCannotBeApprover"])
+}
+
+// verifies that a failed approver lookup is surfaced to the caller
+func TestCheckApproval_Expand_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		GetStorageKey(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetStorageKey() Failed")).
+		Times(1)
+
+	_, err := expandCheckApproval(getApprovalResourceData(t), clients)
+	require.Contains(t, err.Error(), "GetStorageKey() Failed")
+}
+
+// verifies that approvers are resolved back to graph descriptors when the check is read
+func TestCheckApproval_Flatten_ResolvesApprovers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		GetStorageKey(clients.Ctx, gomock.Any()).
+		Return(&graph.GraphStorageKeyResult{Value: &approvalTestApproverStorageKey}, nil).
+		Times(1)
+	graphClient.
+		EXPECT().
+		GetDescriptor(clients.Ctx, graph.GetDescriptorArgs{StorageKey: &approvalTestApproverStorageKey}).
+		Return(&graph.GraphDescriptorResult{Value: converter.String(approvalTestApproverDescriptor)}, nil).
+		Times(1)
+
+	check, err := expandCheckApproval(getApprovalResourceData(t), clients)
+	require.Nil(t, err)
+	check.ID = converter.Int(5)
+	check.Version = converter.Int(2)
+
+	// the service returns the settings as untyped JSON
+	body, err := json.Marshal(check)
+	require.Nil(t, err)
+	var readCheck checkConfiguration
+	require.Nil(t, json.Unmarshal(body, &readCheck))
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckApproval().Schema, nil)
+	resourceData.Set(checkProjectID, approvalTestProjectID.String())
+	require.Nil(t, flattenCheckApproval(resourceData, &readCheck, clients))
+
+	require.Equal(t, "5", resourceData.Id())
+	require.Equal(t, 2, resourceData.Get(checkVersion))
+	require.Equal(t, "12", resourceData.Get(checkTargetResourceID))
+	require.Equal(t, 1440, resourceData.Get(checkTimeout))
+	require.Equal(t, []interface{}{approvalTestApproverDescriptor}, resourceData.Get(approvalApprovers).(*schema.Set).List())
+	require.Equal(t, 1, resourceData.Get(approvalMinRequired))
+	require.Equal(t, "Approve the deployment", resourceData.Get(approvalInstructions))
+	require.Equal(t, "inSequence", resourceData.Get(approvalExecutionOrder))
+	require.Equal(t, false, resourceData.Get(approvalRequesterCanApprove))
+}
@@ -0,0 +1,67 @@
+package checks
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+)
+
+const (
+	branchControlAllowedBranches        = "allowed_branches"
+	branchControlVerifyBranchProtection = "verify_branch_protection"
+	branchControlIgnoreUnknownStatus    = "ignore_unknown_protection_status"
+)
+
+var branchControlDefinition = taskCheckDefinition{
+	ID:      "86b05a0c-73e6-4f7d-b3cf-e38f3b39a75b",
+	Name:    "evaluatebranchProtection",
+	Version: "0.0.1",
+}
+
+// ResourceCheckBranchControl schema and implementation for branch control check resource
+func ResourceCheckBranchControl() *schema.Resource {
+	r := genBaseCheckResource(flattenCheckBranchControl, expandCheckBranchControl)
+
+	r.Schema[checkDisplayName] = displayNameSchema()
+	r.Schema[branchControlAllowedBranches] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "*",
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+	}
+	r.Schema[branchControlVerifyBranchProtection] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	r.Schema[branchControlIgnoreUnknownStatus] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+
+	return r
+}
+
+func expandCheckBranchControl(d *schema.ResourceData, _ *client.AggregatedClient) (*checkConfiguration, error) {
+	inputs := map[string]interface{}{
+		"allowedBranches":          d.Get(branchControlAllowedBranches).(string),
+		"ensureProtectionOfBranch": strconv.FormatBool(d.Get(branchControlVerifyBranchProtection).(bool)),
+		"allowUnknownStatusBranch": strconv.FormatBool(d.Get(branchControlIgnoreUnknownStatus).(bool)),
+	}
+	settings := expandTaskCheckSettings(branchControlDefinition, d.Get(checkDisplayName).(string), inputs)
+	return doBaseExpansion(d, taskCheckType, settings), nil
+}
+
+func flattenCheckBranchControl(d *schema.ResourceData, check *checkConfiguration, _ *client.AggregatedClient) error {
+	doBaseFlattening(d, check)
+
+	displayName, inputs := flattenTaskCheckSettings(check.Settings)
+	d.Set(checkDisplayName, displayName)
+	d.Set(branchControlAllowedBranches, inputString(inputs, "allowedBranches"))
+	d.Set(branchControlVerifyBranchProtection, inputBool(inputs, "ensureProtectionOfBranch"))
+	d.Set(branchControlIgnoreUnknownStatus, inputBool(inputs, "allowUnknownStatusBranch"))
+	return nil
+}
@@ -0,0 +1,105 @@
+//go:build (all || resource_check_branch_control) && !exclude_checks
+// +build all resource_check_branch_control
+// +build !exclude_checks
+
+package checks
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var branchControlTestProjectID = uuid.New()
+
+func getBranchControlResourceData(t *testing.T) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckBranchControl().Schema, nil)
+	resourceData.SetId("7")
+	resourceData.Set(checkProjectID, branchControlTestProjectID.String())
+	resourceData.Set(checkTargetResourceID, "4")
+	resourceData.Set(checkTargetResourceType, "endpoint")
+	resourceData.Set(checkDisplayName, "Protected branches only")
+	resourceData.Set(branchControlAllowedBranches, "refs/heads/main,refs/heads/release/*")
+	resourceData.Set(branchControlVerifyBranchProtection, true)
+	resourceData.Set(branchControlIgnoreUnknownStatus, false)
+	return resourceData
+}
+
+// verifies that the branch control settings survive the flatten/expand round trip
+func TestCheckBranchControl_ExpandFlatten_Roundtrip(t *testing.T) {
+	check, err := expandCheckBranchControl(getBranchControlResourceData(t), nil)
+	require.Nil(t, err)
+
+	require.Equal(t, 7, *check.ID)
+	require.Equal(t, taskCheckType.ID, check.Type.ID)
+	require.Equal(t, branchControlDefinition.ID, check.Settings["definitionRef"].(map[string]interface{})["id"])
+	inputs := check.Settings["inputs"].(map[string]interface{})
+	require.Equal(t, "refs/heads/main,refs/heads/release/*", inputs["allowedBranches"])
+	require.Equal(t, "true", inputs["ensureProtectionOfBranch"])
+	require.Equal(t, "false", inputs["allowUnknownStatusBranch"])
+
+	// the service returns the settings as untyped JSON
+	check.Version = converter.Int(3)
+	body, err := json.Marshal(check)
+	require.Nil(t, err)
+	var readCheck checkConfiguration
+	require.Nil(t, json.Unmarshal(body, &readCheck))
+
+	flattenedData := schema.TestResourceDataRaw(t, ResourceCheckBranchControl().Schema, nil)
+	require.Nil(t, flattenCheckBranchControl(flattenedData, &readCheck, nil))
+
+	require.Equal(t, "7", flattenedData.Id())
+	require.Equal(t, 3, flattenedData.Get(checkVersion))
+	require.Equal(t, "4", flattenedData.Get(checkTargetResourceID))
+	require.Equal(t, "endpoint", flattenedData.Get(checkTargetResourceType))
+	require.Equal(t, "Protected branches only", flattenedData.Get(checkDisplayName))
+	require.Equal(t, "refs/heads/main,refs/heads/release/*", flattenedData.Get(branchControlAllowedBranches))
+	require.Equal(t, true, flattenedData.Get(branchControlVerifyBranchProtection))
+	require.Equal(t, false, flattenedData.Get(branchControlIgnoreUnknownStatus))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestCheckBranchControl_Create_DoesNotSwallowError(t *testing.T) {
+	err := ResourceCheckBranchControl().Create(getBranchControlResourceData(t), newFailingChecksClients(t))
+	require.Contains(t, err.Error(), "POST Failed")
+}
+
+// verifies that if an error is produced on a read, the error is not swallowed
+func TestCheckBranchControl_Read_DoesNotSwallowError(t *testing.T) {
+	err := ResourceCheckBranchControl().Read(getBranchControlResourceData(t), newFailingChecksClients(t))
+	require.Contains(t, err.Error(), "GET Failed")
+}
+
+// verifies that if an error is produced on an update, the error is not swallowed
+func TestCheckBranchControl_Update_DoesNotSwallowError(t *testing.T) {
+	err := ResourceCheckBranchControl().Update(getBranchControlResourceData(t), newFailingChecksClients(t))
+	require.Contains(t, err.Error(), "PATCH Failed")
+}
+
+// verifies that if an error is produced on a delete, the error is not swallowed
+func TestCheckBranchControl_Delete_DoesNotSwallowError(t *testing.T) {
+	err := ResourceCheckBranchControl().Delete(getBranchControlResourceData(t), newFailingChecksClients(t))
+	require.Contains(t, err.Error(), "DELETE Failed")
+}
+
+// verifies that a client that can't send the checks requests fails with an error instead of a panic
+func TestCheckBranchControl_Read_UnsupportedClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clients := &client.AggregatedClient{
+		TaskAgentClient: azdosdkmocks.NewMockTaskagentClient(ctrl),
+		Ctx:             context.Background(),
+	}
+	err := ResourceCheckBranchControl().Read(getBranchControlResourceData(t), clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Invalid Azure DevOps TaskAgent client implementation")
+}
@@ -0,0 +1,100 @@
+package checks
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+)
+
+const (
+	businessHoursDays      = "business_days"
+	businessHoursTimeZone  = "time_zone"
+	businessHoursStartTime = "start_time"
+	businessHoursEndTime   = "end_time"
+)
+
+var businessHoursDefinition = taskCheckDefinition{
+	ID:      "445fde2f-6c39-441c-807f-8a59ff2e075f",
+	Name:    "evaluatebusinesshours",
+	Version: "0.0.1",
+}
+
+var timeOfDayRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// weekDays lists the days in the order the service expects them
+var weekDays = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+
+// ResourceCheckBusinessHours schema and implementation for business hours check resource
+func ResourceCheckBusinessHours() *schema.Resource {
+	r := genBaseCheckResource(flattenCheckBusinessHours, expandCheckBusinessHours)
+
+	r.Schema[checkDisplayName] = displayNameSchema()
+	r.Schema[businessHoursDays] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(weekDays, false),
+		},
+		Set: schema.HashString,
+	}
+	r.Schema[businessHoursTimeZone] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+	}
+	r.Schema[businessHoursStartTime] = timeOfDaySchema()
+	r.Schema[businessHoursEndTime] = timeOfDaySchema()
+
+	return r
+}
+
+func expandCheckBusinessHours(d *schema.ResourceData, _ *client.AggregatedClient) (*checkConfiguration, error) {
+	days := d.Get(businessHoursDays).(*schema.Set)
+	businessDays := []string{}
+	for _, day := range weekDays {
+		if days.Contains(day) {
+			businessDays = append(businessDays, day)
+		}
+	}
+
+	inputs := map[string]interface{}{
+		"businessDays": strings.Join(businessDays, ","),
+		"timeZone":     d.Get(businessHoursTimeZone).(string),
+		"startTime":    d.Get(businessHoursStartTime).(string),
+		"endTime":      d.Get(businessHoursEndTime).(string),
+	}
+	settings := expandTaskCheckSettings(businessHoursDefinition, d.Get(checkDisplayName).(string), inputs)
+	return doBaseExpansion(d, taskCheckType, settings), nil
+}
+
+func flattenCheckBusinessHours(d *schema.ResourceData, check *checkConfiguration, _ *client.AggregatedClient) error {
+	doBaseFlattening(d, check)
+
+	displayName, inputs := flattenTaskCheckSettings(check.Settings)
+	d.Set(checkDisplayName, displayName)
+
+	businessDays := []string{}
+	for _, day := range strings.Split(inputString(inputs, "businessDays"), ",") {
+		if day = strings.TrimSpace(day); day != "" {
+			businessDays = append(businessDays, day)
+		}
+	}
+	d.Set(businessHoursDays, businessDays)
+	d.Set(businessHoursTimeZone, inputString(inputs, "timeZone"))
+	d.Set(businessHoursStartTime, inputString(inputs, "startTime"))
+	d.Set(businessHoursEndTime, inputString(inputs, "endTime"))
+	return nil
+}
+
+func timeOfDaySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringMatch(timeOfDayRegexp, "must be a time of day in the HH:MM format"),
+	}
+}
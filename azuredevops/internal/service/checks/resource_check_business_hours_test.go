@@ -0,0 +1,65 @@
+//go:build (all || resource_check_business_hours) && !exclude_checks
+// +build all resource_check_business_hours
+// +build !exclude_checks
+
+package checks
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var businessHoursTestProjectID = uuid.New()
+
+// verifies that business days are sent in week order and survive the flatten/expand round trip
+func TestCheckBusinessHours_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckBusinessHours().Schema, nil)
+	resourceData.Set(checkProjectID, businessHoursTestProjectID.String())
+	resourceData.Set(checkTargetResourceID, "3")
+	resourceData.Set(checkTargetResourceType, "queue")
+	resourceData.Set(businessHoursDays, []string{"Friday", "Monday", "Wednesday"})
+	resourceData.Set(businessHoursTimeZone, "UTC")
+	resourceData.Set(businessHoursStartTime, "08:00")
+	resourceData.Set(businessHoursEndTime, "17:30")
+
+	check, err := expandCheckBusinessHours(resourceData, nil)
+	require.Nil(t, err)
+
+	inputs := check.Settings["inputs"].(map[string]interface{})
+	require.Equal(t, "Monday,Wednesday,Friday", inputs["businessDays"])
+	require.Equal(t, businessHoursDefinition.ID, check.Settings["definitionRef"].(map[string]interface{})["id"])
+	require.Equal(t, taskCheckType.ID, check.Type.ID)
+
+	check.ID = converter.Int(9)
+	body, err := json.Marshal(check)
+	require.Nil(t, err)
+	var readCheck checkConfiguration
+	require.Nil(t, json.Unmarshal(body, &readCheck))
+
+	flattenedData := schema.TestResourceDataRaw(t, ResourceCheckBusinessHours().Schema, nil)
+	require.Nil(t, flattenCheckBusinessHours(flattenedData, &readCheck, nil))
+
+	require.Equal(t, "9", flattenedData.Id())
+	require.Equal(t, "Managed by Terraform", flattenedData.Get(checkDisplayName))
+	require.Equal(t, 3, flattenedData.Get(businessHoursDays).(*schema.Set).Len())
+	require.Equal(t, "UTC", flattenedData.Get(businessHoursTimeZone))
+	require.Equal(t, "08:00", flattenedData.Get(businessHoursStartTime))
+	require.Equal(t, "17:30", flattenedData.Get(businessHoursEndTime))
+}
+
+func TestCheckBusinessHours_TimeOfDay_Validation(t *testing.T) {
+	validate := ResourceCheckBusinessHours().Schema[businessHoursStartTime].ValidateFunc
+	for _, value := range []string{"00:00", "09:30", "23:59"} {
+		_, errs := validate(value, businessHoursStartTime)
+		require.Empty(t, errs, value)
+	}
+	for _, value := range []string{"9:30", "24:00", "12:60", "noon"} {
+		_, errs := validate(value, businessHoursStartTime)
+		require.NotEmpty(t, errs, value)
+	}
+}
@@ -0,0 +1,108 @@
+package checks
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+)
+
+const (
+	requiredTemplates              = "required_template"
+	requiredTemplateRepositoryType = "repository_type"
+	requiredTemplateRepositoryName = "repository_name"
+	requiredTemplateRepositoryRef  = "repository_ref"
+	requiredTemplateTemplatePath   = "template_path"
+)
+
+// ResourceCheckRequiredTemplate schema and implementation for required template check resource
+func ResourceCheckRequiredTemplate() *schema.Resource {
+	r := genBaseCheckResource(flattenCheckRequiredTemplate, expandCheckRequiredTemplate)
+
+	r.Schema[requiredTemplates] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				requiredTemplateRepositoryType: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "azuregit",
+					ValidateFunc: validation.StringInSlice([]string{"azuregit", "github", "bitbucket"}, false),
+				},
+				requiredTemplateRepositoryName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				requiredTemplateRepositoryRef: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				requiredTemplateTemplatePath: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+		},
+	}
+
+	return r
+}
+
+// The service names Azure Repos Git repositories "git", the schema uses "azuregit" to be consistent
+// with the repository types of azuredevops_build_definition
+var requiredTemplateRepositoryTypes = map[string]string{
+	"azuregit":  "git",
+	"github":    "github",
+	"bitbucket": "bitbucket",
+}
+
+func expandCheckRequiredTemplate(d *schema.ResourceData, _ *client.AggregatedClient) (*checkConfiguration, error) {
+	templates := d.Get(requiredTemplates).([]interface{})
+	extendsChecks := make([]interface{}, 0, len(templates))
+	for _, template := range templates {
+		templateMap := template.(map[string]interface{})
+		extendsChecks = append(extendsChecks, map[string]interface{}{
+			"repositoryType": requiredTemplateRepositoryTypes[templateMap[requiredTemplateRepositoryType].(string)],
+			"repositoryName": templateMap[requiredTemplateRepositoryName].(string),
+			"repositoryRef":  templateMap[requiredTemplateRepositoryRef].(string),
+			"templatePath":   templateMap[requiredTemplateTemplatePath].(string),
+		})
+	}
+
+	settings := map[string]interface{}{
+		"extendsChecks": extendsChecks,
+	}
+	return doBaseExpansion(d, extendsCheckType, settings), nil
+}
+
+func flattenCheckRequiredTemplate(d *schema.ResourceData, check *checkConfiguration, _ *client.AggregatedClient) error {
+	doBaseFlattening(d, check)
+
+	templates := []interface{}{}
+	if extendsChecks, ok := check.Settings["extendsChecks"].([]interface{}); ok {
+		for _, extendsCheck := range extendsChecks {
+			extendsCheckMap, ok := extendsCheck.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			repositoryType := inputString(extendsCheckMap, "repositoryType")
+			for schemaType, serviceType := range requiredTemplateRepositoryTypes {
+				if serviceType == repositoryType {
+					repositoryType = schemaType
+				}
+			}
+			templates = append(templates, map[string]interface{}{
+				requiredTemplateRepositoryType: repositoryType,
+				requiredTemplateRepositoryName: inputString(extendsCheckMap, "repositoryName"),
+				requiredTemplateRepositoryRef:  inputString(extendsCheckMap, "repositoryRef"),
+				requiredTemplateTemplatePath:   inputString(extendsCheckMap, "templatePath"),
+			})
+		}
+	}
+	d.Set(requiredTemplates, templates)
+	return nil
+}
@@ -0,0 +1,52 @@
+//go:build (all || resource_check_required_template) && !exclude_checks
+// +build all resource_check_required_template
+// +build !exclude_checks
+
+package checks
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var requiredTemplateTestProjectID = uuid.New()
+
+// verifies that repository types are translated to the service names and back
+func TestCheckRequiredTemplate_ExpandFlatten_Roundtrip(t *testing.T) {
+	templates := []interface{}{
+		map[string]interface{}{
+			requiredTemplateRepositoryType: "azuregit",
+			requiredTemplateRepositoryName: "project/templates",
+			requiredTemplateRepositoryRef:  "refs/heads/main",
+			requiredTemplateTemplatePath:   "pipeline.yml",
+		},
+	}
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckRequiredTemplate().Schema, nil)
+	resourceData.Set(checkProjectID, requiredTemplateTestProjectID.String())
+	resourceData.Set(checkTargetResourceID, "4")
+	resourceData.Set(checkTargetResourceType, "environment")
+	resourceData.Set(requiredTemplates, templates)
+
+	check, err := expandCheckRequiredTemplate(resourceData, nil)
+	require.Nil(t, err)
+
+	extendsChecks := check.Settings["extendsChecks"].([]interface{})
+	require.Equal(t, "git", extendsChecks[0].(map[string]interface{})["repositoryType"])
+	require.Equal(t, extendsCheckType.ID, check.Type.ID)
+
+	check.ID = converter.Int(4)
+	body, err := json.Marshal(check)
+	require.Nil(t, err)
+	var readCheck checkConfiguration
+	require.Nil(t, json.Unmarshal(body, &readCheck))
+
+	flattenedData := schema.TestResourceDataRaw(t, ResourceCheckRequiredTemplate().Schema, nil)
+	require.Nil(t, flattenCheckRequiredTemplate(flattenedData, &readCheck, nil))
+
+	require.Equal(t, templates, flattenedData.Get(requiredTemplates))
+}
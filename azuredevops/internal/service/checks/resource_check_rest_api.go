@@ -0,0 +1,110 @@
+package checks
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+)
+
+const (
+	restAPIServiceConnectionID = "service_connection_id"
+	restAPIMethod              = "method"
+	restAPIHeaders             = "headers"
+	restAPIBody                = "body"
+	restAPIURLSuffix           = "url_suffix"
+	restAPIWaitForCompletion   = "wait_for_completion"
+	restAPISuccessCriteria     = "success_criteria"
+	restAPIRetryInterval       = "retry_interval"
+)
+
+var restAPIDefinition = taskCheckDefinition{
+	ID:      "9c3e8943-130d-4c78-ac63-8af81df62dfb",
+	Name:    "InvokeRESTAPI",
+	Version: "1.220.0",
+}
+
+// ResourceCheckRestAPI schema and implementation for invoke REST API check resource
+func ResourceCheckRestAPI() *schema.Resource {
+	r := genBaseCheckResource(flattenCheckRestAPI, expandCheckRestAPI)
+
+	r.Schema[checkDisplayName] = displayNameSchema()
+	r.Schema[restAPIServiceConnectionID] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateFunc:     validation.IsUUID,
+		DiffSuppressFunc: suppress.CaseDifference,
+	}
+	r.Schema[restAPIMethod] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "POST",
+		ValidateFunc: validation.StringInSlice([]string{"OPTIONS", "GET", "HEAD", "POST", "PUT", "DELETE", "TRACE", "PATCH"}, false),
+	}
+	r.Schema[restAPIHeaders] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "{\"Content-Type\":\"application/json\"}",
+	}
+	r.Schema[restAPIBody] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	r.Schema[restAPIURLSuffix] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	r.Schema[restAPIWaitForCompletion] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	r.Schema[restAPISuccessCriteria] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	r.Schema[restAPIRetryInterval] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      5,
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+
+	return r
+}
+
+func expandCheckRestAPI(d *schema.ResourceData, _ *client.AggregatedClient) (*checkConfiguration, error) {
+	inputs := map[string]interface{}{
+		"connectedServiceNameSelector": "connectedServiceName",
+		"connectedServiceName":         d.Get(restAPIServiceConnectionID).(string),
+		"method":                       d.Get(restAPIMethod).(string),
+		"headers":                      d.Get(restAPIHeaders).(string),
+		"body":                         d.Get(restAPIBody).(string),
+		"urlSuffix":                    d.Get(restAPIURLSuffix).(string),
+		"waitForCompletion":            strconv.FormatBool(d.Get(restAPIWaitForCompletion).(bool)),
+		"successCriteria":              d.Get(restAPISuccessCriteria).(string),
+	}
+	settings := expandTaskCheckSettings(restAPIDefinition, d.Get(checkDisplayName).(string), inputs)
+	settings["retryInterval"] = d.Get(restAPIRetryInterval).(int)
+	return doBaseExpansion(d, taskCheckType, settings), nil
+}
+
+func flattenCheckRestAPI(d *schema.ResourceData, check *checkConfiguration, _ *client.AggregatedClient) error {
+	doBaseFlattening(d, check)
+
+	displayName, inputs := flattenTaskCheckSettings(check.Settings)
+	d.Set(checkDisplayName, displayName)
+	d.Set(restAPIServiceConnectionID, inputString(inputs, "connectedServiceName"))
+	d.Set(restAPIMethod, inputString(inputs, "method"))
+	d.Set(restAPIHeaders, inputString(inputs, "headers"))
+	d.Set(restAPIBody, inputString(inputs, "body"))
+	d.Set(restAPIURLSuffix, inputString(inputs, "urlSuffix"))
+	d.Set(restAPIWaitForCompletion, inputBool(inputs, "waitForCompletion"))
+	d.Set(restAPISuccessCriteria, inputString(inputs, "successCriteria"))
+	if retryInterval, ok := check.Settings["retryInterval"].(float64); ok {
+		d.Set(restAPIRetryInterval, int(retryInterval))
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+//go:build (all || resource_check_rest_api) && !exclude_checks
+// +build all resource_check_rest_api
+// +build !exclude_checks
+
+package checks
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var restAPITestProjectID = uuid.New()
+var restAPITestServiceConnectionID = uuid.New()
+
+func getRestAPIResourceData(t *testing.T) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckRestAPI().Schema, nil)
+	resourceData.SetId("8")
+	resourceData.Set(checkProjectID, restAPITestProjectID.String())
+	resourceData.Set(checkTargetResourceID, "2")
+	resourceData.Set(checkTargetResourceType, "environment")
+	resourceData.Set(checkTimeout, 60)
+	resourceData.Set(restAPIServiceConnectionID, restAPITestServiceConnectionID.String())
+	resourceData.Set(restAPIMethod, "GET")
+	resourceData.Set(restAPIURLSuffix, "api/status")
+	resourceData.Set(restAPIWaitForCompletion, true)
+	resourceData.Set(restAPISuccessCriteria, "eq(root['status'], 'ok')")
+	resourceData.Set(restAPIRetryInterval, 10)
+	return resourceData
+}
+
+// verifies that the REST API settings survive the flatten/expand round trip
+func TestCheckRestAPI_ExpandFlatten_Roundtrip(t *testing.T) {
+	check, err := expandCheckRestAPI(getRestAPIResourceData(t), nil)
+	require.Nil(t, err)
+
+	require.Equal(t, 8, *check.ID)
+	require.Equal(t, 60, *check.Timeout)
+	require.Equal(t, taskCheckType.ID, check.Type.ID)
+	require.Equal(t, restAPIDefinition.ID, check.Settings["definitionRef"].(map[string]interface{})["id"])
+	require.Equal(t, 10, check.Settings["retryInterval"])
+	inputs := check.Settings["inputs"].(map[string]interface{})
+	require.Equal(t, "connectedServiceName", inputs["connectedServiceNameSelector"])
+	require.Equal(t, restAPITestServiceConnectionID.String(), inputs["connectedServiceName"])
+	require.Equal(t, "GET", inputs["method"])
+	require.Equal(t, "{\"Content-Type\":\"application/json\"}", inputs["headers"])
+	require.Equal(t, "true", inputs["waitForCompletion"])
+
+	// the service returns the settings as untyped JSON
+	check.Version = converter.Int(1)
+	body, err := json.Marshal(check)
+	require.Nil(t, err)
+	var readCheck checkConfiguration
+	require.Nil(t, json.Unmarshal(body, &readCheck))
+
+	flattenedData := schema.TestResourceDataRaw(t, ResourceCheckRestAPI().Schema, nil)
+	require.Nil(t, flattenCheckRestAPI(flattenedData, &readCheck, nil))
+
+	require.Equal(t, "8", flattenedData.Id())
+	require.Equal(t, 1, flattenedData.Get(checkVersion))
+	require.Equal(t, 60, flattenedData.Get(checkTimeout))
+	require.Equal(t, "Managed by Terraform", flattenedData.Get(checkDisplayName))
+	require.Equal(t, restAPITestServiceConnectionID.String(), flattenedData.Get(restAPIServiceConnectionID))
+	require.Equal(t, "GET", flattenedData.Get(restAPIMethod))
+	require.Equal(t, "{\"Content-Type\":\"application/json\"}", flattenedData.Get(restAPIHeaders))
+	require.Equal(t, "", flattenedData.Get(restAPIBody))
+	require.Equal(t, "api/status", flattenedData.Get(restAPIURLSuffix))
+	require.Equal(t, true, flattenedData.Get(restAPIWaitForCompletion))
+	require.Equal(t, "eq(root['status'], 'ok')", flattenedData.Get(restAPISuccessCriteria))
+	require.Equal(t, 10, flattenedData.Get(restAPIRetryInterval))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestCheckRestAPI_Create_DoesNotSwallowError(t *testing.T) {
+	err := ResourceCheckRestAPI().Create(getRestAPIResourceData(t), newFailingChecksClients(t))
+	require.Contains(t, err.Error(), "POST Failed")
+}
+
+// verifies that if an error is produced on a read, the error is not swallowed
+func TestCheckRestAPI_Read_DoesNotSwallowError(t *testing.T) {
+	err := ResourceCheckRestAPI().Read(getRestAPIResourceData(t), newFailingChecksClients(t))
+	require.Contains(t, err.Error(), "GET Failed")
+}
+
+// verifies that if an error is produced on an update, the error is not swallowed
+func TestCheckRestAPI_Update_DoesNotSwallowError(t *testing.T) {
+	err := ResourceCheckRestAPI().Update(getRestAPIResourceData(t), newFailingChecksClients(t))
+	require.Contains(t, err.Error(), "PATCH Failed")
+}
+
+// verifies that if an error is produced on a delete, the error is not swallowed
+func TestCheckRestAPI_Delete_DoesNotSwallowError(t *testing.T) {
+	err := ResourceCheckRestAPI().Delete(getRestAPIResourceData(t), newFailingChecksClients(t))
+	require.Contains(t, err.Error(), "DELETE Failed")
+}
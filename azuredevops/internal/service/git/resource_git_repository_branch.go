@@ -0,0 +1,316 @@
+package git
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// the object ID used by git to create and delete refs
+const emptyObjectID = "0000000000000000000000000000000000000000"
+
+var commitIDRegexp = regexp.MustCompile("^[0-9a-fA-F]{40}$")
+
+// ResourceGitRepositoryBranch schema and implementation for git repository branch resource
+func ResourceGitRepositoryBranch() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitRepositoryBranchCreate,
+		Read:   resourceGitRepositoryBranchRead,
+		Update: resourceGitRepositoryBranchUpdate,
+		Delete: resourceGitRepositoryBranchDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGitRepositoryBranchImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The repository ID",
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The name of the branch, without the refs/heads/ prefix",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"ref_branch": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The branch the new branch is created from",
+				DiffSuppressFunc: suppressBranchSourceOfExistingBranch,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				ExactlyOneOf:     []string{"ref_branch", "ref_tag", "ref_commit_id"},
+			},
+			"ref_tag": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The tag the new branch is created from",
+				DiffSuppressFunc: suppressBranchSourceOfExistingBranch,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				ExactlyOneOf:     []string{"ref_branch", "ref_tag", "ref_commit_id"},
+			},
+			"ref_commit_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The commit the new branch is created from",
+				DiffSuppressFunc: suppressBranchSourceOfExistingBranch,
+				ValidateFunc:     validation.StringMatch(commitIDRegexp, "must be a 40 character commit SHA"),
+				ExactlyOneOf:     []string{"ref_branch", "ref_tag", "ref_commit_id"},
+			},
+			"is_locked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Lock the branch, defaults to \"false\"",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The full name of the branch ref",
+			},
+			"last_commit_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The commit the branch points to",
+			},
+		},
+	}
+}
+
+func resourceGitRepositoryBranchCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	repoID := d.Get("repository_id").(string)
+	ref := withBranchPrefix(d.Get("name").(string))
+
+	objectID, err := resolveBranchSource(clients, d)
+	if err != nil {
+		return err
+	}
+
+	_, err = updateGitRef(clients, repoID, git.GitRefUpdate{
+		Name:        converter.String(ref),
+		OldObjectId: converter.String(emptyObjectID),
+		NewObjectId: converter.String(objectID),
+	})
+	if err != nil {
+		return fmt.Errorf("Create branch failed, repositoryID: %s, branch: %s. Error:  %+v", repoID, ref, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", repoID, ref))
+
+	if d.Get("is_locked").(bool) {
+		if err := lockGitBranch(clients, repoID, ref, true); err != nil {
+			return err
+		}
+	}
+	return resourceGitRepositoryBranchRead(d, m)
+}
+
+func resourceGitRepositoryBranchRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	repoID, ref := splitRepoBranchID(d.Id())
+	gitRef, err := getGitRef(clients, repoID, ref)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Query branch failed, repositoryID: %s, branch: %s. Error:  %+v", repoID, ref, err)
+	}
+	if gitRef == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("repository_id", repoID)
+	d.Set("name", shortBranchName(ref))
+	d.Set("ref", ref)
+	d.Set("last_commit_id", converter.ToString(gitRef.ObjectId, ""))
+	d.Set("is_locked", converter.ToBool(gitRef.IsLocked, false))
+	return nil
+}
+
+func resourceGitRepositoryBranchUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	if d.HasChange("is_locked") {
+		repoID, ref := splitRepoBranchID(d.Id())
+		if err := lockGitBranch(clients, repoID, ref, d.Get("is_locked").(bool)); err != nil {
+			return err
+		}
+	}
+	return resourceGitRepositoryBranchRead(d, m)
+}
+
+func resourceGitRepositoryBranchDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	repoID, ref := splitRepoBranchID(d.Id())
+	gitRef, err := getGitRef(clients, repoID, ref)
+	if err != nil {
+		return fmt.Errorf("Query branch failed, repositoryID: %s, branch: %s. Error:  %+v", repoID, ref, err)
+	}
+	if gitRef == nil {
+		return nil
+	}
+
+	// a locked branch can only be deleted by the user who locked it, unlock it first
+	if converter.ToBool(gitRef.IsLocked, false) {
+		if err := lockGitBranch(clients, repoID, ref, false); err != nil {
+			return err
+		}
+	}
+
+	_, err = updateGitRef(clients, repoID, git.GitRefUpdate{
+		Name:        converter.String(ref),
+		OldObjectId: gitRef.ObjectId,
+		NewObjectId: converter.String(emptyObjectID),
+	})
+	if err != nil {
+		return fmt.Errorf("Delete branch failed, repositoryID: %s, branch: %s. Error:  %+v", repoID, ref, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceGitRepositoryBranchImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	repoID, ref := splitRepoBranchID(d.Id())
+	if repoID == "" || ref == "" {
+		return nil, fmt.Errorf("Invalid ID specified. Supplied ID must be written as <repository>:<branch>")
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", repoID, withBranchPrefix(ref)))
+	return []*schema.ResourceData{d}, nil
+}
+
+// suppressBranchSourceOfExistingBranch ignores the source of a branch once it is created, the source can't be
+// read back and a replacement would delete the commits of the branch
+func suppressBranchSourceOfExistingBranch(_, _, _ string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+// resolveBranchSource returns the commit the new branch points to
+func resolveBranchSource(clients *client.AggregatedClient, d *schema.ResourceData) (string, error) {
+	repoID := d.Get("repository_id").(string)
+
+	if commitID, ok := d.GetOk("ref_commit_id"); ok {
+		return commitID.(string), nil
+	}
+
+	if refBranch, ok := d.GetOk("ref_branch"); ok {
+		ref := withBranchPrefix(refBranch.(string))
+		gitRef, err := getGitRef(clients, repoID, ref)
+		if err != nil {
+			return "", fmt.Errorf("Query branch failed, repositoryID: %s, branch: %s. Error:  %+v", repoID, ref, err)
+		}
+		if gitRef == nil {
+			return "", fmt.Errorf("Branch %s not found in repository %s", ref, repoID)
+		}
+		return converter.ToString(gitRef.ObjectId, ""), nil
+	}
+
+	ref := "refs/tags/" + strings.TrimPrefix(d.Get("ref_tag").(string), "refs/tags/")
+	gitRef, err := getGitRef(clients, repoID, ref)
+	if err != nil {
+		return "", fmt.Errorf("Query tag failed, repositoryID: %s, tag: %s. Error:  %+v", repoID, ref, err)
+	}
+	if gitRef == nil {
+		return "", fmt.Errorf("Tag %s not found in repository %s", ref, repoID)
+	}
+	// annotated tags point to a tag object, the peeled object is the tagged commit
+	if gitRef.PeeledObjectId != nil && *gitRef.PeeledObjectId != "" {
+		return *gitRef.PeeledObjectId, nil
+	}
+	return converter.ToString(gitRef.ObjectId, ""), nil
+}
+
+// getGitRef returns the ref with the given full name or nil if the ref does not exist
+func getGitRef(clients *client.AggregatedClient, repoID string, ref string) (*git.GitRef, error) {
+	args := git.GetRefsArgs{
+		RepositoryId: converter.String(repoID),
+		Filter:       converter.String(strings.TrimPrefix(ref, "refs/")),
+		PeelTags:     converter.Bool(true),
+	}
+	for {
+		refs, err := clients.GitReposClient.GetRefs(clients.Ctx, args)
+		if err != nil {
+			return nil, err
+		}
+
+		// the filter matches all refs starting with the name
+		for _, gitRef := range refs.Value {
+			if gitRef.Name != nil && *gitRef.Name == ref {
+				return &gitRef, nil
+			}
+		}
+
+		if refs.ContinuationToken == "" {
+			return nil, nil
+		}
+		args.ContinuationToken = converter.String(refs.ContinuationToken)
+	}
+}
+
+// updateGitRef creates, moves or deletes a ref and returns an error if the service rejected the update
+func updateGitRef(clients *client.AggregatedClient, repoID string, refUpdate git.GitRefUpdate) (*git.GitRefUpdateResult, error) {
+	results, err := clients.GitReposClient.UpdateRefs(clients.Ctx, git.UpdateRefsArgs{
+		RepositoryId: converter.String(repoID),
+		RefUpdates:   &[]git.GitRefUpdate{refUpdate},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if results == nil || len(*results) != 1 {
+		return nil, fmt.Errorf("Unexpected result of the ref update")
+	}
+
+	result := (*results)[0]
+	if !converter.ToBool(result.Success, false) {
+		status := ""
+		if result.UpdateStatus != nil {
+			status = string(*result.UpdateStatus)
+		}
+		return nil, fmt.Errorf("Ref update rejected, status: %s, message: %s", status, converter.ToString(result.CustomMessage, ""))
+	}
+	return &result, nil
+}
+
+func lockGitBranch(clients *client.AggregatedClient, repoID string, ref string, isLocked bool) error {
+	_, err := clients.GitReposClient.UpdateRef(clients.Ctx, git.UpdateRefArgs{
+		RepositoryId: converter.String(repoID),
+		Filter:       converter.String(strings.TrimPrefix(ref, "refs/")),
+		NewRefInfo: &git.GitRefUpdate{
+			IsLocked: converter.Bool(isLocked),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Lock branch failed, repositoryID: %s, branch: %s, locked: %t. Error:  %+v", repoID, ref, isLocked, err)
+	}
+	return nil
+}
+
+func withBranchPrefix(branch string) string {
+	return "refs/heads/" + shortBranchName(branch)
+}
+
+// splitRepoBranchID splits the resource ID into separate repository id and branch ref components.
+func splitRepoBranchID(id string) (string, string) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
@@ -0,0 +1,255 @@
+//go:build (all || git || resource_git_repository_branch) && (!exclude_git || !exclude_resource_git_repository_branch)
+// +build all git resource_git_repository_branch
+// +build !exclude_git !exclude_resource_git_repository_branch
+
+package git
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testBranchRepoID = "4c3e8e4a-7b0d-4a8e-9f5a-2d6c1b0e9f31"
+var testBranchCommitID = "1111111111111111111111111111111111111111"
+var testBranchTagObjectID = "2222222222222222222222222222222222222222"
+
+func getGitRepositoryBranchResourceData(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	raw["repository_id"] = testBranchRepoID
+	raw["name"] = "release/1.0"
+	return schema.TestResourceDataRaw(t, ResourceGitRepositoryBranch().Schema, raw)
+}
+
+// verifies that a branch created from a tag points to the tagged commit
+func TestGitRepositoryBranch_ResolveBranchSource_PeelsAnnotatedTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId: converter.String(testBranchRepoID),
+			Filter:       converter.String("tags/v1.0"),
+			PeelTags:     converter.Bool(true),
+		}).
+		Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{
+				{Name: converter.String("refs/tags/v1.0.1"), ObjectId: converter.String(emptyObjectID)},
+				{Name: converter.String("refs/tags/v1.0"), ObjectId: converter.String(testBranchTagObjectID), PeeledObjectId: converter.String(testBranchCommitID)},
+			},
+		}, nil).
+		Times(1)
+
+	d := getGitRepositoryBranchResourceData(t, map[string]interface{}{"ref_tag": "v1.0"})
+	objectID, err := resolveBranchSource(clients, d)
+	require.Nil(t, err)
+	require.Equal(t, testBranchCommitID, objectID)
+}
+
+// verifies that a missing source branch is reported
+func TestGitRepositoryBranch_ResolveBranchSource_MissingBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, gomock.Any()).
+		Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{
+				{Name: converter.String("refs/heads/main-old"), ObjectId: converter.String(testBranchCommitID)},
+			},
+		}, nil).
+		Times(1)
+
+	d := getGitRepositoryBranchResourceData(t, map[string]interface{}{"ref_branch": "main"})
+	_, err := resolveBranchSource(clients, d)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Branch refs/heads/main not found")
+}
+
+// verifies that a branch is created from a commit and locked afterwards
+func TestGitRepositoryBranch_Create_FromCommitAndLock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		UpdateRefs(clients.Ctx, git.UpdateRefsArgs{
+			RepositoryId: converter.String(testBranchRepoID),
+			RefUpdates: &[]git.GitRefUpdate{
+				{
+					Name:        converter.String("refs/heads/release/1.0"),
+					OldObjectId: converter.String(emptyObjectID),
+					NewObjectId: converter.String(testBranchCommitID),
+				},
+			},
+		}).
+		Return(&[]git.GitRefUpdateResult{{Success: converter.Bool(true)}}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		UpdateRef(clients.Ctx, git.UpdateRefArgs{
+			RepositoryId: converter.String(testBranchRepoID),
+			Filter:       converter.String("heads/release/1.0"),
+			NewRefInfo:   &git.GitRefUpdate{IsLocked: converter.Bool(true)},
+		}).
+		Return(&git.GitRef{}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, gomock.Any()).
+		Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{
+				{Name: converter.String("refs/heads/release/1.0"), ObjectId: converter.String(testBranchCommitID), IsLocked: converter.Bool(true)},
+			},
+		}, nil).
+		Times(1)
+
+	d := getGitRepositoryBranchResourceData(t, map[string]interface{}{
+		"ref_commit_id": testBranchCommitID,
+		"is_locked":     true,
+	})
+	err := resourceGitRepositoryBranchCreate(d, clients)
+	require.Nil(t, err)
+	require.Equal(t, testBranchRepoID+":refs/heads/release/1.0", d.Id())
+	require.Equal(t, testBranchCommitID, d.Get("last_commit_id"))
+	require.True(t, d.Get("is_locked").(bool))
+}
+
+// verifies that a rejected ref update is reported
+func TestGitRepositoryBranch_Create_RejectedUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		UpdateRefs(clients.Ctx, gomock.Any()).
+		Return(&[]git.GitRefUpdateResult{{
+			Success:      converter.Bool(false),
+			UpdateStatus: &git.GitRefUpdateStatusValues.CreateBranchPermissionRequired,
+		}}, nil).
+		Times(1)
+
+	d := getGitRepositoryBranchResourceData(t, map[string]interface{}{"ref_commit_id": testBranchCommitID})
+	err := resourceGitRepositoryBranchCreate(d, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "createBranchPermissionRequired")
+	require.Empty(t, d.Id())
+}
+
+// verifies that the ref is deleted from its current commit
+func TestGitRepositoryBranch_Delete_DeletesRef(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, gomock.Any()).
+		Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{
+				{Name: converter.String("refs/heads/release/1.0"), ObjectId: converter.String(testBranchCommitID)},
+			},
+		}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		UpdateRefs(clients.Ctx, git.UpdateRefsArgs{
+			RepositoryId: converter.String(testBranchRepoID),
+			RefUpdates: &[]git.GitRefUpdate{
+				{
+					Name:        converter.String("refs/heads/release/1.0"),
+					OldObjectId: converter.String(testBranchCommitID),
+					NewObjectId: converter.String(emptyObjectID),
+				},
+			},
+		}).
+		Return(nil, errors.New("UpdateRefs() Failed")).
+		Times(1)
+
+	d := getGitRepositoryBranchResourceData(t, map[string]interface{}{"ref_commit_id": testBranchCommitID})
+	d.SetId(testBranchRepoID + ":refs/heads/release/1.0")
+	err := resourceGitRepositoryBranchDelete(d, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "UpdateRefs() Failed")
+}
+
+// verifies that an imported branch is not replaced by the first plan, the source of the branch can't be read back
+func TestGitRepositoryBranch_Import_NoChangesPlanned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId: converter.String(testBranchRepoID),
+			Filter:       converter.String("heads/release/1.0"),
+			PeelTags:     converter.Bool(true),
+		}).
+		Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{
+				{Name: converter.String("refs/heads/release/1.0"), ObjectId: converter.String(testBranchCommitID)},
+			},
+		}, nil).
+		Times(1)
+
+	r := ResourceGitRepositoryBranch()
+	d := r.TestResourceData()
+	d.SetId(testBranchRepoID + ":release/1.0")
+	imported, err := r.Importer.State(d, clients)
+	require.Nil(t, err)
+	require.Len(t, imported, 1)
+	require.Nil(t, r.Read(imported[0], clients))
+	require.Equal(t, testBranchRepoID+":refs/heads/release/1.0", imported[0].Id())
+
+	for _, source := range []map[string]interface{}{
+		{"ref_branch": "main"},
+		{"ref_tag": "v1.0"},
+		{"ref_commit_id": testBranchCommitID},
+	} {
+		source["repository_id"] = testBranchRepoID
+		source["name"] = "release/1.0"
+		diff, err := r.Diff(imported[0].State(), terraform.NewResourceConfigRaw(source), clients)
+		require.Nil(t, err)
+		require.True(t, diff == nil || diff.Empty(), "unexpected changes %+v", diff)
+	}
+}
+
+func TestSplitRepoBranchID(t *testing.T) {
+	repoID, ref := splitRepoBranchID("repo:refs/heads/feature/a")
+	require.Equal(t, "repo", repoID)
+	require.Equal(t, "refs/heads/feature/a", ref)
+
+	repoID, ref = splitRepoBranchID("repo")
+	require.Equal(t, "repo", repoID)
+	require.Empty(t, ref)
+}
@@ -0,0 +1,500 @@
+package git
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceGitRepositoryFiles schema and implementation for a set of files pushed in a single commit
+func ResourceGitRepositoryFiles() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceGitRepositoryFilesCreate,
+		Read:          resourceGitRepositoryFilesRead,
+		Update:        resourceGitRepositoryFilesUpdate,
+		Delete:        resourceGitRepositoryFilesDelete,
+		CustomizeDiff: customizeGitRepositoryFilesDiff,
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The repository ID",
+				ValidateFunc: validation.IsUUID,
+			},
+			"branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The branch name, defaults to \"refs/heads/master\"",
+				Default:     "refs/heads/master",
+			},
+			"files": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Description:  "A map of file paths to file contents",
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: []string{"files", "source_directory"},
+			},
+			"source_directory": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "A local directory whose files are pushed to the root of the repository",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				AtLeastOneOf: []string{"files", "source_directory"},
+			},
+			"include": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Glob patterns of the files of the source directory to push, defaults to all files",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Glob patterns of the files of the source directory not to push",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"commit_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The commit message when adding, updating or deleting the files",
+			},
+			"overwrite_on_create": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enable overwriting existing files, defaults to \"false\"",
+				Default:     false,
+			},
+			"object_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "A map of the managed file paths to the git object IDs of their contents",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+	}
+}
+
+// the object IDs are compared on plan, a file changed in the repository or in the
+// source directory shows up as a change of the object ID of the file
+func customizeGitRepositoryFilesDiff(d *schema.ResourceDiff, m interface{}) error {
+	for _, key := range []string{"files", "source_directory", "include", "exclude"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("object_ids")
+		}
+	}
+
+	files, err := expandGitRepositoryFiles(d)
+	if err != nil {
+		return err
+	}
+
+	objectIDs := gitBlobObjectIDs(files)
+	current := d.Get("object_ids").(map[string]interface{})
+	if len(current) == len(objectIDs) {
+		equal := true
+		for filePath, objectID := range objectIDs {
+			if current[filePath] != objectID {
+				equal = false
+				break
+			}
+		}
+		if equal {
+			return nil
+		}
+	}
+	return d.SetNew("object_ids", objectIDs)
+}
+
+func resourceGitRepositoryFilesCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	repoID := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+
+	if err := checkRepositoryBranchExists(clients, repoID, branch); err != nil {
+		return err
+	}
+
+	files, err := expandGitRepositoryFiles(d)
+	if err != nil {
+		return err
+	}
+
+	err = pushGitRepositoryFiles(clients, d, files, nil, d.Get("overwrite_on_create").(bool), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Create repository files failed, repositoryID: %s, branch: %s. Error:  %+v", repoID, branch, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", repoID, branch))
+	d.Set("object_ids", gitBlobObjectIDs(files))
+	return resourceGitRepositoryFilesRead(d, m)
+}
+
+func resourceGitRepositoryFilesRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	repoID := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+
+	repoObjectIDs, err := getGitRepositoryObjectIDs(clients, repoID, branch)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Query repository items failed, repositoryID: %s, branch: %s. Error:  %+v", repoID, branch, err)
+	}
+
+	// only the files owned by the resource are tracked, deleted files are missing from the map
+	objectIDs := map[string]string{}
+	for filePath := range d.Get("object_ids").(map[string]interface{}) {
+		if objectID, ok := repoObjectIDs[filePath]; ok {
+			objectIDs[filePath] = objectID
+		}
+	}
+	d.Set("object_ids", objectIDs)
+	return nil
+}
+
+func resourceGitRepositoryFilesUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	repoID := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+
+	files, err := expandGitRepositoryFiles(d)
+	if err != nil {
+		return err
+	}
+
+	oldObjectIDs, _ := d.GetChange("object_ids")
+	owned := tfhelper.ExpandStringList(mapKeys(oldObjectIDs.(map[string]interface{})))
+
+	// the owned files are always overwritten, files added to the resource only if overwriting is enabled
+	err = pushGitRepositoryFiles(clients, d, files, owned, d.Get("overwrite_on_create").(bool), d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("Update repository files failed, repositoryID: %s, branch: %s. Error:  %+v", repoID, branch, err)
+	}
+
+	d.Set("object_ids", gitBlobObjectIDs(files))
+	return resourceGitRepositoryFilesRead(d, m)
+}
+
+func resourceGitRepositoryFilesDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	repoID := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+	owned := tfhelper.ExpandStringList(mapKeys(d.Get("object_ids").(map[string]interface{})))
+
+	err := pushGitRepositoryFiles(clients, d, map[string]string{}, owned, true, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmt.Errorf("Failed to destroy the repository files, repository ID: %s, branch: %s. Error %+v ", repoID, branch, err)
+	}
+	return nil
+}
+
+// pushGitRepositoryFiles pushes the files and deletes the owned files that are no longer wanted in one commit
+func pushGitRepositoryFiles(clients *client.AggregatedClient, d *schema.ResourceData, files map[string]string, owned []string, overwrite bool, timeout time.Duration) error {
+	repoID := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+
+	// Need to retry pushing the files as multiple updates could happen at the same time
+	return resource.Retry(timeout, func() *resource.RetryError {
+		objectID, err := getLastCommitId(clients, repoID, branch)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		repoObjectIDs, err := getGitRepositoryObjectIDs(clients, repoID, branch)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		changes, err := gitRepositoryFileChanges(files, owned, repoObjectIDs, overwrite)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if len(changes) == 0 {
+			return nil
+		}
+
+		message := fmt.Sprintf("Update %d file(s)", len(changes))
+		if commitMessage, ok := d.GetOk("commit_message"); ok {
+			message = commitMessage.(string)
+		}
+
+		_, err = clients.GitReposClient.CreatePush(clients.Ctx, git.CreatePushArgs{
+			RepositoryId: converter.String(repoID),
+			Push: &git.GitPush{
+				RefUpdates: &[]git.GitRefUpdate{
+					{
+						Name:        converter.String(branch),
+						OldObjectId: converter.String(objectID),
+					},
+				},
+				Commits: &[]git.GitCommitRef{
+					{
+						Comment: converter.String(message),
+						Changes: &changes,
+					},
+				},
+			},
+		})
+		if err != nil {
+			if utils.ResponseContainsStatusMessage(err, "has already been updated by another client") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+// gitRepositoryFileChanges returns the changes needed to get from the files in the repository to the wanted files
+func gitRepositoryFileChanges(files map[string]string, owned []string, repoObjectIDs map[string]string, overwrite bool) ([]interface{}, error) {
+	ownedFiles := map[string]bool{}
+	for _, filePath := range owned {
+		ownedFiles[filePath] = true
+	}
+
+	changes := []interface{}{}
+	for _, filePath := range sortedKeys(files) {
+		content := files[filePath]
+		changeType := git.VersionControlChangeTypeValues.Add
+		if repoObjectID, ok := repoObjectIDs[filePath]; ok {
+			if repoObjectID == gitBlobObjectID(content) {
+				continue
+			}
+			if !overwrite && !ownedFiles[filePath] {
+				return nil, fmt.Errorf("Refusing to overwrite existing file %s. Configure `overwrite_on_create` to `true` to override.", filePath)
+			}
+			changeType = git.VersionControlChangeTypeValues.Edit
+		}
+		changes = append(changes, expandGitFileChange(filePath, content, changeType))
+	}
+
+	for _, filePath := range owned {
+		if _, wanted := files[filePath]; wanted {
+			continue
+		}
+		if _, ok := repoObjectIDs[filePath]; ok {
+			changes = append(changes, git.GitChange{
+				ChangeType: &git.VersionControlChangeTypeValues.Delete,
+				Item: git.GitItem{
+					Path: converter.String(filePath),
+				},
+			})
+		}
+	}
+	return changes, nil
+}
+
+func expandGitFileChange(filePath string, content string, changeType git.VersionControlChangeType) git.GitChange {
+	newContent := &git.ItemContent{
+		Content:     converter.String(content),
+		ContentType: &git.ItemContentTypeValues.RawText,
+	}
+	// binary files of the source directory can not be sent as text
+	if !utf8.ValidString(content) {
+		newContent = &git.ItemContent{
+			Content:     converter.String(base64.StdEncoding.EncodeToString([]byte(content))),
+			ContentType: &git.ItemContentTypeValues.Base64Encoded,
+		}
+	}
+
+	return git.GitChange{
+		ChangeType: &changeType,
+		Item: git.GitItem{
+			Path: converter.String(filePath),
+		},
+		NewContent: newContent,
+	}
+}
+
+// getGitRepositoryObjectIDs returns the object IDs of all files of a branch
+func getGitRepositoryObjectIDs(clients *client.AggregatedClient, repoID string, branch string) (map[string]string, error) {
+	items, err := clients.GitReposClient.GetItems(clients.Ctx, git.GetItemsArgs{
+		RepositoryId:   converter.String(repoID),
+		ScopePath:      converter.String("/"),
+		RecursionLevel: &git.VersionControlRecursionTypeValues.Full,
+		VersionDescriptor: &git.GitVersionDescriptor{
+			Version:     converter.String(shortBranchName(branch)),
+			VersionType: &git.GitVersionTypeValues.Branch,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	objectIDs := map[string]string{}
+	if items == nil {
+		return objectIDs, nil
+	}
+	for _, item := range *items {
+		if item.Path == nil || item.ObjectId == nil || converter.ToBool(item.IsFolder, false) {
+			continue
+		}
+		objectIDs[normalizeGitFilePath(*item.Path)] = *item.ObjectId
+	}
+	return objectIDs, nil
+}
+
+// expandGitRepositoryFiles returns the wanted files, the files of the map take precedence over the source directory
+func expandGitRepositoryFiles(d interface {
+	Get(string) interface{}
+}) (map[string]string, error) {
+	files := map[string]string{}
+
+	if sourceDirectory := d.Get("source_directory").(string); sourceDirectory != "" {
+		include := tfhelper.ExpandStringList(d.Get("include").([]interface{}))
+		exclude := tfhelper.ExpandStringList(d.Get("exclude").([]interface{}))
+		directoryFiles, err := readSourceDirectory(sourceDirectory, include, exclude)
+		if err != nil {
+			return nil, err
+		}
+		for filePath, content := range directoryFiles {
+			files[filePath] = content
+		}
+	}
+
+	for filePath, content := range d.Get("files").(map[string]interface{}) {
+		files[normalizeGitFilePath(filePath)] = content.(string)
+	}
+	return files, nil
+}
+
+// readSourceDirectory returns the files of the directory matching one of the include and none of the exclude patterns
+func readSourceDirectory(sourceDirectory string, include []string, exclude []string) (map[string]string, error) {
+	files := map[string]string{}
+	err := filepath.Walk(sourceDirectory, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(sourceDirectory, filePath)
+		if err != nil {
+			return err
+		}
+		relativePath = filepath.ToSlash(relativePath)
+		if len(include) > 0 && !matchesAnyGlob(relativePath, include) {
+			return nil
+		}
+		if matchesAnyGlob(relativePath, exclude) {
+			return nil
+		}
+
+		content, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		files[normalizeGitFilePath(relativePath)] = string(content)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Reading source directory %s failed. Error:  %+v", sourceDirectory, err)
+	}
+	return files, nil
+}
+
+func matchesAnyGlob(filePath string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if matchGlob(strings.Split(strings.Trim(pattern, "/"), "/"), strings.Split(filePath, "/")) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchGlob matches the path segments against the pattern segments, "**" matches any number of segments
+func matchGlob(pattern []string, segments []string) bool {
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			if matchGlob(pattern[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segments) == 0 {
+		return false
+	}
+	if matched, _ := path.Match(pattern[0], segments[0]); !matched {
+		return false
+	}
+	return matchGlob(pattern[1:], segments[1:])
+}
+
+// gitBlobObjectID computes the object ID git assigns to a file with the given content
+func gitBlobObjectID(content string) string {
+	hash := sha1.New()
+	hash.Write([]byte(fmt.Sprintf("blob %d\x00", len(content))))
+	hash.Write([]byte(content))
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func gitBlobObjectIDs(files map[string]string) map[string]string {
+	objectIDs := make(map[string]string, len(files))
+	for filePath, content := range files {
+		objectIDs[filePath] = gitBlobObjectID(content)
+	}
+	return objectIDs
+}
+
+// normalizeGitFilePath returns the path the service uses for a file, e.g. "/docs/README.md"
+func normalizeGitFilePath(filePath string) string {
+	return "/" + strings.TrimPrefix(filepath.ToSlash(filePath), "/")
+}
+
+func mapKeys(m map[string]interface{}) []interface{} {
+	keys := make([]interface{}, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,230 @@
+//go:build (all || git || resource_git_repository_files) && (!exclude_git || !exclude_resource_git_repository_files)
+// +build all git resource_git_repository_files
+// +build !exclude_git !exclude_resource_git_repository_files
+
+package git
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testFilesRepoID = "0f6a3e52-8d8c-4b71-9c4e-5a3d2b1c0e9f"
+var testFilesCommitID = "3333333333333333333333333333333333333333"
+
+// verifies that the object IDs match the ones computed by git
+func TestGitRepositoryFiles_GitBlobObjectID(t *testing.T) {
+	require.Equal(t, "e69de29bb2d1d6434b8b29ae775ad8c2e48c5391", gitBlobObjectID(""))
+	require.Equal(t, "ce013625030ba8dba906f756967f9e9ca394464a", gitBlobObjectID("hello\n"))
+}
+
+func TestGitRepositoryFiles_MatchesAnyGlob(t *testing.T) {
+	require.True(t, matchesAnyGlob("README.md", []string{"*.md"}))
+	require.False(t, matchesAnyGlob("docs/README.md", []string{"*.md"}))
+	require.True(t, matchesAnyGlob("docs/README.md", []string{"**/*.md"}))
+	require.True(t, matchesAnyGlob("README.md", []string{"**/*.md"}))
+	require.True(t, matchesAnyGlob("drafts/a/b.yml", []string{"drafts/**"}))
+	require.False(t, matchesAnyGlob("pipelines/b.yml", []string{"drafts/**"}))
+}
+
+// verifies that the files of the source directory are filtered and the map takes precedence
+func TestGitRepositoryFiles_ExpandGitRepositoryFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "repository-files")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	require.Nil(t, os.MkdirAll(filepath.Join(dir, "pipelines", "drafts"), 0755))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "pipelines", "build.yml"), []byte("build"), 0644))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "pipelines", "drafts", "test.yml"), []byte("test"), 0644))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "pipelines", "notes.txt"), []byte("notes"), 0644))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "README.md"), []byte("readme"), 0644))
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryFiles().Schema, map[string]interface{}{
+		"repository_id":    testFilesRepoID,
+		"source_directory": dir,
+		"include":          []interface{}{"**/*.yml", "README.md"},
+		"exclude":          []interface{}{"**/drafts/**"},
+		"files":            map[string]interface{}{"README.md": "overridden"},
+	})
+
+	files, err := expandGitRepositoryFiles(d)
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{
+		"/pipelines/build.yml": "build",
+		"/README.md":           "overridden",
+	}, files)
+}
+
+// verifies that unchanged files are skipped and only owned files are deleted
+func TestGitRepositoryFiles_FileChanges(t *testing.T) {
+	files := map[string]string{
+		"/a.txt": "a",
+		"/b.txt": "new b",
+		"/c.txt": "c",
+	}
+	repoObjectIDs := map[string]string{
+		"/a.txt":     gitBlobObjectID("a"),
+		"/b.txt":     gitBlobObjectID("b"),
+		"/old.txt":   gitBlobObjectID("old"),
+		"/other.txt": gitBlobObjectID("other"),
+	}
+
+	changes, err := gitRepositoryFileChanges(files, []string{"/a.txt", "/b.txt", "/old.txt"}, repoObjectIDs, false)
+	require.Nil(t, err)
+	require.Len(t, changes, 3)
+
+	changeTypes := map[string]git.VersionControlChangeType{}
+	for _, change := range changes {
+		gitChange := change.(git.GitChange)
+		changeTypes[*gitChange.Item.(git.GitItem).Path] = *gitChange.ChangeType
+	}
+	require.Equal(t, map[string]git.VersionControlChangeType{
+		"/b.txt":   git.VersionControlChangeTypeValues.Edit,
+		"/c.txt":   git.VersionControlChangeTypeValues.Add,
+		"/old.txt": git.VersionControlChangeTypeValues.Delete,
+	}, changeTypes)
+}
+
+// verifies that existing files not owned by the resource are not overwritten by default
+func TestGitRepositoryFiles_FileChanges_RefusesOverwrite(t *testing.T) {
+	files := map[string]string{"/a.txt": "a"}
+	repoObjectIDs := map[string]string{"/a.txt": gitBlobObjectID("other")}
+
+	_, err := gitRepositoryFileChanges(files, nil, repoObjectIDs, false)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Refusing to overwrite existing file /a.txt")
+
+	changes, err := gitRepositoryFileChanges(files, nil, repoObjectIDs, true)
+	require.Nil(t, err)
+	require.Len(t, changes, 1)
+}
+
+// verifies that binary files are sent base64 encoded
+func TestGitRepositoryFiles_ExpandGitFileChange_Binary(t *testing.T) {
+	change := expandGitFileChange("/image.png", "\x89PNG\xff", git.VersionControlChangeTypeValues.Add)
+	require.Equal(t, git.ItemContentTypeValues.Base64Encoded, *change.NewContent.ContentType)
+	require.Equal(t, "iVBOR/8=", *change.NewContent.Content)
+}
+
+// verifies that all files are pushed in one commit and drift is read from the repository
+func TestGitRepositoryFiles_Create_PushesOneCommit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetBranch(clients.Ctx, gomock.Any()).
+		Return(&git.GitBranchStats{}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetCommits(clients.Ctx, gomock.Any()).
+		Return(&[]git.GitCommitRef{{CommitId: converter.String(testFilesCommitID)}}, nil).
+		Times(1)
+	gomock.InOrder(
+		reposClient.
+			EXPECT().
+			GetItems(clients.Ctx, gomock.Any()).
+			Return(&[]git.GitItem{
+				{Path: converter.String("/"), IsFolder: converter.Bool(true), ObjectId: converter.String(testFilesCommitID)},
+				{Path: converter.String("/other.txt"), ObjectId: converter.String(gitBlobObjectID("other"))},
+			}, nil),
+		reposClient.
+			EXPECT().
+			GetItems(clients.Ctx, gomock.Any()).
+			Return(&[]git.GitItem{
+				{Path: converter.String("/a.txt"), ObjectId: converter.String(gitBlobObjectID("a"))},
+				{Path: converter.String("/docs/b.txt"), ObjectId: converter.String(gitBlobObjectID("changed"))},
+				{Path: converter.String("/other.txt"), ObjectId: converter.String(gitBlobObjectID("other"))},
+			}, nil),
+	)
+	reposClient.
+		EXPECT().
+		CreatePush(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args git.CreatePushArgs) (*git.GitPush, error) {
+			require.Equal(t, testFilesRepoID, *args.RepositoryId)
+			require.Equal(t, testFilesCommitID, *(*args.Push.RefUpdates)[0].OldObjectId)
+			require.Len(t, *args.Push.Commits, 1)
+			require.Len(t, *(*args.Push.Commits)[0].Changes, 2)
+			return &git.GitPush{}, nil
+		}).
+		Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryFiles().Schema, map[string]interface{}{
+		"repository_id": testFilesRepoID,
+		"files":         map[string]interface{}{"a.txt": "a", "docs/b.txt": "b"},
+	})
+	err := resourceGitRepositoryFilesCreate(d, clients)
+	require.Nil(t, err)
+	require.Equal(t, testFilesRepoID+":refs/heads/master", d.Id())
+	require.Equal(t, map[string]interface{}{
+		"/a.txt":      gitBlobObjectID("a"),
+		"/docs/b.txt": gitBlobObjectID("changed"),
+	}, d.Get("object_ids"))
+}
+
+// verifies that a file added to the resource does not overwrite an existing file it doesn't own
+func TestGitRepositoryFiles_Update_RefusesOverwriteOfAddedFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetCommits(clients.Ctx, gomock.Any()).
+		Return(&[]git.GitCommitRef{{CommitId: converter.String(testFilesCommitID)}}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetItems(clients.Ctx, gomock.Any()).
+		Return(&[]git.GitItem{
+			{Path: converter.String("/a.txt"), ObjectId: converter.String(gitBlobObjectID("a"))},
+			{Path: converter.String("/other.txt"), ObjectId: converter.String(gitBlobObjectID("other"))},
+		}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		CreatePush(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	r := ResourceGitRepositoryFiles()
+	created := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"repository_id": testFilesRepoID,
+		"files":         map[string]interface{}{"a.txt": "a"},
+	})
+	created.SetId(testFilesRepoID + ":refs/heads/master")
+	created.Set("object_ids", map[string]interface{}{"/a.txt": gitBlobObjectID("a")})
+	state := created.State()
+
+	diff, err := r.Diff(state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"repository_id": testFilesRepoID,
+		"files":         map[string]interface{}{"a.txt": "a", "other.txt": "mine"},
+	}), clients)
+	require.Nil(t, err)
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	require.Nil(t, err)
+
+	err = resourceGitRepositoryFilesUpdate(d, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Refusing to overwrite existing file /other.txt")
+}
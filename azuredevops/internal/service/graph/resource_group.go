@@ -135,7 +135,7 @@ type azDOGraphCreateGroupArgs struct {
 	GroupDescriptors *[]string
 }
 
-func azDOGraphCreateGroup(ctx context.Context, client graph.Client, args azDOGraphCreateGroupArgs) (*graph.GraphGroup, error) {
+func azDOGraphCreateGroup(ctx context.Context, graphClient graph.Client, args azDOGraphCreateGroupArgs) (*graph.GraphGroup, error) {
 	if args.CreationContext == nil {
 		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.CreationContext"}
 	}
@@ -161,7 +161,11 @@ func azDOGraphCreateGroup(ctx context.Context, client graph.Client, args azDOGra
 		return nil, marshalErr
 	}
 	locationID, _ := uuid.Parse("ebbe6af8-0b91-4c13-8cf1-777c14858188")
-	if clientImpl, ok := client.(*graph.ClientImpl); ok {
+	sdkClient, err := client.UnwrapClient(graphClient)
+	if err != nil {
+		return nil, err
+	}
+	if clientImpl, ok := sdkClient.(*graph.ClientImpl); ok {
 		resp, err := clientImpl.Client.Send(ctx, http.MethodPost, locationID, "5.1-preview.1", nil, queryParams, bytes.NewReader(body), "application/json", "application/json", nil)
 		if err != nil {
 			return nil, err
@@ -170,8 +174,7 @@ func azDOGraphCreateGroup(ctx context.Context, client graph.Client, args azDOGra
 		err = clientImpl.Client.UnmarshalBody(resp, &responseValue)
 		return &responseValue, err
 	}
-
-	panic("Invalid Azure DevOps Graph client implementation")
+	return nil, fmt.Errorf("Invalid Azure DevOps Graph client implementation %T", graphClient)
 }
 
 func resourceGroupCreate(d *schema.ResourceData, m interface{}) error {
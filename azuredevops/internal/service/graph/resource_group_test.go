@@ -10,6 +10,11 @@ package graph
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -341,3 +346,41 @@ func TestGroupResource_Create_TestHandleErrorVstsContext(t *testing.T) {
 		require.Contains(t, err.Error(), "CreateGroup() Failed")
 	*/
 }
+
+// verifies that groups are created through the client of the SDK when the identities are cached
+func TestGroupResource_Create_CachedGraphClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/tfs/defaultcollection/_apis/connectionData":
+			fmt.Fprint(w, `{"deploymentType":"onPremises"}`)
+		case r.Method == http.MethodOptions && r.URL.Path == "/tfs/defaultcollection/_apis":
+			fmt.Fprint(w, `{"count":1,"value":[
+				{"id":"ebbe6af8-0b91-4c13-8cf1-777c14858188","area":"Graph","resourceName":"Groups","routeTemplate":"_apis/{area}/{resource}/{groupDescriptor}","resourceVersion":1,"minVersion":"1.0","maxVersion":"6.0","releasedVersion":"0.0"}]}`)
+		case r.Method == http.MethodPost && strings.EqualFold(r.URL.Path, "/tfs/defaultcollection/_apis/graph/groups"):
+			body, err := ioutil.ReadAll(r.Body)
+			require.Nil(t, err)
+			require.Contains(t, string(body), displayName)
+			fmt.Fprintf(w, `{"descriptor":"vssgp.test","displayName":"%s"}`, displayName)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	clients, err := client.GetAzdoClient(
+		&client.AuthConfig{PersonalAccessToken: "pat"},
+		&client.TransportConfig{IdentityCacheTTL: client.DefaultIdentityCacheTTL},
+		server.URL+"/tfs/DefaultCollection",
+		"0.12.0")
+	require.Nil(t, err)
+	_, isSDKClient := clients.GraphClient.(*graph.ClientImpl)
+	require.False(t, isSDKClient, "the graph client must be wrapped by the identity cache")
+
+	group, err := azDOGraphCreateGroup(clients.Ctx, clients.GraphClient, azDOGraphCreateGroupArgs{
+		CreationContext: &graph.GraphGroupVstsCreationContext{DisplayName: &displayName},
+	})
+	require.Nil(t, err)
+	require.Equal(t, "vssgp.test", *group.Descriptor)
+}
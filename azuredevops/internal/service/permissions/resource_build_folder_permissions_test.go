@@ -0,0 +1,56 @@
+//go:build (all || permissions || resource_build_folder_permissions) && (!exclude_permissions || !resource_build_folder_permissions)
+// +build all permissions resource_build_folder_permissions
+// +build !exclude_permissions !resource_build_folder_permissions
+
+package permissions
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+ * Begin unit tests
+ */
+
+var buildFolderProjectID = "9083e944-8e9e-405e-960a-c80180aa71e6"
+
+func TestBuildFolderPermissions_CreateBuildFolderToken(t *testing.T) {
+	var d *schema.ResourceData
+	var token string
+	var err error
+
+	d = getBuildFolderPermissionsResource(t, buildFolderProjectID, "\\a\\b\\c")
+	token, err = createBuildFolderToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("%s/a/b/c", buildFolderProjectID), token)
+
+	d = getBuildFolderPermissionsResource(t, buildFolderProjectID, "\\")
+	token, err = createBuildFolderToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, buildFolderProjectID, token)
+
+	d = getBuildFolderPermissionsResource(t, "", "\\a")
+	token, err = createBuildFolderToken(d, nil)
+	assert.Empty(t, token)
+	assert.NotNil(t, err)
+
+	d = getBuildFolderPermissionsResource(t, buildFolderProjectID, "")
+	token, err = createBuildFolderToken(d, nil)
+	assert.Empty(t, token)
+	assert.NotNil(t, err)
+}
+
+func getBuildFolderPermissionsResource(t *testing.T, projectID string, path string) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, ResourceBuildFolderPermissions().Schema, nil)
+	if projectID != "" {
+		d.Set("project_id", projectID)
+	}
+	if path != "" {
+		d.Set("path", path)
+	}
+	return d
+}
@@ -0,0 +1,100 @@
+package permissions
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	securityhelper "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
+)
+
+// ResourceEnvironmentPermissions schema and implementation for environment permission resource
+func ResourceEnvironmentPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEnvironmentPermissionsCreateOrUpdate,
+		Read:   resourceEnvironmentPermissionsRead,
+		Update: resourceEnvironmentPermissionsCreateOrUpdate,
+		Delete: resourceEnvironmentPermissionsDelete,
+		Schema: securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				Required:     true,
+				ForceNew:     true,
+			},
+			"environment_id": {
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+				ForceNew:     true,
+				Optional:     true,
+			},
+		}),
+	}
+}
+
+func resourceEnvironmentPermissionsCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.Environment, createEnvironmentToken)
+	if err != nil {
+		return err
+	}
+
+	if err := securityhelper.SetPrincipalPermissions(d, sn, nil, false); err != nil {
+		return err
+	}
+
+	return resourceEnvironmentPermissionsRead(d, m)
+}
+
+func resourceEnvironmentPermissionsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.Environment, createEnvironmentToken)
+	if err != nil {
+		return err
+	}
+
+	principalPermissions, err := securityhelper.GetPrincipalPermissions(d, sn)
+	if err != nil {
+		return err
+	}
+	if principalPermissions == nil {
+		d.SetId("")
+		log.Printf("[INFO] Permissions for ACL token %q not found. Removing from state", sn.GetToken())
+		return nil
+	}
+
+	d.Set("permissions", principalPermissions.Permissions)
+	return nil
+}
+
+func resourceEnvironmentPermissionsDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.Environment, createEnvironmentToken)
+	if err != nil {
+		return err
+	}
+
+	if err := securityhelper.SetPrincipalPermissions(d, sn, &securityhelper.PermissionTypeValues.NotSet, true); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func createEnvironmentToken(d *schema.ResourceData, clients *client.AggregatedClient) (string, error) {
+	projectID := d.Get("project_id").(string)
+	// Token format for ALL environments in a project: Environments/ProjectID
+	// Token format for a specific environment in a project: Environments/ProjectID/EnvironmentID
+	aclToken := "Environments/" + projectID
+	environmentID, environmentOk := d.GetOk("environment_id")
+	if environmentOk {
+		aclToken += "/" + strconv.Itoa(environmentID.(int))
+	}
+	return aclToken, nil
+}
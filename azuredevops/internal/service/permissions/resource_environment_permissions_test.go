@@ -0,0 +1,41 @@
+//go:build (all || permissions || resource_environment_permissions) && (!exclude_permissions || !exclude_resource_environment_permissions)
+// +build all permissions resource_environment_permissions
+// +build !exclude_permissions !exclude_resource_environment_permissions
+
+package permissions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+ * Begin unit tests
+ */
+
+var environmentProjectID = "9083e944-8e9e-405e-960a-c80180aa71e6"
+
+func TestEnvironmentPermissions_CreateEnvironmentToken(t *testing.T) {
+	d := getEnvironmentPermissionsResource(t, environmentProjectID, 0)
+	token, err := createEnvironmentToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "Environments/"+environmentProjectID, token)
+
+	d = getEnvironmentPermissionsResource(t, environmentProjectID, 12)
+	token, err = createEnvironmentToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "Environments/"+environmentProjectID+"/12", token)
+}
+
+func getEnvironmentPermissionsResource(t *testing.T, projectID string, environmentID int) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, ResourceEnvironmentPermissions().Schema, nil)
+	if projectID != "" {
+		d.Set("project_id", projectID)
+	}
+	if environmentID != 0 {
+		d.Set("environment_id", environmentID)
+	}
+	return d
+}
@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/security"
+)
+
+// aclKey identifies the access control list of a token, the security client tells the organizations apart
+type aclKey struct {
+	securityClient security.Client
+	namespaceID    uuid.UUID
+	token          string
+}
+
+// aclRead is a read of the complete access control list of a token that is shared by the waiting resources
+type aclRead struct {
+	done       chan struct{}
+	generation int
+	acl        *security.AccessControlList
+	err        error
+}
+
+// aclBatcher coalesces the reads of the access control list of a token by the permission resources of a run.
+// Concurrent reads wait for a single request and the result is kept until the provider changes the access
+// control list of the token.
+type aclBatcher struct {
+	lock        sync.Mutex
+	reads       map[aclKey]*aclRead
+	generations map[aclKey]int
+}
+
+var aclReads = &aclBatcher{
+	reads:       map[aclKey]*aclRead{},
+	generations: map[aclKey]int{},
+}
+
+// get returns the complete access control list of the token including the extended info of its entries
+func (b *aclBatcher) get(ctx context.Context, key aclKey) (*security.AccessControlList, error) {
+	b.lock.Lock()
+	read, ok := b.reads[key]
+	if !ok {
+		read = &aclRead{done: make(chan struct{}), generation: b.generations[key]}
+		b.reads[key] = read
+	}
+	b.lock.Unlock()
+
+	if ok {
+		<-read.done
+		return read.acl, read.err
+	}
+
+	read.acl, read.err = queryAccessControlList(ctx, key)
+	b.lock.Lock()
+	// failed reads and reads that raced with a change of the access control list are not kept
+	if read.err != nil || read.generation != b.generations[key] {
+		if b.reads[key] == read {
+			delete(b.reads, key)
+		}
+	}
+	b.lock.Unlock()
+	close(read.done)
+	return read.acl, read.err
+}
+
+// invalidate discards the access control list of the token after it was changed
+func (b *aclBatcher) invalidate(key aclKey) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.generations[key]++
+	delete(b.reads, key)
+}
+
+func queryAccessControlList(ctx context.Context, key aclKey) (*security.AccessControlList, error) {
+	bTrue := true
+	acl, err := key.securityClient.QueryAccessControlLists(ctx, security.QueryAccessControlListsArgs{
+		SecurityNamespaceId: &key.namespaceID,
+		Token:               &key.token,
+		IncludeExtendedInfo: &bTrue,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if acl == nil || len(*acl) <= 0 {
+		return nil, nil
+	}
+	if len(*acl) != 1 {
+		return nil, fmt.Errorf("Failed to load current ACL for token [%s]. Result set contains more than one ACL", key.token)
+	}
+	return &(*acl)[0], nil
+}
+
+// filterAccessControlList returns a copy of the access control list with the entries of the descriptors,
+// the entries are keyed by the descriptors as passed
+func filterAccessControlList(acl *security.AccessControlList, descriptorList []string) *security.AccessControlList {
+	entries := map[string]security.AccessControlEntry{}
+	if acl.AcesDictionary != nil {
+		byDescriptor := map[string]security.AccessControlEntry{}
+		for descriptor, entry := range *acl.AcesDictionary {
+			byDescriptor[strings.ToLower(descriptor)] = entry
+		}
+		for _, descriptor := range descriptorList {
+			if entry, ok := byDescriptor[strings.ToLower(descriptor)]; ok {
+				entries[descriptor] = entry
+			}
+		}
+	}
+
+	filtered := *acl
+	filtered.AcesDictionary = &entries
+	return &filtered
+}
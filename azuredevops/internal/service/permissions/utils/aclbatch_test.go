@@ -0,0 +1,107 @@
+//go:build (all || utils || securitynamespaces) && !exclude_securitynamespaces
+// +build all utils securitynamespaces
+// +build !exclude_securitynamespaces
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	batchDescriptorBuild   = "Microsoft.TeamFoundation.ServiceIdentity;7774ac03-8a29-44ac-86f1-fa4bded78de2:Build:f609b046-3e4a-419a-a5d7-a0840414dc74"
+	batchDescriptorReaders = "Microsoft.TeamFoundation.Identity;S-1-9-1551374245-4251810032-2399672646-2899062471-1578266062-0-0-0-0-1"
+)
+
+// verifies that concurrent reads of a token share a single request for the complete access control list
+func TestACLBatcher_CoalescesReads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	key := aclKey{securityClient: securityClient, namespaceID: uuid.New(), token: projectAccessToken}
+	securityClient.
+		EXPECT().
+		QueryAccessControlLists(gomock.Any(), security.QueryAccessControlListsArgs{
+			SecurityNamespaceId: &key.namespaceID,
+			Token:               &key.token,
+			IncludeExtendedInfo: converter.Bool(true),
+		}).
+		Return(&projectAccessControlList, nil).
+		Times(1)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			acl, err := aclReads.get(context.Background(), key)
+			assert.Nil(t, err)
+			assert.Equal(t, &projectAccessControlList[0], acl)
+		}()
+	}
+	wg.Wait()
+}
+
+// verifies that the access control list is read again once it was changed
+func TestACLBatcher_Invalidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	key := aclKey{securityClient: securityClient, namespaceID: uuid.New(), token: projectAccessToken}
+	securityClient.
+		EXPECT().
+		QueryAccessControlLists(gomock.Any(), gomock.Any()).
+		Return(&projectAccessControlList, nil).
+		Times(2)
+
+	_, err := aclReads.get(context.Background(), key)
+	assert.Nil(t, err)
+	_, err = aclReads.get(context.Background(), key)
+	assert.Nil(t, err)
+	aclReads.invalidate(key)
+	_, err = aclReads.get(context.Background(), key)
+	assert.Nil(t, err)
+}
+
+func TestACLBatcher_ErrorsNotKept(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	key := aclKey{securityClient: securityClient, namespaceID: uuid.New(), token: projectAccessToken}
+	gomock.InOrder(
+		securityClient.EXPECT().QueryAccessControlLists(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("@@failed@@")).Times(1),
+		securityClient.EXPECT().QueryAccessControlLists(gomock.Any(), gomock.Any()).Return(&projectAccessControlList, nil).Times(1),
+	)
+
+	_, err := aclReads.get(context.Background(), key)
+	assert.EqualError(t, err, "@@failed@@")
+	acl, err := aclReads.get(context.Background(), key)
+	assert.Nil(t, err)
+	assert.NotNil(t, acl)
+}
+
+func TestACLBatcher_FilterAccessControlList(t *testing.T) {
+	unknown := "Microsoft.TeamFoundation.Identity;S-1-9-unknown"
+	acl := filterAccessControlList(&projectAccessControlList[0], []string{batchDescriptorBuild, unknown})
+
+	assert.Len(t, *acl.AcesDictionary, 1)
+	assert.Contains(t, *acl.AcesDictionary, batchDescriptorBuild)
+	assert.Equal(t, projectAccessControlList[0].Token, acl.Token)
+	assert.Len(t, *projectAccessControlList[0].AcesDictionary, 5, "the shared access control list must not be changed")
+
+	acl = filterAccessControlList(&projectAccessControlList[0], []string{batchDescriptorReaders})
+	assert.Equal(t, 112, *(*acl.AcesDictionary)[batchDescriptorReaders].Allow)
+}
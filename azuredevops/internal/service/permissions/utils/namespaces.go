@@ -272,6 +272,20 @@ func (sn *SecurityNamespace) GetAccessControlList(descriptorList *[]string) (*se
 	return &(*acl)[0], nil
 }
 
+// getBatchedAccessControlList returns the entries of the descriptors from the access control list of the token,
+// the access control list is read once for all permission resources using the token
+func (sn *SecurityNamespace) getBatchedAccessControlList(descriptorList []string) (*security.AccessControlList, error) {
+	acl, err := aclReads.get(sn.context, sn.aclKey())
+	if err != nil || acl == nil {
+		return nil, err
+	}
+	return filterAccessControlList(acl, descriptorList), nil
+}
+
+func (sn *SecurityNamespace) aclKey() aclKey {
+	return aclKey{securityClient: sn.securityClient, namespaceID: sn.namespaceID, token: sn.token}
+}
+
 func (sn *SecurityNamespace) getIdentitiesFromSubjects(principal *[]string) (*[]identity.Identity, error) {
 	if principal == nil || len(*principal) <= 0 {
 		return nil, fmt.Errorf("principal is nil or empty")
@@ -304,6 +318,9 @@ func (sn *SecurityNamespace) SetPrincipalPermissions(permissionList *[]SetPrinci
 		return nil
 	}
 
+	// the permission resources read the access control list again once it was changed
+	defer aclReads.invalidate(sn.aclKey())
+
 	permissionMap := map[string]SetPrincipalPermission{}
 	linq.From(*permissionList).
 		ToMapBy(&permissionMap,
@@ -442,7 +459,7 @@ func (sn *SecurityNamespace) GetPrincipalPermissions(principal *[]string) (*[]Pr
 			return *elem.(identity.Identity).Descriptor
 		}).
 		ToSlice(&descriptorList)
-	acl, err := sn.GetAccessControlList(&descriptorList)
+	acl, err := sn.getBatchedAccessControlList(descriptorList)
 	if err != nil {
 		return nil, err
 	}
@@ -511,6 +528,7 @@ func (sn *SecurityNamespace) RemovePrincipalPermissions(principal *[]string) err
 		}).(string)
 
 	log.Printf("[TRACE]RemovePrincipalPermissions: removing the following principals from the ACL %s", val)
+	defer aclReads.invalidate(sn.aclKey())
 	bRet, err := sn.securityClient.RemoveAccessControlEntries(sn.context, security.RemoveAccessControlEntriesArgs{
 		SecurityNamespaceId: &sn.namespaceID,
 		Token:               &sn.token,
@@ -0,0 +1,1358 @@
+package release
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/release"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/validate"
+)
+
+const (
+	rdVariable              = "variable"
+	rdVariableName          = "name"
+	rdVariableValue         = "value"
+	rdSecretVariableValue   = "secret_value"
+	rdVariableIsSecret      = "is_secret"
+	rdVariableAllowOverride = "allow_override"
+
+	artifactTypeBuild = "Build"
+	artifactTypeGit   = "Git"
+
+	// environmentStateSucceeded is the value of an `environmentState` condition that waits for the
+	// referenced stage to complete successfully
+	environmentStateSucceeded = "4"
+	releaseStartedEvent       = "ReleaseStarted"
+)
+
+var scheduleDays = []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
+var scheduleDaysToRelease = map[string]string{
+	"Mon": "monday",
+	"Tue": "tuesday",
+	"Wed": "wednesday",
+	"Thu": "thursday",
+	"Fri": "friday",
+	"Sat": "saturday",
+	"Sun": "sunday",
+}
+
+// ResourceReleaseDefinition schema and implementation for classic release definition resource
+func ResourceReleaseDefinition() *schema.Resource {
+	taskSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"task_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"condition": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "succeeded()",
+			},
+			"continue_on_error": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"inputs": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+
+	approvalSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"approvers": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.IsUUID,
+					},
+				},
+				"required_approver_count": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"timeout_in_minutes": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 365*24*60),
+				},
+				"execution_order": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  string(release.ApprovalExecutionOrderValues.BeforeGates),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(release.ApprovalExecutionOrderValues.BeforeGates),
+						string(release.ApprovalExecutionOrderValues.AfterSuccessfulGates),
+						string(release.ApprovalExecutionOrderValues.AfterGatesAlways),
+					}, false),
+				},
+				"release_creator_can_be_approver": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+
+	gateSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timeout_in_minutes": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1440,
+					ValidateFunc: validation.IntAtLeast(6),
+				},
+				"sampling_interval_in_minutes": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      15,
+					ValidateFunc: validation.IntAtLeast(5),
+				},
+				"stabilization_time_in_minutes": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      5,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"minimum_success_duration_in_minutes": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"task": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem:     taskSchema,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create:   resourceReleaseDefinitionCreate,
+		Read:     resourceReleaseDefinitionRead,
+		Update:   resourceReleaseDefinitionUpdate,
+		Delete:   resourceReleaseDefinitionDelete,
+		Importer: tfhelper.ImportProjectQualifiedResourceInteger(),
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsUUID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+			"revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      `\`,
+				ValidateFunc: validate.Path,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"release_name_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Release-$(rev:r)",
+			},
+			"variable_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+			rdVariable: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						rdVariableName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						rdVariableValue: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						rdSecretVariableValue: {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+							Default:   "",
+						},
+						rdVariableIsSecret: {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						rdVariableAllowOverride: {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"artifact": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{artifactTypeBuild, artifactTypeGit}, false),
+						},
+						"is_primary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"project_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     validation.IsUUID,
+							DiffSuppressFunc: suppress.CaseDifference,
+						},
+						"build_definition_id": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"repository_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"default_branch": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"default_version_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "latestType",
+							ValidateFunc: validation.StringInSlice([]string{
+								"latestType",
+								"latestFromBranchType",
+								"specificVersionType",
+								"selectDuringReleaseCreationType",
+							}, false),
+						},
+					},
+				},
+			},
+			"continuous_deployment_trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"artifact_alias": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"branch_filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+					},
+				},
+			},
+			"schedule_trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days_to_release": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(scheduleDays, false),
+							},
+						},
+						"start_hours": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"start_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+						"time_zone_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "UTC",
+						},
+						"schedule_only_with_changes": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"stage": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"owner_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"manual_only": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"trigger_after_stages": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotWhiteSpace,
+							},
+						},
+						"variable_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+						"variables": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"retention_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days_to_keep": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      30,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"releases_to_keep": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      3,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"retain_build": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+								},
+							},
+						},
+						"pre_deploy_approval":  approvalSchema,
+						"post_deploy_approval": approvalSchema,
+						"pre_deploy_gate":      gateSchema,
+						"post_deploy_gate":     gateSchema,
+						"job": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+									"queue_id": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"condition": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "succeeded()",
+									},
+									"timeout_in_minutes": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      0,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"skip_artifacts_download": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"task": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     taskSchema,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceReleaseDefinitionCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	releaseDefinition, projectID, err := expandReleaseDefinition(d)
+	if err != nil {
+		return fmt.Errorf(" failed to expand release definition: %+v", err)
+	}
+
+	createdReleaseDefinition, err := clients.ReleaseClient.CreateReleaseDefinition(clients.Ctx, release.CreateReleaseDefinitionArgs{
+		ReleaseDefinition: releaseDefinition,
+		Project:           &projectID,
+	})
+	if err != nil {
+		return fmt.Errorf(" failed to create release definition: %+v", err)
+	}
+
+	d.SetId(strconv.Itoa(*createdReleaseDefinition.Id))
+	return resourceReleaseDefinitionRead(d, m)
+}
+
+func resourceReleaseDefinitionRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID, releaseDefinitionID, err := tfhelper.ParseProjectIDAndResourceID(d)
+	if err != nil {
+		return err
+	}
+
+	releaseDefinition, err := clients.ReleaseClient.GetReleaseDefinition(clients.Ctx, release.GetReleaseDefinitionArgs{
+		Project:      &projectID,
+		DefinitionId: &releaseDefinitionID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" failed to read release definition %d: %+v", releaseDefinitionID, err)
+	}
+
+	if releaseDefinition.IsDeleted != nil && *releaseDefinition.IsDeleted {
+		d.SetId("")
+		return nil
+	}
+
+	return flattenReleaseDefinition(d, releaseDefinition, projectID)
+}
+
+func resourceReleaseDefinitionUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	releaseDefinition, projectID, err := expandReleaseDefinition(d)
+	if err != nil {
+		return fmt.Errorf(" failed to expand release definition: %+v", err)
+	}
+
+	_, err = clients.ReleaseClient.UpdateReleaseDefinition(clients.Ctx, release.UpdateReleaseDefinitionArgs{
+		ReleaseDefinition: releaseDefinition,
+		Project:           &projectID,
+	})
+	if err != nil {
+		return fmt.Errorf(" failed to update release definition: %+v", err)
+	}
+
+	return resourceReleaseDefinitionRead(d, m)
+}
+
+func resourceReleaseDefinitionDelete(d *schema.ResourceData, m interface{}) error {
+	if strings.EqualFold(d.Id(), "") {
+		return nil
+	}
+
+	clients := m.(*client.AggregatedClient)
+	projectID, releaseDefinitionID, err := tfhelper.ParseProjectIDAndResourceID(d)
+	if err != nil {
+		return err
+	}
+
+	err = clients.ReleaseClient.DeleteReleaseDefinition(clients.Ctx, release.DeleteReleaseDefinitionArgs{
+		Project:      &projectID,
+		DefinitionId: &releaseDefinitionID,
+		ForceDelete:  converter.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf(" failed to delete release definition %d: %+v", releaseDefinitionID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandReleaseDefinition(d *schema.ResourceData) (*release.ReleaseDefinition, string, error) {
+	projectID := d.Get("project_id").(string)
+
+	variables, err := expandReleaseVariables(d)
+	if err != nil {
+		return nil, "", err
+	}
+
+	artifacts, err := expandReleaseArtifacts(d.Get("artifact").([]interface{}), projectID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	environments, err := expandReleaseEnvironments(d.Get("stage").([]interface{}))
+	if err != nil {
+		return nil, "", err
+	}
+
+	triggers := expandReleaseTriggers(d)
+	releaseDefinition := &release.ReleaseDefinition{
+		Name:              converter.String(d.Get("name").(string)),
+		Path:              converter.String(d.Get("path").(string)),
+		Description:       converter.String(d.Get("description").(string)),
+		ReleaseNameFormat: converter.String(d.Get("release_name_format").(string)),
+		VariableGroups:    expandIntSet(d.Get("variable_groups").(*schema.Set)),
+		Variables:         variables,
+		Artifacts:         artifacts,
+		Environments:      environments,
+		Triggers:          &triggers,
+	}
+
+	// The ID and revision only exist once the definition has been created. The revision is used by
+	// the service for optimistic concurrency, so it has to be sent back on update.
+	if d.Id() != "" {
+		id, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return nil, "", fmt.Errorf(" release definition ID was unexpectedly not a valid integer: %+v", err)
+		}
+		releaseDefinition.Id = &id
+		releaseDefinition.Revision = converter.Int(d.Get("revision").(int))
+	}
+
+	return releaseDefinition, projectID, nil
+}
+
+func expandIntSet(set *schema.Set) *[]int {
+	values := make([]int, 0, set.Len())
+	for _, v := range set.List() {
+		values = append(values, v.(int))
+	}
+	sort.Ints(values)
+	return &values
+}
+
+func expandReleaseVariables(d *schema.ResourceData) (*map[string]release.ConfigurationVariableValue, error) {
+	variables := map[string]release.ConfigurationVariableValue{}
+	for _, variable := range d.Get(rdVariable).(*schema.Set).List() {
+		varAsMap := variable.(map[string]interface{})
+		varName := varAsMap[rdVariableName].(string)
+
+		if _, ok := variables[varName]; ok {
+			return nil, fmt.Errorf(" unexpectedly found duplicate variable with name %s", varName)
+		}
+
+		isSecret := varAsMap[rdVariableIsSecret].(bool)
+		value := varAsMap[rdVariableValue].(string)
+		if isSecret {
+			value = varAsMap[rdSecretVariableValue].(string)
+		}
+
+		variables[varName] = release.ConfigurationVariableValue{
+			AllowOverride: converter.Bool(varAsMap[rdVariableAllowOverride].(bool)),
+			IsSecret:      converter.Bool(isSecret),
+			Value:         converter.String(value),
+		}
+	}
+	return &variables, nil
+}
+
+func expandReleaseArtifacts(configured []interface{}, projectID string) (*[]release.Artifact, error) {
+	artifacts := make([]release.Artifact, 0, len(configured))
+	for _, raw := range configured {
+		artifact := raw.(map[string]interface{})
+		alias := artifact["alias"].(string)
+		artifactType := artifact["type"].(string)
+
+		sourceProjectID := artifact["project_id"].(string)
+		if sourceProjectID == "" {
+			sourceProjectID = projectID
+		}
+
+		versionType := artifact["default_version_type"].(string)
+		branch := artifact["default_branch"].(string)
+		reference := map[string]release.ArtifactSourceReference{
+			"project":            {Id: converter.String(sourceProjectID)},
+			"defaultVersionType": {Id: converter.String(versionType)},
+		}
+
+		switch artifactType {
+		case artifactTypeBuild:
+			definitionID := artifact["build_definition_id"].(int)
+			if definitionID == 0 {
+				return nil, fmt.Errorf(" artifact %s of type %s requires build_definition_id", alias, artifactType)
+			}
+			reference["definition"] = release.ArtifactSourceReference{Id: converter.String(strconv.Itoa(definitionID))}
+			if branch != "" {
+				reference["defaultVersionBranch"] = release.ArtifactSourceReference{Id: converter.String(branch), Name: converter.String(branch)}
+			}
+		case artifactTypeGit:
+			repositoryID := artifact["repository_id"].(string)
+			if repositoryID == "" {
+				return nil, fmt.Errorf(" artifact %s of type %s requires repository_id", alias, artifactType)
+			}
+			reference["definition"] = release.ArtifactSourceReference{Id: converter.String(repositoryID)}
+			if branch != "" {
+				reference["branches"] = release.ArtifactSourceReference{Id: converter.String(branch), Name: converter.String(branch)}
+			}
+		}
+
+		artifacts = append(artifacts, release.Artifact{
+			Alias:               converter.String(alias),
+			Type:                converter.String(artifactType),
+			IsPrimary:           converter.Bool(artifact["is_primary"].(bool)),
+			DefinitionReference: &reference,
+		})
+	}
+	return &artifacts, nil
+}
+
+func expandReleaseTriggers(d *schema.ResourceData) []interface{} {
+	triggers := []interface{}{}
+	for _, raw := range d.Get("continuous_deployment_trigger").([]interface{}) {
+		trigger := raw.(map[string]interface{})
+		conditions := []release.ArtifactFilter{}
+		for _, branch := range tfhelper.ExpandStringList(trigger["branch_filter"].([]interface{})) {
+			conditions = append(conditions, release.ArtifactFilter{
+				SourceBranch:             converter.String(branch),
+				UseBuildDefinitionBranch: converter.Bool(false),
+			})
+		}
+		triggers = append(triggers, release.ArtifactSourceTrigger{
+			TriggerType:       &release.ReleaseTriggerTypeValues.ArtifactSource,
+			ArtifactAlias:     converter.String(trigger["artifact_alias"].(string)),
+			TriggerConditions: &conditions,
+		})
+	}
+
+	for _, raw := range d.Get("schedule_trigger").([]interface{}) {
+		trigger := raw.(map[string]interface{})
+		triggers = append(triggers, release.ScheduledReleaseTrigger{
+			TriggerType: &release.ReleaseTriggerTypeValues.Schedule,
+			Schedule:    expandReleaseSchedule(trigger),
+		})
+	}
+	return triggers
+}
+
+func expandReleaseSchedule(schedule map[string]interface{}) *release.ReleaseSchedule {
+	days := make([]string, 0, len(scheduleDays))
+	for _, day := range tfhelper.ExpandStringList(schedule["days_to_release"].([]interface{})) {
+		days = append(days, scheduleDaysToRelease[day])
+	}
+	daysToRelease := release.ScheduleDays(strings.Join(days, ", "))
+	return &release.ReleaseSchedule{
+		DaysToRelease:           &daysToRelease,
+		StartHours:              converter.Int(schedule["start_hours"].(int)),
+		StartMinutes:            converter.Int(schedule["start_minutes"].(int)),
+		TimeZoneId:              converter.String(schedule["time_zone_id"].(string)),
+		ScheduleOnlyWithChanges: converter.Bool(schedule["schedule_only_with_changes"].(bool)),
+	}
+}
+
+func expandReleaseEnvironments(configured []interface{}) (*[]release.ReleaseDefinitionEnvironment, error) {
+	environments := make([]release.ReleaseDefinitionEnvironment, 0, len(configured))
+	stageNames := map[string]bool{}
+	for _, raw := range configured {
+		stageNames[raw.(map[string]interface{})["name"].(string)] = true
+	}
+
+	for i, raw := range configured {
+		stage := raw.(map[string]interface{})
+		name := stage["name"].(string)
+
+		conditions, err := expandReleaseEnvironmentConditions(stage, stageNames)
+		if err != nil {
+			return nil, err
+		}
+
+		variables := map[string]release.ConfigurationVariableValue{}
+		for k, v := range stage["variables"].(map[string]interface{}) {
+			variables[k] = release.ConfigurationVariableValue{Value: converter.String(v.(string))}
+		}
+
+		environment := release.ReleaseDefinitionEnvironment{
+			Name:                converter.String(name),
+			Rank:                converter.Int(i + 1),
+			Owner:               &webapi.IdentityRef{Id: converter.String(stage["owner_id"].(string))},
+			Conditions:          conditions,
+			VariableGroups:      expandIntSet(stage["variable_groups"].(*schema.Set)),
+			Variables:           &variables,
+			DeployPhases:        expandReleaseDeployPhases(stage["job"].([]interface{})),
+			PreDeployApprovals:  expandReleaseApprovals(stage["pre_deploy_approval"].([]interface{})),
+			PostDeployApprovals: expandReleaseApprovals(stage["post_deploy_approval"].([]interface{})),
+			PreDeploymentGates:  expandReleaseGates(stage["pre_deploy_gate"].([]interface{})),
+			PostDeploymentGates: expandReleaseGates(stage["post_deploy_gate"].([]interface{})),
+			RetentionPolicy:     expandReleaseRetentionPolicy(stage["retention_policy"].([]interface{})),
+			DeployStep:          &release.ReleaseDefinitionDeployStep{},
+			EnvironmentOptions:  &release.EnvironmentOptions{},
+			ExecutionPolicy: &release.EnvironmentExecutionPolicy{
+				ConcurrencyCount: converter.Int(1),
+				QueueDepthCount:  converter.Int(0),
+			},
+		}
+		if id, ok := stage["id"].(int); ok && id > 0 {
+			environment.Id = converter.Int(id)
+		}
+		environments = append(environments, environment)
+	}
+	return &environments, nil
+}
+
+func expandReleaseEnvironmentConditions(stage map[string]interface{}, stageNames map[string]bool) (*[]release.Condition, error) {
+	conditions := []release.Condition{}
+	if stage["manual_only"].(bool) {
+		return &conditions, nil
+	}
+
+	afterStages := tfhelper.ExpandStringList(stage["trigger_after_stages"].([]interface{}))
+	if len(afterStages) == 0 {
+		conditions = append(conditions, release.Condition{
+			ConditionType: &release.ConditionTypeValues.Event,
+			Name:          converter.String(releaseStartedEvent),
+			Value:         converter.String(""),
+		})
+		return &conditions, nil
+	}
+
+	for _, afterStage := range afterStages {
+		if !stageNames[afterStage] {
+			return nil, fmt.Errorf(" stage %s is triggered after unknown stage %s", stage["name"], afterStage)
+		}
+		conditions = append(conditions, release.Condition{
+			ConditionType: &release.ConditionTypeValues.EnvironmentState,
+			Name:          converter.String(afterStage),
+			Value:         converter.String(environmentStateSucceeded),
+		})
+	}
+	return &conditions, nil
+}
+
+// expandReleaseApprovals returns an automated approval step when no approvers are configured,
+// as the service requires every stage to carry at least one approval step
+func expandReleaseApprovals(configured []interface{}) *release.ReleaseDefinitionApprovals {
+	if len(configured) == 0 || configured[0] == nil {
+		return &release.ReleaseDefinitionApprovals{
+			Approvals: &[]release.ReleaseDefinitionApprovalStep{{
+				Rank:             converter.Int(1),
+				IsAutomated:      converter.Bool(true),
+				IsNotificationOn: converter.Bool(false),
+			}},
+			ApprovalOptions: &release.ApprovalOptions{
+				ExecutionOrder: &release.ApprovalExecutionOrderValues.BeforeGates,
+			},
+		}
+	}
+
+	approval := configured[0].(map[string]interface{})
+	approvers := tfhelper.ExpandStringList(approval["approvers"].([]interface{}))
+	steps := make([]release.ReleaseDefinitionApprovalStep, 0, len(approvers))
+	for i, approver := range approvers {
+		steps = append(steps, release.ReleaseDefinitionApprovalStep{
+			Rank:             converter.Int(i + 1),
+			IsAutomated:      converter.Bool(false),
+			IsNotificationOn: converter.Bool(false),
+			Approver:         &webapi.IdentityRef{Id: converter.String(approver)},
+		})
+	}
+
+	executionOrder := release.ApprovalExecutionOrder(approval["execution_order"].(string))
+	return &release.ReleaseDefinitionApprovals{
+		Approvals: &steps,
+		ApprovalOptions: &release.ApprovalOptions{
+			RequiredApproverCount:       converter.Int(approval["required_approver_count"].(int)),
+			TimeoutInMinutes:            converter.Int(approval["timeout_in_minutes"].(int)),
+			ReleaseCreatorCanBeApprover: converter.Bool(approval["release_creator_can_be_approver"].(bool)),
+			ExecutionOrder:              &executionOrder,
+		},
+	}
+}
+
+func expandReleaseGates(configured []interface{}) *release.ReleaseDefinitionGatesStep {
+	if len(configured) == 0 || configured[0] == nil {
+		return &release.ReleaseDefinitionGatesStep{
+			Gates: &[]release.ReleaseDefinitionGate{},
+		}
+	}
+
+	gate := configured[0].(map[string]interface{})
+	tasks := expandReleaseWorkflowTasks(gate["task"].([]interface{}))
+	return &release.ReleaseDefinitionGatesStep{
+		Gates: &[]release.ReleaseDefinitionGate{{Tasks: tasks}},
+		GatesOptions: &release.ReleaseDefinitionGatesOptions{
+			IsEnabled:              converter.Bool(true),
+			Timeout:                converter.Int(gate["timeout_in_minutes"].(int)),
+			SamplingInterval:       converter.Int(gate["sampling_interval_in_minutes"].(int)),
+			StabilizationTime:      converter.Int(gate["stabilization_time_in_minutes"].(int)),
+			MinimumSuccessDuration: converter.Int(gate["minimum_success_duration_in_minutes"].(int)),
+		},
+	}
+}
+
+func expandReleaseRetentionPolicy(configured []interface{}) *release.EnvironmentRetentionPolicy {
+	if len(configured) == 0 || configured[0] == nil {
+		return &release.EnvironmentRetentionPolicy{
+			DaysToKeep:     converter.Int(30),
+			ReleasesToKeep: converter.Int(3),
+			RetainBuild:    converter.Bool(true),
+		}
+	}
+
+	policy := configured[0].(map[string]interface{})
+	return &release.EnvironmentRetentionPolicy{
+		DaysToKeep:     converter.Int(policy["days_to_keep"].(int)),
+		ReleasesToKeep: converter.Int(policy["releases_to_keep"].(int)),
+		RetainBuild:    converter.Bool(policy["retain_build"].(bool)),
+	}
+}
+
+func expandReleaseDeployPhases(configured []interface{}) *[]interface{} {
+	phases := make([]interface{}, 0, len(configured))
+	for i, raw := range configured {
+		job := raw.(map[string]interface{})
+		phases = append(phases, release.AgentBasedDeployPhase{
+			Name:          converter.String(job["name"].(string)),
+			Rank:          converter.Int(i + 1),
+			PhaseType:     &release.DeployPhaseTypesValues.AgentBasedDeployment,
+			WorkflowTasks: expandReleaseWorkflowTasks(job["task"].([]interface{})),
+			DeploymentInput: &release.AgentDeploymentInput{
+				QueueId:               converter.Int(job["queue_id"].(int)),
+				Condition:             converter.String(job["condition"].(string)),
+				TimeoutInMinutes:      converter.Int(job["timeout_in_minutes"].(int)),
+				SkipArtifactsDownload: converter.Bool(job["skip_artifacts_download"].(bool)),
+			},
+		})
+	}
+	return &phases
+}
+
+func expandReleaseWorkflowTasks(configured []interface{}) *[]release.WorkflowTask {
+	tasks := make([]release.WorkflowTask, 0, len(configured))
+	for _, raw := range configured {
+		task := raw.(map[string]interface{})
+		inputs := map[string]string{}
+		for k, v := range task["inputs"].(map[string]interface{}) {
+			inputs[k] = v.(string)
+		}
+		tasks = append(tasks, release.WorkflowTask{
+			TaskId:           converter.UUID(task["task_id"].(string)),
+			Version:          converter.String(task["version"].(string)),
+			Name:             converter.String(task["name"].(string)),
+			Enabled:          converter.Bool(task["enabled"].(bool)),
+			Condition:        converter.String(task["condition"].(string)),
+			ContinueOnError:  converter.Bool(task["continue_on_error"].(bool)),
+			TimeoutInMinutes: converter.Int(task["timeout_in_minutes"].(int)),
+			DefinitionType:   converter.String("task"),
+			Inputs:           &inputs,
+		})
+	}
+	return &tasks
+}
+
+func flattenReleaseDefinition(d *schema.ResourceData, releaseDefinition *release.ReleaseDefinition, projectID string) error {
+	d.SetId(strconv.Itoa(*releaseDefinition.Id))
+	d.Set("project_id", projectID)
+	d.Set("name", converter.ToString(releaseDefinition.Name, ""))
+	d.Set("path", converter.ToString(releaseDefinition.Path, `\`))
+	d.Set("description", converter.ToString(releaseDefinition.Description, ""))
+	d.Set("release_name_format", converter.ToString(releaseDefinition.ReleaseNameFormat, ""))
+
+	revision := 0
+	if releaseDefinition.Revision != nil {
+		revision = *releaseDefinition.Revision
+	}
+	d.Set("revision", revision)
+
+	if releaseDefinition.VariableGroups != nil {
+		d.Set("variable_groups", *releaseDefinition.VariableGroups)
+	}
+	if err := d.Set(rdVariable, flattenReleaseVariables(d, releaseDefinition.Variables)); err != nil {
+		return fmt.Errorf(" failed to set variable: %+v", err)
+	}
+	if err := d.Set("artifact", flattenReleaseArtifacts(releaseDefinition.Artifacts)); err != nil {
+		return fmt.Errorf(" failed to set artifact: %+v", err)
+	}
+
+	cdTriggers, scheduleTriggers, err := flattenReleaseTriggers(releaseDefinition.Triggers)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("continuous_deployment_trigger", cdTriggers); err != nil {
+		return fmt.Errorf(" failed to set continuous_deployment_trigger: %+v", err)
+	}
+	if err := d.Set("schedule_trigger", scheduleTriggers); err != nil {
+		return fmt.Errorf(" failed to set schedule_trigger: %+v", err)
+	}
+
+	stages, err := flattenReleaseEnvironments(releaseDefinition.Environments)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("stage", stages); err != nil {
+		return fmt.Errorf(" failed to set stage: %+v", err)
+	}
+	return nil
+}
+
+// flattenReleaseVariables ensures that secrets, for which values will not be returned by the service,
+// are not overidden with null or empty values
+func flattenReleaseVariables(d *schema.ResourceData, variables *map[string]release.ConfigurationVariableValue) []interface{} {
+	if variables == nil {
+		return nil
+	}
+
+	results := make([]interface{}, 0, len(*variables))
+	for name, value := range *variables {
+		isSecret := converter.ToBool(value.IsSecret, false)
+		variable := map[string]interface{}{
+			rdVariableName:          name,
+			rdVariableValue:         converter.ToString(value.Value, ""),
+			rdVariableIsSecret:      isSecret,
+			rdVariableAllowOverride: converter.ToBool(value.AllowOverride, false),
+		}
+
+		if isSecret {
+			if stateVal := tfhelper.FindMapInSetWithGivenKeyValue(d, rdVariable, rdVariableName, name); stateVal != nil {
+				variable = stateVal
+			}
+		}
+		results = append(results, variable)
+	}
+	return results
+}
+
+func flattenReleaseArtifacts(artifacts *[]release.Artifact) []interface{} {
+	if artifacts == nil {
+		return nil
+	}
+
+	results := make([]interface{}, 0, len(*artifacts))
+	for _, artifact := range *artifacts {
+		artifactType := converter.ToString(artifact.Type, "")
+		result := map[string]interface{}{
+			"alias":      converter.ToString(artifact.Alias, ""),
+			"type":       artifactType,
+			"is_primary": converter.ToBool(artifact.IsPrimary, false),
+		}
+
+		if artifact.DefinitionReference != nil {
+			reference := *artifact.DefinitionReference
+			result["project_id"] = artifactReferenceID(reference, "project")
+			result["default_version_type"] = artifactReferenceID(reference, "defaultVersionType")
+
+			switch artifactType {
+			case artifactTypeBuild:
+				if definitionID, err := strconv.Atoi(artifactReferenceID(reference, "definition")); err == nil {
+					result["build_definition_id"] = definitionID
+				}
+				result["default_branch"] = artifactReferenceID(reference, "defaultVersionBranch")
+			case artifactTypeGit:
+				result["repository_id"] = artifactReferenceID(reference, "definition")
+				result["default_branch"] = artifactReferenceID(reference, "branches")
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func artifactReferenceID(reference map[string]release.ArtifactSourceReference, key string) string {
+	if value, ok := reference[key]; ok {
+		return converter.ToString(value.Id, "")
+	}
+	return ""
+}
+
+// releaseTrigger is the union of all trigger types supported by this resource. Triggers are
+// deserialized into `interface{}` by the SDK, so they are converted through JSON.
+type releaseTrigger struct {
+	TriggerType       *release.ReleaseTriggerType `json:"triggerType,omitempty"`
+	ArtifactAlias     *string                     `json:"artifactAlias,omitempty"`
+	TriggerConditions *[]release.ArtifactFilter   `json:"triggerConditions,omitempty"`
+	Schedule          *release.ReleaseSchedule    `json:"schedule,omitempty"`
+}
+
+func flattenReleaseTriggers(triggers *[]interface{}) ([]interface{}, []interface{}, error) {
+	cdTriggers := []interface{}{}
+	scheduleTriggers := []interface{}{}
+	if triggers == nil {
+		return cdTriggers, scheduleTriggers, nil
+	}
+
+	for _, raw := range *triggers {
+		var trigger releaseTrigger
+		if err := converter.ThroughJSON(raw, &trigger); err != nil {
+			return nil, nil, fmt.Errorf(" failed to parse release trigger: %+v", err)
+		}
+		if trigger.TriggerType == nil {
+			continue
+		}
+
+		switch *trigger.TriggerType {
+		case release.ReleaseTriggerTypeValues.ArtifactSource:
+			branches := []string{}
+			if trigger.TriggerConditions != nil {
+				for _, condition := range *trigger.TriggerConditions {
+					if condition.SourceBranch != nil {
+						branches = append(branches, *condition.SourceBranch)
+					}
+				}
+			}
+			cdTriggers = append(cdTriggers, map[string]interface{}{
+				"artifact_alias": converter.ToString(trigger.ArtifactAlias, ""),
+				"branch_filter":  branches,
+			})
+		case release.ReleaseTriggerTypeValues.Schedule:
+			if trigger.Schedule != nil {
+				scheduleTriggers = append(scheduleTriggers, flattenReleaseSchedule(trigger.Schedule))
+			}
+		}
+	}
+	return cdTriggers, scheduleTriggers, nil
+}
+
+func flattenReleaseSchedule(schedule *release.ReleaseSchedule) map[string]interface{} {
+	days := []string{}
+	if schedule.DaysToRelease != nil {
+		released := map[string]bool{}
+		for _, day := range strings.Split(string(*schedule.DaysToRelease), ",") {
+			released[strings.ToLower(strings.TrimSpace(day))] = true
+		}
+		for _, day := range scheduleDays {
+			if released[scheduleDaysToRelease[day]] || released[string(release.ScheduleDaysValues.All)] {
+				days = append(days, day)
+			}
+		}
+	}
+
+	startHours, startMinutes := 0, 0
+	if schedule.StartHours != nil {
+		startHours = *schedule.StartHours
+	}
+	if schedule.StartMinutes != nil {
+		startMinutes = *schedule.StartMinutes
+	}
+	return map[string]interface{}{
+		"days_to_release":            days,
+		"start_hours":                startHours,
+		"start_minutes":              startMinutes,
+		"time_zone_id":               converter.ToString(schedule.TimeZoneId, "UTC"),
+		"schedule_only_with_changes": converter.ToBool(schedule.ScheduleOnlyWithChanges, false),
+	}
+}
+
+func flattenReleaseEnvironments(environments *[]release.ReleaseDefinitionEnvironment) ([]interface{}, error) {
+	if environments == nil {
+		return nil, nil
+	}
+
+	sorted := make([]release.ReleaseDefinitionEnvironment, len(*environments))
+	copy(sorted, *environments)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return converter.ToInt(sorted[i].Rank, 0) < converter.ToInt(sorted[j].Rank, 0)
+	})
+
+	stages := make([]interface{}, 0, len(sorted))
+	for _, environment := range sorted {
+		stage := map[string]interface{}{
+			"name":                 converter.ToString(environment.Name, ""),
+			"pre_deploy_approval":  flattenReleaseApprovals(environment.PreDeployApprovals),
+			"post_deploy_approval": flattenReleaseApprovals(environment.PostDeployApprovals),
+			"pre_deploy_gate":      flattenReleaseGates(environment.PreDeploymentGates),
+			"post_deploy_gate":     flattenReleaseGates(environment.PostDeploymentGates),
+		}
+		if environment.Id != nil {
+			stage["id"] = *environment.Id
+		}
+		if environment.Owner != nil {
+			stage["owner_id"] = converter.ToString(environment.Owner.Id, "")
+		}
+		if environment.VariableGroups != nil {
+			stage["variable_groups"] = *environment.VariableGroups
+		}
+		if environment.Variables != nil {
+			variables := map[string]interface{}{}
+			for k, v := range *environment.Variables {
+				variables[k] = converter.ToString(v.Value, "")
+			}
+			stage["variables"] = variables
+		}
+		if environment.RetentionPolicy != nil {
+			stage["retention_policy"] = []interface{}{map[string]interface{}{
+				"days_to_keep":     converter.ToInt(environment.RetentionPolicy.DaysToKeep, 0),
+				"releases_to_keep": converter.ToInt(environment.RetentionPolicy.ReleasesToKeep, 0),
+				"retain_build":     converter.ToBool(environment.RetentionPolicy.RetainBuild, false),
+			}}
+		}
+
+		manualOnly, afterStages := flattenReleaseEnvironmentConditions(environment.Conditions)
+		stage["manual_only"] = manualOnly
+		stage["trigger_after_stages"] = afterStages
+
+		jobs, err := flattenReleaseDeployPhases(environment.DeployPhases)
+		if err != nil {
+			return nil, err
+		}
+		stage["job"] = jobs
+		stages = append(stages, stage)
+	}
+	return stages, nil
+}
+
+func flattenReleaseEnvironmentConditions(conditions *[]release.Condition) (bool, []string) {
+	afterStages := []string{}
+	if conditions == nil || len(*conditions) == 0 {
+		return true, afterStages
+	}
+
+	for _, condition := range *conditions {
+		if condition.ConditionType != nil && *condition.ConditionType == release.ConditionTypeValues.EnvironmentState {
+			afterStages = append(afterStages, converter.ToString(condition.Name, ""))
+		}
+	}
+	return false, afterStages
+}
+
+func flattenReleaseApprovals(approvals *release.ReleaseDefinitionApprovals) []interface{} {
+	if approvals == nil || approvals.Approvals == nil {
+		return nil
+	}
+
+	steps := make([]release.ReleaseDefinitionApprovalStep, 0, len(*approvals.Approvals))
+	for _, step := range *approvals.Approvals {
+		if converter.ToBool(step.IsAutomated, false) || step.Approver == nil {
+			continue
+		}
+		steps = append(steps, step)
+	}
+	if len(steps) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(steps, func(i, j int) bool {
+		return converter.ToInt(steps[i].Rank, 0) < converter.ToInt(steps[j].Rank, 0)
+	})
+	approvers := make([]string, 0, len(steps))
+	for _, step := range steps {
+		approvers = append(approvers, converter.ToString(step.Approver.Id, ""))
+	}
+
+	result := map[string]interface{}{
+		"approvers": approvers,
+	}
+	if options := approvals.ApprovalOptions; options != nil {
+		result["required_approver_count"] = converter.ToInt(options.RequiredApproverCount, 0)
+		result["timeout_in_minutes"] = converter.ToInt(options.TimeoutInMinutes, 0)
+		result["release_creator_can_be_approver"] = converter.ToBool(options.ReleaseCreatorCanBeApprover, false)
+		if options.ExecutionOrder != nil {
+			result["execution_order"] = string(*options.ExecutionOrder)
+		}
+	}
+	return []interface{}{result}
+}
+
+func flattenReleaseGates(gates *release.ReleaseDefinitionGatesStep) []interface{} {
+	if gates == nil || gates.GatesOptions == nil || !converter.ToBool(gates.GatesOptions.IsEnabled, false) {
+		return nil
+	}
+
+	tasks := []interface{}{}
+	if gates.Gates != nil {
+		for _, gate := range *gates.Gates {
+			tasks = append(tasks, flattenReleaseWorkflowTasks(gate.Tasks)...)
+		}
+	}
+
+	options := gates.GatesOptions
+	return []interface{}{map[string]interface{}{
+		"timeout_in_minutes":                  converter.ToInt(options.Timeout, 0),
+		"sampling_interval_in_minutes":        converter.ToInt(options.SamplingInterval, 0),
+		"stabilization_time_in_minutes":       converter.ToInt(options.StabilizationTime, 0),
+		"minimum_success_duration_in_minutes": converter.ToInt(options.MinimumSuccessDuration, 0),
+		"task":                                tasks,
+	}}
+}
+
+func flattenReleaseDeployPhases(phases *[]interface{}) ([]interface{}, error) {
+	jobs := []interface{}{}
+	if phases == nil {
+		return jobs, nil
+	}
+
+	parsed := make([]release.AgentBasedDeployPhase, 0, len(*phases))
+	for _, raw := range *phases {
+		// Deploy phases are deserialized into `interface{}` by the SDK
+		var phase release.AgentBasedDeployPhase
+		if err := converter.ThroughJSON(raw, &phase); err != nil {
+			return nil, fmt.Errorf(" failed to parse deploy phase: %+v", err)
+		}
+		if phase.PhaseType == nil || *phase.PhaseType != release.DeployPhaseTypesValues.AgentBasedDeployment {
+			continue
+		}
+		parsed = append(parsed, phase)
+	}
+
+	sort.SliceStable(parsed, func(i, j int) bool {
+		return converter.ToInt(parsed[i].Rank, 0) < converter.ToInt(parsed[j].Rank, 0)
+	})
+	for _, phase := range parsed {
+		job := map[string]interface{}{
+			"name": converter.ToString(phase.Name, ""),
+			"task": flattenReleaseWorkflowTasks(phase.WorkflowTasks),
+		}
+		if input := phase.DeploymentInput; input != nil {
+			job["queue_id"] = converter.ToInt(input.QueueId, 0)
+			job["condition"] = converter.ToString(input.Condition, "")
+			job["timeout_in_minutes"] = converter.ToInt(input.TimeoutInMinutes, 0)
+			job["skip_artifacts_download"] = converter.ToBool(input.SkipArtifactsDownload, false)
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func flattenReleaseWorkflowTasks(tasks *[]release.WorkflowTask) []interface{} {
+	results := []interface{}{}
+	if tasks == nil {
+		return results
+	}
+
+	for _, task := range *tasks {
+		taskID := ""
+		if task.TaskId != nil {
+			taskID = task.TaskId.String()
+		}
+		inputs := map[string]interface{}{}
+		if task.Inputs != nil {
+			for k, v := range *task.Inputs {
+				inputs[k] = v
+			}
+		}
+		results = append(results, map[string]interface{}{
+			"task_id":            taskID,
+			"version":            converter.ToString(task.Version, ""),
+			"name":               converter.ToString(task.Name, ""),
+			"enabled":            converter.ToBool(task.Enabled, true),
+			"condition":          converter.ToString(task.Condition, ""),
+			"continue_on_error":  converter.ToBool(task.ContinueOnError, false),
+			"timeout_in_minutes": converter.ToInt(task.TimeoutInMinutes, 0),
+			"inputs":             inputs,
+		})
+	}
+	return results
+}
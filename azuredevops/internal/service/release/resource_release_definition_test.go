@@ -0,0 +1,415 @@
+//go:build (all || resource_release_definition) && !exclude_resource_release_definition
+// +build all resource_release_definition
+// +build !exclude_resource_release_definition
+
+package release
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/release"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testReleaseProjectID = uuid.New().String()
+var testReleaseOwnerID = uuid.New().String()
+var testReleaseApproverID = uuid.New().String()
+var testReleaseRepositoryID = uuid.New().String()
+var testReleaseTaskID = uuid.New()
+
+func testReleaseWorkflowTasks() *[]release.WorkflowTask {
+	return &[]release.WorkflowTask{{
+		TaskId:           &testReleaseTaskID,
+		Version:          converter.String("2.*"),
+		Name:             converter.String("Run script"),
+		Enabled:          converter.Bool(true),
+		Condition:        converter.String("succeeded()"),
+		ContinueOnError:  converter.Bool(false),
+		TimeoutInMinutes: converter.Int(0),
+		DefinitionType:   converter.String("task"),
+		Inputs:           &map[string]string{"script": "echo hello"},
+	}}
+}
+
+// This definition matches the structure produced by expanding a release definition with two stages,
+// a build artifact, a git artifact and both kinds of triggers
+func testReleaseDefinition() release.ReleaseDefinition {
+	artifactSource := release.ReleaseTriggerTypeValues.ArtifactSource
+	schedule := release.ReleaseTriggerTypeValues.Schedule
+	days := release.ScheduleDays("monday, friday")
+	afterGates := release.ApprovalExecutionOrderValues.AfterSuccessfulGates
+
+	return release.ReleaseDefinition{
+		Id:                converter.Int(10),
+		Revision:          converter.Int(3),
+		Name:              converter.String("Name"),
+		Path:              converter.String(`\`),
+		Description:       converter.String("Description"),
+		ReleaseNameFormat: converter.String("Release-$(rev:r)"),
+		VariableGroups:    &[]int{1, 2},
+		Variables: &map[string]release.ConfigurationVariableValue{
+			"foo": {
+				Value:         converter.String("bar"),
+				IsSecret:      converter.Bool(false),
+				AllowOverride: converter.Bool(true),
+			},
+		},
+		Artifacts: &[]release.Artifact{
+			{
+				Alias:     converter.String("_build"),
+				Type:      converter.String(artifactTypeBuild),
+				IsPrimary: converter.Bool(true),
+				DefinitionReference: &map[string]release.ArtifactSourceReference{
+					"project":              {Id: converter.String(testReleaseProjectID)},
+					"defaultVersionType":   {Id: converter.String("latestFromBranchType")},
+					"definition":           {Id: converter.String("42")},
+					"defaultVersionBranch": {Id: converter.String("refs/heads/main"), Name: converter.String("refs/heads/main")},
+				},
+			},
+			{
+				Alias:     converter.String("_repo"),
+				Type:      converter.String(artifactTypeGit),
+				IsPrimary: converter.Bool(false),
+				DefinitionReference: &map[string]release.ArtifactSourceReference{
+					"project":            {Id: converter.String(testReleaseProjectID)},
+					"defaultVersionType": {Id: converter.String("latestType")},
+					"definition":         {Id: converter.String(testReleaseRepositoryID)},
+				},
+			},
+		},
+		Triggers: &[]interface{}{
+			release.ArtifactSourceTrigger{
+				TriggerType:   &artifactSource,
+				ArtifactAlias: converter.String("_build"),
+				TriggerConditions: &[]release.ArtifactFilter{{
+					SourceBranch:             converter.String("refs/heads/main"),
+					UseBuildDefinitionBranch: converter.Bool(false),
+				}},
+			},
+			release.ScheduledReleaseTrigger{
+				TriggerType: &schedule,
+				Schedule: &release.ReleaseSchedule{
+					DaysToRelease:           &days,
+					StartHours:              converter.Int(3),
+					StartMinutes:            converter.Int(30),
+					TimeZoneId:              converter.String("UTC"),
+					ScheduleOnlyWithChanges: converter.Bool(true),
+				},
+			},
+		},
+		Environments: &[]release.ReleaseDefinitionEnvironment{
+			{
+				Id:    converter.Int(1),
+				Name:  converter.String("dev"),
+				Rank:  converter.Int(1),
+				Owner: &webapi.IdentityRef{Id: converter.String(testReleaseOwnerID)},
+				Conditions: &[]release.Condition{{
+					ConditionType: &release.ConditionTypeValues.Event,
+					Name:          converter.String(releaseStartedEvent),
+					Value:         converter.String(""),
+				}},
+				VariableGroups: &[]int{},
+				Variables: &map[string]release.ConfigurationVariableValue{
+					"env": {Value: converter.String("dev")},
+				},
+				DeployPhases: &[]interface{}{
+					release.AgentBasedDeployPhase{
+						Name:          converter.String("Agent job"),
+						Rank:          converter.Int(1),
+						PhaseType:     &release.DeployPhaseTypesValues.AgentBasedDeployment,
+						WorkflowTasks: testReleaseWorkflowTasks(),
+						DeploymentInput: &release.AgentDeploymentInput{
+							QueueId:               converter.Int(7),
+							Condition:             converter.String("succeeded()"),
+							TimeoutInMinutes:      converter.Int(60),
+							SkipArtifactsDownload: converter.Bool(false),
+						},
+					},
+				},
+				PreDeployApprovals:  expandReleaseApprovals(nil),
+				PostDeployApprovals: expandReleaseApprovals(nil),
+				PreDeploymentGates:  expandReleaseGates(nil),
+				PostDeploymentGates: expandReleaseGates(nil),
+				RetentionPolicy: &release.EnvironmentRetentionPolicy{
+					DaysToKeep:     converter.Int(30),
+					ReleasesToKeep: converter.Int(3),
+					RetainBuild:    converter.Bool(true),
+				},
+				DeployStep:         &release.ReleaseDefinitionDeployStep{},
+				EnvironmentOptions: &release.EnvironmentOptions{},
+				ExecutionPolicy: &release.EnvironmentExecutionPolicy{
+					ConcurrencyCount: converter.Int(1),
+					QueueDepthCount:  converter.Int(0),
+				},
+			},
+			{
+				Id:    converter.Int(2),
+				Name:  converter.String("prod"),
+				Rank:  converter.Int(2),
+				Owner: &webapi.IdentityRef{Id: converter.String(testReleaseOwnerID)},
+				Conditions: &[]release.Condition{{
+					ConditionType: &release.ConditionTypeValues.EnvironmentState,
+					Name:          converter.String("dev"),
+					Value:         converter.String(environmentStateSucceeded),
+				}},
+				VariableGroups: &[]int{3},
+				Variables:      &map[string]release.ConfigurationVariableValue{},
+				DeployPhases:   &[]interface{}{},
+				PreDeployApprovals: &release.ReleaseDefinitionApprovals{
+					Approvals: &[]release.ReleaseDefinitionApprovalStep{{
+						Rank:             converter.Int(1),
+						IsAutomated:      converter.Bool(false),
+						IsNotificationOn: converter.Bool(false),
+						Approver:         &webapi.IdentityRef{Id: converter.String(testReleaseApproverID)},
+					}},
+					ApprovalOptions: &release.ApprovalOptions{
+						RequiredApproverCount:       converter.Int(1),
+						TimeoutInMinutes:            converter.Int(120),
+						ReleaseCreatorCanBeApprover: converter.Bool(false),
+						ExecutionOrder:              &afterGates,
+					},
+				},
+				PostDeployApprovals: expandReleaseApprovals(nil),
+				PreDeploymentGates: &release.ReleaseDefinitionGatesStep{
+					Gates: &[]release.ReleaseDefinitionGate{{Tasks: testReleaseWorkflowTasks()}},
+					GatesOptions: &release.ReleaseDefinitionGatesOptions{
+						IsEnabled:              converter.Bool(true),
+						Timeout:                converter.Int(1440),
+						SamplingInterval:       converter.Int(15),
+						StabilizationTime:      converter.Int(5),
+						MinimumSuccessDuration: converter.Int(0),
+					},
+				},
+				PostDeploymentGates: expandReleaseGates(nil),
+				RetentionPolicy: &release.EnvironmentRetentionPolicy{
+					DaysToKeep:     converter.Int(60),
+					ReleasesToKeep: converter.Int(5),
+					RetainBuild:    converter.Bool(false),
+				},
+				DeployStep:         &release.ReleaseDefinitionDeployStep{},
+				EnvironmentOptions: &release.EnvironmentOptions{},
+				ExecutionPolicy: &release.EnvironmentExecutionPolicy{
+					ConcurrencyCount: converter.Int(1),
+					QueueDepthCount:  converter.Int(0),
+				},
+			},
+		},
+	}
+}
+
+// verifies that the flatten/expand round trip works as expected
+func TestReleaseDefinition_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceReleaseDefinition().Schema, nil)
+	releaseDefinition := testReleaseDefinition()
+
+	err := flattenReleaseDefinition(resourceData, &releaseDefinition, testReleaseProjectID)
+	require.Nil(t, err)
+
+	releaseDefinitionAfterRoundTrip, projectID, err := expandReleaseDefinition(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, testReleaseProjectID, projectID)
+	require.Equal(t, releaseDefinition, *releaseDefinitionAfterRoundTrip)
+}
+
+// verifies that deploy phases and triggers returned by the service, which are deserialized into
+// generic maps by the SDK, are flattened in the same way as the typed values
+func TestReleaseDefinition_Flatten_HandlesDeserializedPhasesAndTriggers(t *testing.T) {
+	releaseDefinition := testReleaseDefinition()
+	data, err := json.Marshal(releaseDefinition)
+	require.Nil(t, err)
+
+	var deserialized release.ReleaseDefinition
+	require.Nil(t, json.Unmarshal(data, &deserialized))
+	_, isMap := (*(*deserialized.Environments)[0].DeployPhases)[0].(map[string]interface{})
+	require.True(t, isMap)
+
+	expected := schema.TestResourceDataRaw(t, ResourceReleaseDefinition().Schema, nil)
+	require.Nil(t, flattenReleaseDefinition(expected, &releaseDefinition, testReleaseProjectID))
+
+	actual := schema.TestResourceDataRaw(t, ResourceReleaseDefinition().Schema, nil)
+	require.Nil(t, flattenReleaseDefinition(actual, &deserialized, testReleaseProjectID))
+
+	expectedDefinition, _, err := expandReleaseDefinition(expected)
+	require.Nil(t, err)
+	actualDefinition, _, err := expandReleaseDefinition(actual)
+	require.Nil(t, err)
+	require.Equal(t, expectedDefinition, actualDefinition)
+}
+
+// verifies that a stage cannot be triggered after a stage that is not part of the definition
+func TestReleaseDefinition_Expand_UnknownTriggerStageIsError(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceReleaseDefinition().Schema, map[string]interface{}{
+		"project_id": testReleaseProjectID,
+		"name":       "Name",
+		"stage": []interface{}{
+			map[string]interface{}{
+				"name":                 "prod",
+				"owner_id":             testReleaseOwnerID,
+				"trigger_after_stages": []interface{}{"dev"},
+			},
+		},
+	})
+
+	_, _, err := expandReleaseDefinition(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "unknown stage dev")
+}
+
+// verifies that build artifacts must reference a build definition
+func TestReleaseDefinition_Expand_BuildArtifactRequiresDefinition(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceReleaseDefinition().Schema, map[string]interface{}{
+		"project_id": testReleaseProjectID,
+		"name":       "Name",
+		"artifact": []interface{}{
+			map[string]interface{}{
+				"alias": "_build",
+				"type":  artifactTypeBuild,
+			},
+		},
+		"stage": []interface{}{
+			map[string]interface{}{
+				"name":     "dev",
+				"owner_id": testReleaseOwnerID,
+			},
+		},
+	})
+
+	_, _, err := expandReleaseDefinition(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "requires build_definition_id")
+}
+
+// verifies that if an error is produced on create, it is not swallowed
+func TestReleaseDefinition_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceReleaseDefinition().Schema, nil)
+	releaseDefinition := testReleaseDefinition()
+	require.Nil(t, flattenReleaseDefinition(resourceData, &releaseDefinition, testReleaseProjectID))
+	resourceData.SetId("")
+
+	releaseClient := azdosdkmocks.NewMockReleaseClient(ctrl)
+	clients := &client.AggregatedClient{ReleaseClient: releaseClient, Ctx: context.Background()}
+
+	releaseClient.
+		EXPECT().
+		CreateReleaseDefinition(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("CreateReleaseDefinition() Failed")).
+		Times(1)
+
+	err := resourceReleaseDefinitionCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "CreateReleaseDefinition() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestReleaseDefinition_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceReleaseDefinition().Schema, nil)
+	releaseDefinition := testReleaseDefinition()
+	require.Nil(t, flattenReleaseDefinition(resourceData, &releaseDefinition, testReleaseProjectID))
+
+	releaseClient := azdosdkmocks.NewMockReleaseClient(ctrl)
+	clients := &client.AggregatedClient{ReleaseClient: releaseClient, Ctx: context.Background()}
+
+	expectedArgs := release.GetReleaseDefinitionArgs{DefinitionId: releaseDefinition.Id, Project: &testReleaseProjectID}
+	releaseClient.
+		EXPECT().
+		GetReleaseDefinition(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("GetReleaseDefinition() Failed")).
+		Times(1)
+
+	err := resourceReleaseDefinitionRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "GetReleaseDefinition() Failed")
+}
+
+// verifies that a release definition which no longer exists is removed from the state
+func TestReleaseDefinition_Read_NotFoundClearsID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceReleaseDefinition().Schema, nil)
+	releaseDefinition := testReleaseDefinition()
+	require.Nil(t, flattenReleaseDefinition(resourceData, &releaseDefinition, testReleaseProjectID))
+
+	releaseClient := azdosdkmocks.NewMockReleaseClient(ctrl)
+	clients := &client.AggregatedClient{ReleaseClient: releaseClient, Ctx: context.Background()}
+
+	releaseClient.
+		EXPECT().
+		GetReleaseDefinition(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+
+	err := resourceReleaseDefinitionRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the revision is sent to the service on update
+func TestReleaseDefinition_Update_SendsRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceReleaseDefinition().Schema, nil)
+	releaseDefinition := testReleaseDefinition()
+	require.Nil(t, flattenReleaseDefinition(resourceData, &releaseDefinition, testReleaseProjectID))
+
+	releaseClient := azdosdkmocks.NewMockReleaseClient(ctrl)
+	clients := &client.AggregatedClient{ReleaseClient: releaseClient, Ctx: context.Background()}
+
+	expectedArgs := release.UpdateReleaseDefinitionArgs{ReleaseDefinition: &releaseDefinition, Project: &testReleaseProjectID}
+	releaseClient.
+		EXPECT().
+		UpdateReleaseDefinition(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("UpdateReleaseDefinition() Failed")).
+		Times(1)
+
+	err := resourceReleaseDefinitionUpdate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "UpdateReleaseDefinition() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestReleaseDefinition_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceReleaseDefinition().Schema, nil)
+	releaseDefinition := testReleaseDefinition()
+	require.Nil(t, flattenReleaseDefinition(resourceData, &releaseDefinition, testReleaseProjectID))
+
+	releaseClient := azdosdkmocks.NewMockReleaseClient(ctrl)
+	clients := &client.AggregatedClient{ReleaseClient: releaseClient, Ctx: context.Background()}
+
+	expectedArgs := release.DeleteReleaseDefinitionArgs{
+		DefinitionId: releaseDefinition.Id,
+		Project:      &testReleaseProjectID,
+		ForceDelete:  converter.Bool(true),
+	}
+	releaseClient.
+		EXPECT().
+		DeleteReleaseDefinition(clients.Ctx, expectedArgs).
+		Return(errors.New("DeleteReleaseDefinition() Failed")).
+		Times(1)
+
+	err := resourceReleaseDefinitionDelete(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "DeleteReleaseDefinition() Failed")
+}
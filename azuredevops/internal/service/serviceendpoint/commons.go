@@ -3,6 +3,8 @@ package serviceendpoint
 import (
 	"fmt"
 	"log"
+	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/secretmemo"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
@@ -21,6 +24,21 @@ const errMsgTfConfigRead = "Error reading terraform configuration: %+v"
 const errMsgServiceCreate = "Error looking up service endpoint given ID (%s) and project ID (%s): %v "
 const errMsgServiceDelete = "Error delete service endpoint. ServiceEndpointID: %s, projectID: %s. %v "
 
+// testConnectionDataSource is the name of the data source of a service endpoint type that verifies the credentials
+const testConnectionDataSource = "TestConnection"
+
+// verificationStatusOk is the status of a successful verification of the credentials
+const verificationStatusOk = "ok"
+
+// verificationStatusNotSupported is the status of a service endpoint whose type has no data source to verify the credentials
+const verificationStatusNotSupported = "not supported"
+
+// the suffixes of the attributes that read a secret from a file or an environment variable
+const (
+	secretFileSuffix = "_file"
+	secretEnvSuffix  = "_env"
+)
+
 type flatFunc func(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID)
 type expandFunc func(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error)
 
@@ -40,10 +58,11 @@ var opState = operationState{
 // that all Service Endpoints require.
 func genBaseServiceEndpointResource(f flatFunc, e expandFunc) *schema.Resource {
 	return &schema.Resource{
-		Create: genServiceEndpointCreateFunc(f, e),
-		Read:   genServiceEndpointReadFunc(f),
-		Update: genServiceEndpointUpdateFunc(f, e),
-		Delete: genServiceEndpointDeleteFunc(e),
+		Create:        genServiceEndpointCreateFunc(f, e),
+		Read:          genServiceEndpointReadFunc(f),
+		Update:        genServiceEndpointUpdateFunc(f, e),
+		Delete:        genServiceEndpointDeleteFunc(e),
+		CustomizeDiff: customizeServiceEndpointDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
 			Read:   schema.DefaultTimeout(1 * time.Minute),
@@ -79,10 +98,50 @@ func genBaseServiceEndpointResource(f flatFunc, e expandFunc) *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"shared_project_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+				Set:         schema.HashString,
+				Description: "The IDs of the additional projects the service endpoint is shared with.",
+			},
+			"verify_on_create": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Verify the credentials of the service endpoint after it is created, the service endpoint is deleted if the verification fails.",
+			},
+			"verify_on_update": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Verify the credentials of the service endpoint after it is updated and plan an update while the last verification failed.",
+			},
+			"last_verified_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last verification of the credentials, ok if the verification succeeded, not supported if the service endpoint type can't be verified.",
+			},
 		},
 	}
 }
 
+// customizeServiceEndpointDiff plans an update of a service endpoint whose credentials failed the last
+// verification, the update verifies the credentials again and fails while they are not fixed
+func customizeServiceEndpointDiff(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.Get("verify_on_update").(bool) {
+		return nil
+	}
+	status := d.Get("last_verified_status").(string)
+	if status != "" && !strings.EqualFold(status, verificationStatusOk) && status != verificationStatusNotSupported {
+		return d.SetNewComputed("last_verified_status")
+	}
+	return nil
+}
+
 // doBaseExpansion performs the expansion for the 'base' attributes that are defined in the schema, above
 func doBaseExpansion(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID) {
 	// an "error" is OK here as it is expected in the case that the ID is not set in the resource data
@@ -116,7 +175,23 @@ func doBaseFlattening(d *schema.ResourceData, serviceEndpoint *serviceendpoint.S
 	d.SetId(serviceEndpoint.Id.String())
 	d.Set("service_endpoint_name", serviceEndpoint.Name)
 	d.Set("project_id", projectID.String())
-	d.Set("description", (*serviceEndpoint.ServiceEndpointProjectReferences)[0].Description)
+
+	// the description is taken from the reference of the project the resource belongs to
+	references := *serviceEndpoint.ServiceEndpointProjectReferences
+	description := references[0].Description
+	sharedProjectIDs := []string{}
+	for _, reference := range references {
+		if reference.ProjectReference == nil || reference.ProjectReference.Id == nil {
+			continue
+		}
+		if *reference.ProjectReference.Id == *projectID {
+			description = reference.Description
+		} else {
+			sharedProjectIDs = append(sharedProjectIDs, reference.ProjectReference.Id.String())
+		}
+	}
+	d.Set("description", description)
+	d.Set("shared_project_ids", sharedProjectIDs)
 
 	if serviceEndpoint.Authorization != nil && serviceEndpoint.Authorization.Scheme != nil {
 		d.Set("authorization", &map[string]interface{}{
@@ -129,7 +204,7 @@ func doBaseFlattening(d *schema.ResourceData, serviceEndpoint *serviceendpoint.S
 func makeProtectedSchema(r *schema.Resource, keyName, envVarName, description string) {
 	r.Schema[keyName] = &schema.Schema{
 		Type:             schema.TypeString,
-		Required:         true,
+		Optional:         true,
 		DefaultFunc:      schema.EnvDefaultFunc(envVarName, nil),
 		Description:      description,
 		Sensitive:        true,
@@ -138,6 +213,257 @@ func makeProtectedSchema(r *schema.Resource, keyName, envVarName, description st
 
 	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema(keyName)
 	r.Schema[secretHashKey] = secretHashSchema
+	makeSecretSourceSchema(r, keyName)
+}
+
+// makeSecretSourceSchema adds the attributes that read the secret from a file or an environment variable
+// instead of setting it inline. The secret itself becomes optional, expandSecretValue checks that it was
+// provided when the resource is applied.
+func makeSecretSourceSchema(r *schema.Resource, keyName string) {
+	if secret := r.Schema[keyName]; secret.Required {
+		secret.Required = false
+		secret.Optional = true
+	}
+	r.Schema[keyName+secretFileSuffix] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  fmt.Sprintf("The path of a file containing the value of '%s'. The file is read when the resource is applied.", keyName),
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+	}
+	r.Schema[keyName+secretEnvSuffix] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  fmt.Sprintf("The name of an environment variable containing the value of '%s'. The variable is read when the resource is applied.", keyName),
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+	}
+}
+
+// expandSecretValue returns the secret of the attributes of a resource or block. A secret read from a file
+// or an environment variable takes precedence over the inline value, which may be set by an environment default.
+func expandSecretValue(attributes map[string]interface{}, keyName string, required bool) (string, error) {
+	fileKey, envKey := keyName+secretFileSuffix, keyName+secretEnvSuffix
+	file, _ := attributes[fileKey].(string)
+	env, _ := attributes[envKey].(string)
+	if file != "" && env != "" {
+		return "", fmt.Errorf("only one of %s and %s can be set", fileKey, envKey)
+	}
+
+	value, _ := attributes[keyName].(string)
+	if file != "" {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("reading %s from %s: %+v", keyName, file, err)
+		}
+		value = strings.TrimRight(string(content), "\r\n")
+	} else if env != "" {
+		content, ok := os.LookupEnv(env)
+		if !ok {
+			return "", fmt.Errorf("the environment variable %s of %s is not set", env, keyName)
+		}
+		value = content
+	}
+
+	if required && value == "" {
+		return "", fmt.Errorf("one of %s, %s or %s must be set", keyName, fileKey, envKey)
+	}
+	return value, nil
+}
+
+// expandResourceSecretValue returns the secret of a top level attribute, see expandSecretValue
+func expandResourceSecretValue(d *schema.ResourceData, keyName string, required bool) (string, error) {
+	return expandSecretValue(resourceSecretAttributes(d, keyName), keyName, required)
+}
+
+func resourceSecretAttributes(d *schema.ResourceData, keyName string) map[string]interface{} {
+	attributes := map[string]interface{}{}
+	for _, key := range []string{keyName, keyName + secretFileSuffix, keyName + secretEnvSuffix, keyName + "_hash"} {
+		attributes[key] = d.Get(key)
+	}
+	return attributes
+}
+
+// flattenSecret stores the hash of a top level secret in the state, see flattenSecretSource
+func flattenSecret(d *schema.ResourceData, keyName string) {
+	fileKey, envKey := keyName+secretFileSuffix, keyName+secretEnvSuffix
+	if d.Get(fileKey).(string) == "" && d.Get(envKey).(string) == "" {
+		tfhelper.HelpFlattenSecret(d, keyName)
+		return
+	}
+
+	attributes := resourceSecretAttributes(d, keyName)
+	flattenSecretSource(attributes, attributes, keyName, d.HasChanges(keyName, fileKey, envKey))
+	for _, key := range []string{fileKey, envKey, keyName + "_hash"} {
+		d.Set(key, attributes[key])
+	}
+}
+
+// flattenSecretSource copies the source of the secret from the configured block to the flattened block and
+// stores the hash of the secret read from it. The secret is only hashed if the block changed. Otherwise the
+// source is read to detect a changed secret, the source is then cleared in the state so that the next plan
+// updates the service endpoint without containing the secret.
+func flattenSecretSource(configuration map[string]interface{}, flattened map[string]interface{}, keyName string, changed bool) {
+	fileKey, envKey, hashKey := keyName+secretFileSuffix, keyName+secretEnvSuffix, keyName+"_hash"
+	flattened[fileKey] = configuration[fileKey]
+	flattened[envKey] = configuration[envKey]
+	file, _ := configuration[fileKey].(string)
+	env, _ := configuration[envKey].(string)
+	if file == "" && env == "" {
+		return
+	}
+
+	oldHash, _ := configuration[hashKey].(string)
+	flattened[hashKey] = oldHash
+	secret, err := expandSecretValue(configuration, keyName, false)
+	if err != nil {
+		// the source may only be available where the resource is applied
+		log.Printf("[DEBUG] Secret %s could not be read: %+v", keyName, err)
+		return
+	}
+	isUpdating, newHash, err := secretmemo.IsUpdating(secret, oldHash)
+	if err != nil {
+		log.Printf("Swallowing err while using secret hashing: %s", err)
+		return
+	}
+	if changed {
+		flattened[hashKey] = newHash
+	} else if isUpdating {
+		log.Printf("[DEBUG] Secret %s has changed in its source.", keyName)
+		flattened[fileKey] = ""
+		flattened[envKey] = ""
+	}
+}
+
+// the authentication blocks of the service endpoints that authenticate with a token or a user name and password
+const (
+	authenticationToken = "authentication_token"
+	authenticationBasic = "authentication_basic"
+)
+
+// makeTokenAndBasicAuthenticationSchema adds the authentication_token and authentication_basic blocks to the schema,
+// exactly one of the blocks and the other attributes in exactlyOneOf must be set
+func makeTokenAndBasicAuthenticationSchema(r *schema.Resource, name string, exactlyOneOf ...string) {
+	tokenHashKey, tokenHashSchema := tfhelper.GenerateSecreteMemoSchema("token")
+	token := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"token": {
+				Description:      fmt.Sprintf("The %s access token.", name),
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: tfhelper.DiffFuncSuppressSecretChanged,
+			},
+			tokenHashKey: tokenHashSchema,
+		},
+	}
+	makeSecretSourceSchema(token, "token")
+
+	passwordHashKey, passwordHashSchema := tfhelper.GenerateSecreteMemoSchema("password")
+	basic := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Description:  fmt.Sprintf("The %s user name.", name),
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"password": {
+				Description:      fmt.Sprintf("The %s password.", name),
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: tfhelper.DiffFuncSuppressSecretChanged,
+			},
+			passwordHashKey: passwordHashSchema,
+		},
+	}
+	makeSecretSourceSchema(basic, "password")
+
+	exactlyOneOf = append([]string{authenticationToken, authenticationBasic}, exactlyOneOf...)
+	r.Schema[authenticationToken] = &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		MinItems:     1,
+		MaxItems:     1,
+		Elem:         token,
+		ExactlyOneOf: exactlyOneOf,
+	}
+	r.Schema[authenticationBasic] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MinItems: 1,
+		MaxItems: 1,
+		Elem:     basic,
+	}
+}
+
+// expandTokenAndBasicAuthentication returns the authorization of the authentication block that is set,
+// nil is returned if none of them is set
+func expandTokenAndBasicAuthentication(d *schema.ResourceData) (*serviceendpoint.EndpointAuthorization, error) {
+	if x, ok := d.GetOk(authenticationToken); ok {
+		token, err := expandSecret(x.([]interface{})[0].(map[string]interface{}), "token")
+		if err != nil {
+			return nil, err
+		}
+		return &serviceendpoint.EndpointAuthorization{
+			Parameters: &map[string]string{
+				"apitoken": token,
+			},
+			Scheme: converter.String("Token"),
+		}, nil
+	}
+	if x, ok := d.GetOk(authenticationBasic); ok {
+		basic := x.([]interface{})[0].(map[string]interface{})
+		password, err := expandSecret(basic, "password")
+		if err != nil {
+			return nil, err
+		}
+		return &serviceendpoint.EndpointAuthorization{
+			Parameters: &map[string]string{
+				"username": basic["username"].(string),
+				"password": password,
+			},
+			Scheme: converter.String("UsernamePassword"),
+		}, nil
+	}
+	return nil, nil
+}
+
+// flattenTokenAndBasicAuthentication sets the authentication block of the authorization scheme of the service endpoint,
+// false is returned for any other scheme
+func flattenTokenAndBasicAuthentication(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint) bool {
+	if serviceEndpoint.Authorization == nil || serviceEndpoint.Authorization.Scheme == nil {
+		return false
+	}
+	parameters := map[string]string{}
+	if serviceEndpoint.Authorization.Parameters != nil {
+		parameters = *serviceEndpoint.Authorization.Parameters
+	}
+
+	var key, secretKey string
+	auth := map[string]interface{}{}
+	switch {
+	case strings.EqualFold(*serviceEndpoint.Authorization.Scheme, "Token"):
+		key, secretKey = authenticationToken, "token"
+		auth["token"] = parameters["apitoken"]
+	case strings.EqualFold(*serviceEndpoint.Authorization.Scheme, "UsernamePassword"):
+		key, secretKey = authenticationBasic, "password"
+		auth["username"] = parameters["username"]
+		auth["password"] = parameters["password"]
+	default:
+		return false
+	}
+
+	// secret values won't be returned by the service and should not be overwritten
+	if x, ok := d.GetOk(key); ok {
+		configuration := x.([]interface{})[0].(map[string]interface{})
+		if len(configuration) > 0 {
+			newHash, hashKey := tfhelper.HelpFlattenSecretNested(d, key, configuration, secretKey)
+			auth[hashKey] = newHash
+			flattenSecretSource(configuration, auth, secretKey, d.HasChange(key))
+		}
+	}
+	d.Set(key, []interface{}{auth})
+	return true
 }
 
 // makeUnprotectedSchema create unprotected schema
@@ -180,12 +506,30 @@ func genServiceEndpointCreateFunc(flatFunc flatFunc, expandFunc expandFunc) func
 			return fmt.Errorf(" waiting for service endpoint ready. %v ", err)
 		}
 
+		if d.Get("verify_on_create").(bool) {
+			status, err := verifyServiceEndpoint(clients, projectID, createdServiceEndpoint)
+			if err != nil {
+				if delErr := deleteServiceEndpoint(clients, projectID, createdServiceEndpoint.Id, d.Timeout(schema.TimeoutDelete)); delErr != nil {
+					log.Printf("[DEBUG] Failed to delete the unverified service endpoint: %v ", delErr)
+				}
+				return err
+			}
+			d.Set("last_verified_status", status)
+		}
+
 		d.SetId(createdServiceEndpoint.Id.String())
-		return genServiceEndpointReadFunc(flatFunc)(d, m)
+		if err := shareServiceEndpoint(clients, d, createdServiceEndpoint, projectID); err != nil {
+			return err
+		}
+		return readServiceEndpoint(flatFunc)(d, m)
 	}
 }
 
 func genServiceEndpointReadFunc(flatFunc flatFunc) func(d *schema.ResourceData, m interface{}) error {
+	return readServiceEndpoint(flatFunc)
+}
+
+func readServiceEndpoint(flatFunc flatFunc) func(d *schema.ResourceData, m interface{}) error {
 	return func(d *schema.ResourceData, m interface{}) error {
 		clients := m.(*client.AggregatedClient)
 
@@ -218,9 +562,9 @@ func genServiceEndpointReadFunc(flatFunc flatFunc) func(d *schema.ResourceData,
 		if serviceEndpoint.Id == nil {
 			// e.g. service endpoint has been deleted separately without TF
 			d.SetId("")
-		} else {
-			flatFunc(d, serviceEndpoint, &projectID)
+			return nil
 		}
+		flatFunc(d, serviceEndpoint, &projectID)
 		return nil
 	}
 }
@@ -239,7 +583,19 @@ func genServiceEndpointUpdateFunc(flatFunc flatFunc, expandFunc expandFunc) sche
 		}
 
 		flatFunc(d, updatedServiceEndpoint, projectID)
-		return genServiceEndpointReadFunc(flatFunc)(d, m)
+		if d.Get("verify_on_update").(bool) {
+			status, err := verifyServiceEndpoint(clients, projectID, updatedServiceEndpoint)
+			if status != "" {
+				d.Set("last_verified_status", status)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if err := shareServiceEndpoint(clients, d, updatedServiceEndpoint, projectID); err != nil {
+			return err
+		}
+		return readServiceEndpoint(flatFunc)(d, m)
 	}
 }
 
@@ -251,10 +607,149 @@ func genServiceEndpointDeleteFunc(expandFunc expandFunc) schema.DeleteFunc {
 			return fmt.Errorf(errMsgTfConfigRead, err)
 		}
 
+		// the service endpoint is removed from the projects it is shared with before it is deleted
+		sharedProjectIDs := tfhelper.ExpandStringSet(d.Get("shared_project_ids").(*schema.Set))
+		if err := unshareServiceEndpoint(clients, serviceEndpoint.Id, sharedProjectIDs); err != nil {
+			return err
+		}
 		return deleteServiceEndpoint(clients, projectID, serviceEndpoint.Id, d.Timeout(schema.TimeoutDelete))
 	}
 }
 
+// shareServiceEndpoint adds and removes the references of the projects the service endpoint is shared with
+// until they match shared_project_ids
+func shareServiceEndpoint(clients *client.AggregatedClient, d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID) error {
+	sharedProjectIDs := map[uuid.UUID]bool{}
+	if serviceEndpoint.ServiceEndpointProjectReferences != nil {
+		for _, reference := range *serviceEndpoint.ServiceEndpointProjectReferences {
+			if reference.ProjectReference != nil && reference.ProjectReference.Id != nil && *reference.ProjectReference.Id != *projectID {
+				sharedProjectIDs[*reference.ProjectReference.Id] = true
+			}
+		}
+	}
+
+	var references []serviceendpoint.ServiceEndpointProjectReference
+	for _, id := range d.Get("shared_project_ids").(*schema.Set).List() {
+		sharedProjectID := uuid.MustParse(id.(string))
+		if sharedProjectID == *projectID {
+			continue
+		}
+		if sharedProjectIDs[sharedProjectID] {
+			delete(sharedProjectIDs, sharedProjectID)
+			continue
+		}
+		references = append(references, serviceendpoint.ServiceEndpointProjectReference{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: &sharedProjectID,
+			},
+			Name:        converter.String(d.Get("service_endpoint_name").(string)),
+			Description: converter.String(d.Get("description").(string)),
+		})
+	}
+
+	if len(references) > 0 {
+		err := clients.ServiceEndpointClient.ShareServiceEndpoint(
+			clients.Ctx,
+			serviceendpoint.ShareServiceEndpointArgs{
+				EndpointProjectReferences: &references,
+				EndpointId:                serviceEndpoint.Id,
+			})
+		if err != nil {
+			return fmt.Errorf("Error sharing service endpoint %s: %+v", serviceEndpoint.Id, err)
+		}
+	}
+
+	var unsharedProjectIDs []string
+	for sharedProjectID := range sharedProjectIDs {
+		unsharedProjectIDs = append(unsharedProjectIDs, sharedProjectID.String())
+	}
+	return unshareServiceEndpoint(clients, serviceEndpoint.Id, unsharedProjectIDs)
+}
+
+// unshareServiceEndpoint removes the service endpoint from the projects it is shared with
+func unshareServiceEndpoint(clients *client.AggregatedClient, serviceEndpointID *uuid.UUID, projectIDs []string) error {
+	if len(projectIDs) == 0 {
+		return nil
+	}
+	sort.Strings(projectIDs)
+	err := clients.ServiceEndpointClient.DeleteServiceEndpoint(
+		clients.Ctx,
+		serviceendpoint.DeleteServiceEndpointArgs{
+			ProjectIds: &projectIDs,
+			EndpointId: serviceEndpointID,
+		})
+	if err != nil {
+		return fmt.Errorf("Error removing service endpoint %s from the projects %s: %+v", serviceEndpointID, strings.Join(projectIDs, ", "), err)
+	}
+	return nil
+}
+
+// verifyServiceEndpoint runs the test connection data source of the service endpoint type and returns the status
+// of the verification. An error is returned if the credentials do not work, the status is empty if the verification
+// could not be run at all. Service endpoint types without a test connection data source are not verified.
+func verifyServiceEndpoint(clients *client.AggregatedClient, projectID *uuid.UUID, serviceEndpoint *serviceendpoint.ServiceEndpoint) (string, error) {
+	dataSource, err := getTestConnectionDataSource(clients, serviceEndpoint.Type)
+	if err != nil {
+		return "", fmt.Errorf("Error looking up the data sources of service endpoint type %s: %+v", converter.ToString(serviceEndpoint.Type, ""), err)
+	}
+	if dataSource == "" {
+		log.Printf("[WARN] Service endpoint type %s has no %s data source, the service endpoint %s is not verified", converter.ToString(serviceEndpoint.Type, ""), testConnectionDataSource, serviceEndpoint.Id)
+		return verificationStatusNotSupported, nil
+	}
+
+	result, err := clients.ServiceEndpointClient.ExecuteServiceEndpointRequest(
+		clients.Ctx,
+		serviceendpoint.ExecuteServiceEndpointRequestArgs{
+			ServiceEndpointRequest: &serviceendpoint.ServiceEndpointRequest{
+				DataSourceDetails: &serviceendpoint.DataSourceDetails{
+					DataSourceName: converter.String(dataSource),
+				},
+			},
+			Project:    converter.String(projectID.String()),
+			EndpointId: converter.String(serviceEndpoint.Id.String()),
+		})
+	if err != nil {
+		return "", fmt.Errorf("Error verifying service endpoint %s: %+v", serviceEndpoint.Id, err)
+	}
+	if result == nil || result.StatusCode == nil {
+		return "", fmt.Errorf("Error verifying service endpoint %s: the verification returned no status", serviceEndpoint.Id)
+	}
+
+	status := *result.StatusCode
+	if !strings.EqualFold(status, verificationStatusOk) {
+		return status, fmt.Errorf("Service endpoint %s failed the verification with status %s: %s", serviceEndpoint.Id, status, converter.ToString(result.ErrorMessage, ""))
+	}
+	return status, nil
+}
+
+// getTestConnectionDataSource returns the name of the data source of the service endpoint type that verifies
+// the credentials, the name is empty if the type has none
+func getTestConnectionDataSource(clients *client.AggregatedClient, endpointType *string) (string, error) {
+	endpointTypes, err := clients.ServiceEndpointClient.GetServiceEndpointTypes(
+		clients.Ctx,
+		serviceendpoint.GetServiceEndpointTypesArgs{
+			Type: endpointType,
+		})
+	if err != nil {
+		return "", err
+	}
+	if endpointTypes == nil {
+		return "", nil
+	}
+
+	for _, endpointType := range *endpointTypes {
+		if endpointType.DataSources == nil {
+			continue
+		}
+		for _, dataSource := range *endpointType.DataSources {
+			if dataSource.Name != nil && strings.EqualFold(*dataSource.Name, testConnectionDataSource) {
+				return *dataSource.Name, nil
+			}
+		}
+	}
+	return "", nil
+}
+
 // Make the Azure DevOps API call to create the endpoint
 func createServiceEndpoint(clients *client.AggregatedClient, endpoint *serviceendpoint.ServiceEndpoint) (*serviceendpoint.ServiceEndpoint, error) {
 	if strings.EqualFold(*endpoint.Type, "github") && strings.EqualFold(*endpoint.Authorization.Scheme, "InstallationToken") {
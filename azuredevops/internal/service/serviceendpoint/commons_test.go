@@ -0,0 +1,462 @@
+//go:build (all || resource_serviceendpoint_commons) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_commons
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/secretmemo"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSecretFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "secret")
+	require.Nil(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestServiceEndpointSecret_ExpandReadsFile(t *testing.T) {
+	secret, err := expandSecretValue(map[string]interface{}{
+		"password":      "",
+		"password_file": writeSecretFile(t, "secret-from-file\n"),
+	}, "password", true)
+
+	require.Nil(t, err)
+	require.Equal(t, "secret-from-file", secret)
+}
+
+func TestServiceEndpointSecret_ExpandReadsEnvironment(t *testing.T) {
+	os.Setenv("AZDO_TEST_SERVICE_ENDPOINT_SECRET", "secret-from-env")
+	defer os.Unsetenv("AZDO_TEST_SERVICE_ENDPOINT_SECRET")
+	secret, err := expandSecretValue(map[string]interface{}{
+		"password":     "",
+		"password_env": "AZDO_TEST_SERVICE_ENDPOINT_SECRET",
+	}, "password", true)
+
+	require.Nil(t, err)
+	require.Equal(t, "secret-from-env", secret)
+}
+
+func TestServiceEndpointSecret_ExpandPrefersSourceOverInlineValue(t *testing.T) {
+	secret, err := expandSecretValue(map[string]interface{}{
+		"password":      "secret-from-default",
+		"password_file": writeSecretFile(t, "secret-from-file"),
+	}, "password", true)
+
+	require.Nil(t, err)
+	require.Equal(t, "secret-from-file", secret)
+}
+
+func TestServiceEndpointSecret_ExpandFailsForMissingSecret(t *testing.T) {
+	secret, err := expandSecretValue(map[string]interface{}{"password": ""}, "password", false)
+	require.Nil(t, err)
+	require.Empty(t, secret)
+
+	_, err = expandSecretValue(map[string]interface{}{"password": ""}, "password", true)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "one of password, password_file or password_env must be set")
+
+	_, err = expandSecretValue(map[string]interface{}{"password_env": "AZDO_TEST_SERVICE_ENDPOINT_UNSET"}, "password", false)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "AZDO_TEST_SERVICE_ENDPOINT_UNSET")
+
+	_, err = expandSecretValue(map[string]interface{}{"password_file": filepath.Join(t.TempDir(), "missing")}, "password", false)
+	require.NotNil(t, err)
+}
+
+func TestServiceEndpointSecret_ExpandFailsForFileAndEnvironment(t *testing.T) {
+	_, err := expandSecretValue(map[string]interface{}{
+		"password_file": writeSecretFile(t, "secret-from-file"),
+		"password_env":  "AZDO_TEST_SERVICE_ENDPOINT_SECRET",
+	}, "password", true)
+
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "only one of password_file and password_env can be set")
+}
+
+func TestServiceEndpointSecret_MakeSecretSourceSchemaMakesSecretOptional(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+	}
+	makeSecretSourceSchema(r, "password")
+
+	require.False(t, r.Schema["password"].Required)
+	require.True(t, r.Schema["password"].Optional)
+	require.Contains(t, r.Schema, "password_file")
+	require.Contains(t, r.Schema, "password_env")
+	require.False(t, r.Schema["password_file"].Sensitive)
+}
+
+func TestServiceEndpointSecret_FlattenHashesSecretOfChangedSource(t *testing.T) {
+	configuration := map[string]interface{}{
+		"password":      "",
+		"password_file": writeSecretFile(t, "secret-from-file"),
+		"password_env":  "",
+		"password_hash": "",
+	}
+	flattened := map[string]interface{}{}
+	flattenSecretSource(configuration, flattened, "password", true)
+
+	require.Equal(t, configuration["password_file"], flattened["password_file"])
+	isUpdating, _, err := secretmemo.IsUpdating("secret-from-file", flattened["password_hash"].(string))
+	require.Nil(t, err)
+	require.False(t, isUpdating)
+}
+
+func TestServiceEndpointSecret_FlattenClearsSourceOfChangedSecret(t *testing.T) {
+	_, hash, err := secretmemo.IsUpdating("old-secret", "")
+	require.Nil(t, err)
+
+	configuration := map[string]interface{}{
+		"password":      "",
+		"password_file": writeSecretFile(t, "new-secret"),
+		"password_env":  "",
+		"password_hash": hash,
+	}
+	flattened := map[string]interface{}{}
+	flattenSecretSource(configuration, flattened, "password", false)
+
+	require.Equal(t, "", flattened["password_file"])
+	require.Equal(t, hash, flattened["password_hash"])
+}
+
+func TestServiceEndpointSecret_FlattenKeepsSourceOfUnchangedSecret(t *testing.T) {
+	_, hash, err := secretmemo.IsUpdating("secret-from-file", "")
+	require.Nil(t, err)
+
+	configuration := map[string]interface{}{
+		"password":      "",
+		"password_file": writeSecretFile(t, "secret-from-file"),
+		"password_env":  "",
+		"password_hash": hash,
+	}
+	flattened := map[string]interface{}{}
+	flattenSecretSource(configuration, flattened, "password", false)
+
+	require.Equal(t, configuration["password_file"], flattened["password_file"])
+	require.Equal(t, hash, flattened["password_hash"])
+}
+
+var verifyTestProjectID = uuid.New()
+var verifyTestServiceEndpointID = uuid.New()
+
+var verifyTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"username": "VERIFY_TEST_username",
+			"password": "",
+		},
+		Scheme: converter.String("UsernamePassword"),
+	},
+	Id:    &verifyTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_CONN_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("generic"),
+	Url:   converter.String("https://example.com/"),
+	ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: &verifyTestProjectID,
+			},
+			Name:        converter.String("UNIT_TEST_CONN_NAME"),
+			Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		},
+	},
+}
+
+func expectServiceEndpointTypes(client *azdosdkmocks.MockServiceendpointClient, dataSources ...string) {
+	var typeDataSources []serviceendpoint.DataSource
+	for _, dataSource := range dataSources {
+		typeDataSources = append(typeDataSources, serviceendpoint.DataSource{Name: converter.String(dataSource)})
+	}
+	client.
+		EXPECT().
+		GetServiceEndpointTypes(gomock.Any(), serviceendpoint.GetServiceEndpointTypesArgs{
+			Type: converter.String("generic"),
+		}).
+		Return(&[]serviceendpoint.ServiceEndpointType{
+			{
+				Name:        converter.String("generic"),
+				DataSources: &typeDataSources,
+			},
+		}, nil).
+		Times(1)
+}
+
+func expectServiceEndpointVerification(client *azdosdkmocks.MockServiceendpointClient, result *serviceendpoint.ServiceEndpointRequestResult, err error) {
+	expectServiceEndpointTypes(client, "Projects", "TestConnection")
+	client.
+		EXPECT().
+		ExecuteServiceEndpointRequest(gomock.Any(), serviceendpoint.ExecuteServiceEndpointRequestArgs{
+			ServiceEndpointRequest: &serviceendpoint.ServiceEndpointRequest{
+				DataSourceDetails: &serviceendpoint.DataSourceDetails{
+					DataSourceName: converter.String("TestConnection"),
+				},
+			},
+			Project:    converter.String(verifyTestProjectID.String()),
+			EndpointId: converter.String(verifyTestServiceEndpointID.String()),
+		}).
+		Return(result, err).
+		Times(1)
+}
+
+func TestServiceEndpointVerification_Succeeds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+	expectServiceEndpointVerification(serviceEndpointClient, &serviceendpoint.ServiceEndpointRequestResult{
+		StatusCode: converter.String("ok"),
+	}, nil)
+
+	status, err := verifyServiceEndpoint(clients, &verifyTestProjectID, &verifyTestServiceEndpoint)
+	require.Nil(t, err)
+	require.Equal(t, "ok", status)
+}
+
+func TestServiceEndpointVerification_FailsForInvalidCredentials(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+	expectServiceEndpointVerification(serviceEndpointClient, &serviceendpoint.ServiceEndpointRequestResult{
+		StatusCode:   converter.String("unauthorized"),
+		ErrorMessage: converter.String("The client secret has expired"),
+	}, nil)
+
+	status, err := verifyServiceEndpoint(clients, &verifyTestProjectID, &verifyTestServiceEndpoint)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "The client secret has expired")
+	require.Equal(t, "unauthorized", status)
+}
+
+// verifies that service endpoint types without a test connection data source are not verified
+func TestServiceEndpointVerification_SkipsTypesWithoutTestConnection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+	expectServiceEndpointTypes(serviceEndpointClient, "Projects")
+	serviceEndpointClient.
+		EXPECT().
+		ExecuteServiceEndpointRequest(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	status, err := verifyServiceEndpoint(clients, &verifyTestProjectID, &verifyTestServiceEndpoint)
+	require.Nil(t, err)
+	require.Equal(t, "not supported", status)
+}
+
+// verifies that a read does not verify the credentials and keeps the status of the last verification
+func TestServiceEndpointVerification_ReadKeepsStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointGeneric()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"verify_on_update": true})
+	resourceData.SetId(verifyTestServiceEndpointID.String())
+	resourceData.Set("project_id", verifyTestProjectID.String())
+	resourceData.Set("last_verified_status", "unauthorized")
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(&verifyTestServiceEndpoint, nil).
+		Times(1)
+	serviceEndpointClient.
+		EXPECT().
+		ExecuteServiceEndpointRequest(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	require.Nil(t, r.Read(resourceData, clients))
+	require.Equal(t, "unauthorized", resourceData.Get("last_verified_status"))
+}
+
+func TestServiceEndpointVerification_UpdateFailsForInvalidCredentials(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointGeneric()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"verify_on_update": true})
+	flattenServiceEndpointGeneric(resourceData, &verifyTestServiceEndpoint, &verifyTestProjectID)
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+	serviceEndpointClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, gomock.Any()).
+		Return(&verifyTestServiceEndpoint, nil).
+		Times(1)
+	expectServiceEndpointVerification(serviceEndpointClient, &serviceendpoint.ServiceEndpointRequestResult{
+		StatusCode: converter.String("unauthorized"),
+	}, nil)
+
+	err := r.Update(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "failed the verification with status unauthorized")
+	require.Equal(t, "unauthorized", resourceData.Get("last_verified_status"))
+}
+
+func TestServiceEndpointShare_FlattenReadsSharedProjects(t *testing.T) {
+	sharedProjectID := uuid.New()
+	endpoint := verifyTestServiceEndpoint
+	endpoint.ServiceEndpointProjectReferences = &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{Id: &sharedProjectID},
+			Name:             converter.String("UNIT_TEST_CONN_NAME"),
+			Description:      converter.String("UNIT_TEST_SHARED_DESCRIPTION"),
+		},
+		(*verifyTestServiceEndpoint.ServiceEndpointProjectReferences)[0],
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointGeneric().Schema, nil)
+	flattenServiceEndpointGeneric(resourceData, &endpoint, &verifyTestProjectID)
+
+	require.Equal(t, "UNIT_TEST_CONN_DESCRIPTION", resourceData.Get("description"))
+	require.Equal(t, []interface{}{sharedProjectID.String()}, resourceData.Get("shared_project_ids").(*schema.Set).List())
+}
+
+func TestServiceEndpointShare_AddsAndRemovesProjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	addedProjectID := uuid.New()
+	keptProjectID := uuid.New()
+	removedProjectID := uuid.New()
+	endpoint := verifyTestServiceEndpoint
+	endpoint.ServiceEndpointProjectReferences = &[]serviceendpoint.ServiceEndpointProjectReference{
+		(*verifyTestServiceEndpoint.ServiceEndpointProjectReferences)[0],
+		{ProjectReference: &serviceendpoint.ProjectReference{Id: &keptProjectID}},
+		{ProjectReference: &serviceendpoint.ProjectReference{Id: &removedProjectID}},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointGeneric().Schema, map[string]interface{}{
+		"project_id":            verifyTestProjectID.String(),
+		"service_endpoint_name": "UNIT_TEST_CONN_NAME",
+		"description":           "UNIT_TEST_CONN_DESCRIPTION",
+		"shared_project_ids":    []interface{}{addedProjectID.String(), keptProjectID.String()},
+	})
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+	serviceEndpointClient.
+		EXPECT().
+		ShareServiceEndpoint(clients.Ctx, serviceendpoint.ShareServiceEndpointArgs{
+			EndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+				{
+					ProjectReference: &serviceendpoint.ProjectReference{Id: &addedProjectID},
+					Name:             converter.String("UNIT_TEST_CONN_NAME"),
+					Description:      converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+				},
+			},
+			EndpointId: &verifyTestServiceEndpointID,
+		}).
+		Return(nil).
+		Times(1)
+	serviceEndpointClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.Ctx, serviceendpoint.DeleteServiceEndpointArgs{
+			ProjectIds: &[]string{removedProjectID.String()},
+			EndpointId: &verifyTestServiceEndpointID,
+		}).
+		Return(nil).
+		Times(1)
+
+	require.Nil(t, shareServiceEndpoint(clients, resourceData, &endpoint, &verifyTestProjectID))
+}
+
+// verifies that the service endpoint is removed from the last project it is shared with
+func TestServiceEndpointShare_RemovesLastSharedProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	removedProjectID := uuid.New()
+	endpoint := verifyTestServiceEndpoint
+	endpoint.ServiceEndpointProjectReferences = &[]serviceendpoint.ServiceEndpointProjectReference{
+		(*verifyTestServiceEndpoint.ServiceEndpointProjectReferences)[0],
+		{ProjectReference: &serviceendpoint.ProjectReference{Id: &removedProjectID}},
+	}
+
+	r := ResourceServiceEndpointGeneric()
+	config := map[string]interface{}{
+		"project_id":            verifyTestProjectID.String(),
+		"service_endpoint_name": "UNIT_TEST_CONN_NAME",
+		"server_url":            "https://test.com",
+	}
+	state := schema.TestResourceDataRaw(t, r.Schema, config)
+	flattenServiceEndpointGeneric(state, &endpoint, &verifyTestProjectID)
+	require.Equal(t, 1, state.Get("shared_project_ids").(*schema.Set).Len())
+
+	diff, err := r.Diff(state.State(), terraform.NewResourceConfigRaw(config), nil)
+	require.Nil(t, err)
+	require.NotNil(t, diff)
+	require.Contains(t, diff.Attributes, "shared_project_ids.#")
+	require.Equal(t, "0", diff.Attributes["shared_project_ids.#"].New)
+	resourceData, err := schema.InternalMap(r.Schema).Data(state.State(), diff)
+	require.Nil(t, err)
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+	serviceEndpointClient.
+		EXPECT().
+		ShareServiceEndpoint(gomock.Any(), gomock.Any()).
+		Times(0)
+	serviceEndpointClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.Ctx, serviceendpoint.DeleteServiceEndpointArgs{
+			ProjectIds: &[]string{removedProjectID.String()},
+			EndpointId: &verifyTestServiceEndpointID,
+		}).
+		Return(nil).
+		Times(1)
+
+	require.Nil(t, shareServiceEndpoint(clients, resourceData, &endpoint, &verifyTestProjectID))
+}
+
+func TestServiceEndpointShare_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointGeneric().Schema, map[string]interface{}{
+		"project_id":            verifyTestProjectID.String(),
+		"service_endpoint_name": "UNIT_TEST_CONN_NAME",
+		"shared_project_ids":    []interface{}{uuid.New().String()},
+	})
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+	serviceEndpointClient.
+		EXPECT().
+		ShareServiceEndpoint(clients.Ctx, gomock.Any()).
+		Return(errors.New("ShareServiceEndpoint() Failed")).
+		Times(1)
+
+	err := shareServiceEndpoint(clients, resourceData, &verifyTestServiceEndpoint, &verifyTestProjectID)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "ShareServiceEndpoint() Failed")
+}
@@ -72,6 +72,10 @@ func ResourceServiceEndpointArgoCD() *schema.Resource {
 		},
 	}
 
+	makeSecretSourceSchema(at, "token")
+	makeSecretSourceSchema(aup, "username")
+	makeSecretSourceSchema(aup, "password")
+
 	r.Schema["authentication_token"] = &schema.Schema{
 		Type:         schema.TypeList,
 		Optional:     true,
@@ -103,12 +107,24 @@ func expandServiceEndpointArgoCD(d *schema.ResourceData) (*serviceendpoint.Servi
 
 	if x, ok := d.GetOk("authentication_token"); ok {
 		msi := x.([]interface{})[0].(map[string]interface{})
-		authParams["apitoken"] = expandSecret(msi, "token")
+		token, err := expandSecret(msi, "token")
+		if err != nil {
+			return nil, nil, err
+		}
+		authParams["apitoken"] = token
 	} else if x, ok := d.GetOk("authentication_basic"); ok {
 		authScheme = "UsernamePassword"
 		msi := x.([]interface{})[0].(map[string]interface{})
-		authParams["username"] = expandSecret(msi, "username")
-		authParams["password"] = expandSecret(msi, "password")
+		username, err := expandSecret(msi, "username")
+		if err != nil {
+			return nil, nil, err
+		}
+		password, err := expandSecret(msi, "password")
+		if err != nil {
+			return nil, nil, err
+		}
+		authParams["username"] = username
+		authParams["password"] = password
 	}
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &authParams,
@@ -128,6 +144,7 @@ func flattenServiceEndpointArgoCD(d *schema.ResourceData, serviceEndpoint *servi
 			if len(authList) > 0 {
 				newHash, hashKey := tfhelper.HelpFlattenSecretNested(d, "authentication_token", authList, "token")
 				auth[hashKey] = newHash
+				flattenSecretSource(authList, auth, "token", d.HasChange("authentication_token"))
 			}
 		}
 		if serviceEndpoint.Authorization != nil && serviceEndpoint.Authorization.Parameters != nil {
@@ -143,6 +160,8 @@ func flattenServiceEndpointArgoCD(d *schema.ResourceData, serviceEndpoint *servi
 				auth[hashKey] = newHash
 				newHash, hashKey = tfhelper.HelpFlattenSecretNested(d, "authentication_basic", oldAuthList, "username")
 				auth[hashKey] = newHash
+				flattenSecretSource(oldAuthList, auth, "password", d.HasChange("authentication_basic"))
+				flattenSecretSource(oldAuthList, auth, "username", d.HasChange("authentication_basic"))
 			}
 		}
 		if serviceEndpoint.Authorization != nil && serviceEndpoint.Authorization.Parameters != nil {
@@ -71,6 +71,10 @@ func ResourceServiceEndpointArtifactory() *schema.Resource {
 		},
 	}
 
+	makeSecretSourceSchema(at, "token")
+	makeSecretSourceSchema(aup, "username")
+	makeSecretSourceSchema(aup, "password")
+
 	r.Schema["authentication_token"] = &schema.Schema{
 		Type:         schema.TypeList,
 		Optional:     true,
@@ -103,12 +107,24 @@ func expandServiceEndpointArtifactory(d *schema.ResourceData) (*serviceendpoint.
 	if x, ok := d.GetOk("authentication_token"); ok {
 		authScheme = "Token"
 		msi := x.([]interface{})[0].(map[string]interface{})
-		authParams["apitoken"] = expandSecret(msi, "token")
+		token, err := expandSecret(msi, "token")
+		if err != nil {
+			return nil, nil, err
+		}
+		authParams["apitoken"] = token
 	} else if x, ok := d.GetOk("authentication_basic"); ok {
 		authScheme = "UsernamePassword"
 		msi := x.([]interface{})[0].(map[string]interface{})
-		authParams["username"] = expandSecret(msi, "username")
-		authParams["password"] = expandSecret(msi, "password")
+		username, err := expandSecret(msi, "username")
+		if err != nil {
+			return nil, nil, err
+		}
+		password, err := expandSecret(msi, "password")
+		if err != nil {
+			return nil, nil, err
+		}
+		authParams["username"] = username
+		authParams["password"] = password
 	}
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &authParams,
@@ -118,7 +134,7 @@ func expandServiceEndpointArtifactory(d *schema.ResourceData) (*serviceendpoint.
 	return serviceEndpoint, projectID, nil
 }
 
-func expandSecret(credentials map[string]interface{}, key string) string {
+func expandSecret(credentials map[string]interface{}, key string) (string, error) {
 	// Note: if this is an update for a field other than `key`, the `key` will be
 	// set to `""`. Without catching this case and setting the value to `"null"`, the `key` will
 	// actually be set to `""` by the Azure DevOps service.
@@ -126,12 +142,15 @@ func expandSecret(credentials map[string]interface{}, key string) string {
 	// This step is critical in order to ensure that the service connection can update without loosing its password!
 	//
 	// This behavior is unfortunately not documented in the API documentation.
-	val, ok := credentials[key]
-	if !ok || val.(string) == "" {
-		return "null"
+	val, err := expandSecretValue(credentials, key, false)
+	if err != nil {
+		return "", err
+	}
+	if val == "" {
+		return "null", nil
 	}
 
-	return val.(string)
+	return val, nil
 }
 
 // Convert AzDO data structure to internal Terraform data structure
@@ -144,6 +163,7 @@ func flattenServiceEndpointArtifactory(d *schema.ResourceData, serviceEndpoint *
 			if len(authList) > 0 {
 				newHash, hashKey := tfhelper.HelpFlattenSecretNested(d, "authentication_token", authList, "token")
 				auth[hashKey] = newHash
+				flattenSecretSource(authList, auth, "token", d.HasChange("authentication_token"))
 			}
 		}
 		if serviceEndpoint.Authorization != nil && serviceEndpoint.Authorization.Parameters != nil {
@@ -159,6 +179,8 @@ func flattenServiceEndpointArtifactory(d *schema.ResourceData, serviceEndpoint *
 				auth[hashKey] = newHash
 				newHash, hashKey = tfhelper.HelpFlattenSecretNested(d, "authentication_basic", oldAuthList, "username")
 				auth[hashKey] = newHash
+				flattenSecretSource(oldAuthList, auth, "password", d.HasChange("authentication_basic"))
+				flattenSecretSource(oldAuthList, auth, "username", d.HasChange("authentication_basic"))
 			}
 		}
 		if serviceEndpoint.Authorization != nil && serviceEndpoint.Authorization.Parameters != nil {
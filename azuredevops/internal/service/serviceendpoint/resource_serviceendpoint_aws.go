@@ -37,6 +37,8 @@ func ResourceServiceEndpointAws() *schema.Resource {
 	}
 	stSecretHashKey, stSecretHashSchema := tfhelper.GenerateSecreteMemoSchema("session_token")
 	r.Schema[stSecretHashKey] = stSecretHashSchema
+	makeSecretSourceSchema(r, "secret_access_key")
+	makeSecretSourceSchema(r, "session_token")
 	r.Schema["role_to_assume"] = &schema.Schema{
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -61,11 +63,19 @@ func ResourceServiceEndpointAws() *schema.Resource {
 // Convert internal Terraform data structure to an AzDO data structure
 func expandServiceEndpointAws(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
 	serviceEndpoint, projectID := doBaseExpansion(d)
+	secretAccessKey, err := expandResourceSecretValue(d, "secret_access_key", true)
+	if err != nil {
+		return nil, nil, err
+	}
+	sessionToken, err := expandResourceSecretValue(d, "session_token", false)
+	if err != nil {
+		return nil, nil, err
+	}
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
 			"username":        d.Get("access_key_id").(string),
-			"password":        d.Get("secret_access_key").(string),
-			"sessionToken":    d.Get("session_token").(string),
+			"password":        secretAccessKey,
+			"sessionToken":    sessionToken,
 			"assumeRoleArn":   d.Get("role_to_assume").(string),
 			"roleSessionName": d.Get("role_session_name").(string),
 			"externalId":      d.Get("external_id").(string),
@@ -81,8 +91,8 @@ func expandServiceEndpointAws(d *schema.ResourceData) (*serviceendpoint.ServiceE
 func flattenServiceEndpointAws(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID) {
 	doBaseFlattening(d, serviceEndpoint, projectID)
 
-	tfhelper.HelpFlattenSecret(d, "secret_access_key")
-	tfhelper.HelpFlattenSecret(d, "session_token")
+	flattenSecret(d, "secret_access_key")
+	flattenSecret(d, "session_token")
 
 	d.Set("access_key_id", (*serviceEndpoint.Authorization.Parameters)["username"])
 	d.Set("secret_access_key", (*serviceEndpoint.Authorization.Parameters)["password"])
@@ -6,7 +6,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 func ResourceServiceEndpointAzureDevOps() *schema.Resource {
@@ -31,9 +30,13 @@ func ResourceServiceEndpointAzureDevOps() *schema.Resource {
 
 func expandServiceEndpointAzureDevOps(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
 	serviceEndpoint, projectID := doBaseExpansion(d)
+	personalAccessToken, err := expandResourceSecretValue(d, "personal_access_token", true)
+	if err != nil {
+		return nil, nil, err
+	}
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
-			"apitoken": d.Get("personal_access_token").(string),
+			"apitoken": personalAccessToken,
 		},
 		Scheme: converter.String("Token"),
 	}
@@ -48,6 +51,6 @@ func expandServiceEndpointAzureDevOps(d *schema.ResourceData) (*serviceendpoint.
 func flattenServiceEndpointAzureDevOps(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID) {
 	doBaseFlattening(d, serviceEndpoint, projectID)
 	d.Set("org_url", serviceEndpoint.Url)
-	tfhelper.HelpFlattenSecret(d, "password")
+	flattenSecret(d, "personal_access_token")
 	d.Set("release_api_url", (*serviceEndpoint.Data)["releaseUrl"])
 }
@@ -6,17 +6,75 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
+// the authentication schemes of an AzureRM service endpoint
+const (
+	azureRmAuthenticationSchemeServicePrincipal           = "ServicePrincipal"
+	azureRmAuthenticationSchemeManagedServiceIdentity     = "ManagedServiceIdentity"
+	azureRmAuthenticationSchemeWorkloadIdentityFederation = "WorkloadIdentityFederation"
+)
+
+// the scope levels of an AzureRM service endpoint, a subscription scope may be narrowed to a resource group
+const (
+	azureRmScopeLevelSubscription     = "Subscription"
+	azureRmScopeLevelManagementGroup  = "ManagementGroup"
+	azureRmScopeLevelAzureMLWorkspace = "AzureMLWorkspace"
+)
+
 // ResourceServiceEndpointAzureRM schema and implementation for AzureRM service endpoint resource
 func ResourceServiceEndpointAzureRM() *schema.Resource {
 	r := genBaseServiceEndpointResource(flattenServiceEndpointAzureRM, expandServiceEndpointAzureRM)
 	makeUnprotectedSchema(r, "azurerm_spn_tenantid", "ARM_TENANT_ID", "The service principal tenant id which should be used.")
-	makeUnprotectedSchema(r, "azurerm_subscription_id", "ARM_SUBSCRIPTION_ID", "The Azure subscription Id which should be used.")
-	makeUnprotectedSchema(r, "azurerm_subscription_name", "ARM_SUBSCRIPTION_NAME", "The Azure subscription name which should be used.")
+
+	r.Schema["service_endpoint_authentication_scheme"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  azureRmAuthenticationSchemeServicePrincipal,
+		ValidateFunc: validation.StringInSlice([]string{
+			azureRmAuthenticationSchemeServicePrincipal,
+			azureRmAuthenticationSchemeManagedServiceIdentity,
+			azureRmAuthenticationSchemeWorkloadIdentityFederation,
+		}, false),
+		Description: "The authentication scheme of the service endpoint.",
+	}
+
+	// a management group scope does not need a subscription, expandServiceEndpointAzureRM checks that one of them is set
+	r.Schema["azurerm_subscription_id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		DefaultFunc:   schema.EnvDefaultFunc("ARM_SUBSCRIPTION_ID", nil),
+		Description:   "The Azure subscription Id which should be used.",
+		ConflictsWith: []string{"azurerm_management_group_id"},
+	}
+	r.Schema["azurerm_subscription_name"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		DefaultFunc:   schema.EnvDefaultFunc("ARM_SUBSCRIPTION_NAME", nil),
+		Description:   "The Azure subscription name which should be used.",
+		ConflictsWith: []string{"azurerm_management_group_id"},
+	}
+
+	r.Schema["azurerm_management_group_id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ValidateFunc:  validation.StringIsNotWhiteSpace,
+		Description:   "The Azure management group Id which should be used as scope.",
+		RequiredWith:  []string{"azurerm_management_group_name"},
+		ConflictsWith: []string{"azurerm_subscription_id", "azurerm_subscription_name", "resource_group"},
+	}
+	r.Schema["azurerm_management_group_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The Azure management group name which should be used as scope.",
+		RequiredWith: []string{"azurerm_management_group_id"},
+	}
 
 	r.Schema["resource_group"] = &schema.Schema{
 		Type:          schema.TypeString,
@@ -26,29 +84,69 @@ func ResourceServiceEndpointAzureRM() *schema.Resource {
 		ConflictsWith: []string{"credentials"},
 	}
 
+	r.Schema["azureml_workspace_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The name of the Azure Machine Learning workspace in the resource group which should be used as scope.",
+		RequiredWith: []string{"resource_group", "azureml_workspace_location"},
+	}
+	r.Schema["azureml_workspace_location"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The location of the Azure Machine Learning workspace.",
+		RequiredWith: []string{"azureml_workspace_name"},
+	}
+
 	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema("serviceprincipalkey")
+	certificateHashKey, certificateHashSchema := tfhelper.GenerateSecreteMemoSchema("serviceprincipalcertificate")
+	credentials := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"serviceprincipalid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The service principal id which should be used.",
+			},
+			"serviceprincipalkey": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The service principal secret which should be used.",
+				Sensitive:        true,
+				DiffSuppressFunc: tfhelper.DiffFuncSuppressSecretChanged,
+			},
+			secretHashKey: secretHashSchema,
+			"serviceprincipalcertificate": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The PEM encoded certificate and private key of the service principal which should be used instead of a secret.",
+				Sensitive:        true,
+				DiffSuppressFunc: tfhelper.DiffFuncSuppressSecretChanged,
+			},
+			certificateHashKey: certificateHashSchema,
+		},
+	}
+	makeSecretSourceSchema(credentials, "serviceprincipalkey")
+	makeSecretSourceSchema(credentials, "serviceprincipalcertificate")
 	r.Schema["credentials"] = &schema.Schema{
 		Type:          schema.TypeList,
 		Optional:      true,
 		MaxItems:      1,
 		ConflictsWith: []string{"resource_group"},
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"serviceprincipalid": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The service principal id which should be used.",
-				},
-				"serviceprincipalkey": {
-					Type:             schema.TypeString,
-					Required:         true,
-					Description:      "The service principal secret which should be used.",
-					Sensitive:        true,
-					DiffSuppressFunc: tfhelper.DiffFuncSuppressSecretChanged,
-				},
-				secretHashKey: secretHashSchema,
-			},
-		},
+		Elem:          credentials,
+	}
+
+	r.Schema["workload_identity_federation_issuer"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The issuer of the tokens of a service endpoint using workload identity federation.",
+	}
+	r.Schema["workload_identity_federation_subject"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The subject of the tokens of a service endpoint using workload identity federation.",
 	}
 
 	return r
@@ -57,73 +155,168 @@ func ResourceServiceEndpointAzureRM() *schema.Resource {
 // Convert internal Terraform data structure to an AzDO data structure
 func expandServiceEndpointAzureRM(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
 	serviceEndpoint, projectID := doBaseExpansion(d)
+	authenticationScheme := d.Get("service_endpoint_authentication_scheme").(string)
 
-	scope := fmt.Sprintf("/subscriptions/%s", d.Get("azurerm_subscription_id"))
-	scopeLevel := "Subscription"
-	if _, ok := d.GetOk("resource_group"); ok {
-		scope += fmt.Sprintf("/resourcegroups/%s", d.Get("resource_group"))
-		scopeLevel = "ResourceGroup"
+	parameters := map[string]string{
+		"tenantid": d.Get("azurerm_spn_tenantid").(string),
 	}
-
-	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
-		Parameters: &map[string]string{
-			"authenticationType":  "spnKey",
-			"serviceprincipalid":  "",
-			"serviceprincipalkey": "",
-			"tenantid":            d.Get("azurerm_spn_tenantid").(string),
-		},
-		Scheme: converter.String("ServicePrincipal"),
+	data := map[string]string{
+		"creationMode": "Automatic",
+		"environment":  "AzureCloud",
 	}
-	serviceEndpoint.Data = &map[string]string{
-		"creationMode":     "Automatic",
-		"environment":      "AzureCloud",
-		"scopeLevel":       "Subscription",
-		"subscriptionId":   d.Get("azurerm_subscription_id").(string),
-		"subscriptionName": d.Get("azurerm_subscription_name").(string),
+	if err := expandServiceEndpointAzureRMScope(d, parameters, data); err != nil {
+		return nil, nil, err
 	}
 
-	if scopeLevel == "ResourceGroup" {
-		(*serviceEndpoint.Authorization.Parameters)["scope"] = scope
+	var credentials map[string]interface{}
+	if _, ok := d.GetOk("credentials"); ok {
+		credentials = d.Get("credentials").([]interface{})[0].(map[string]interface{})
 	}
 
-	if _, ok := d.GetOk("credentials"); ok {
-		credentials := d.Get("credentials").([]interface{})[0].(map[string]interface{})
-		(*serviceEndpoint.Authorization.Parameters)["serviceprincipalid"] = credentials["serviceprincipalid"].(string)
-		(*serviceEndpoint.Authorization.Parameters)["serviceprincipalkey"] = credentials["serviceprincipalkey"].(string)
-		(*serviceEndpoint.Data)["creationMode"] = "Manual"
+	switch authenticationScheme {
+	case azureRmAuthenticationSchemeServicePrincipal:
+		parameters["authenticationType"] = "spnKey"
+		parameters["serviceprincipalid"] = ""
+		parameters["serviceprincipalkey"] = ""
+		if credentials != nil {
+			certificate, err := expandSecretValue(credentials, "serviceprincipalcertificate", false)
+			if err != nil {
+				return nil, nil, err
+			}
+			parameters["serviceprincipalid"] = credentials["serviceprincipalid"].(string)
+			if certificate != "" {
+				delete(parameters, "serviceprincipalkey")
+				parameters["authenticationType"] = "spnCertificate"
+				parameters["servicePrincipalCertificate"] = certificate
+			} else {
+				servicePrincipalKey, err := expandSecretValue(credentials, "serviceprincipalkey", true)
+				if err != nil {
+					return nil, nil, err
+				}
+				parameters["serviceprincipalkey"] = servicePrincipalKey
+			}
+			data["creationMode"] = "Manual"
+		}
+	case azureRmAuthenticationSchemeWorkloadIdentityFederation:
+		// the tokens are issued by Azure DevOps, a manually created service principal only needs the federated credential
+		if credentials != nil {
+			if err := expandServiceEndpointAzureRMNoSecrets(credentials, authenticationScheme); err != nil {
+				return nil, nil, err
+			}
+			parameters["serviceprincipalid"] = credentials["serviceprincipalid"].(string)
+			data["creationMode"] = "Manual"
+		}
+	case azureRmAuthenticationSchemeManagedServiceIdentity:
+		if credentials != nil {
+			return nil, nil, fmt.Errorf("credentials can not be used with the authentication scheme %s", authenticationScheme)
+		}
+		data["creationMode"] = "Manual"
 	}
 
+	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &parameters,
+		Scheme:     converter.String(authenticationScheme),
+	}
+	serviceEndpoint.Data = &data
 	serviceEndpoint.Type = converter.String("azurerm")
 	serviceEndpoint.Url = converter.String("https://management.azure.com/")
 	return serviceEndpoint, projectID, nil
 }
 
-func flattenCredentials(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, hashKey string, hashValue string) interface{} {
+// expandServiceEndpointAzureRMScope sets the scope of the service endpoint, which is a management group or a
+// subscription that may be narrowed to a resource group or an Azure Machine Learning workspace
+func expandServiceEndpointAzureRMScope(d *schema.ResourceData, parameters map[string]string, data map[string]string) error {
+	if managementGroupID, ok := d.GetOk("azurerm_management_group_id"); ok {
+		data["scopeLevel"] = azureRmScopeLevelManagementGroup
+		data["managementGroupId"] = managementGroupID.(string)
+		data["managementGroupName"] = d.Get("azurerm_management_group_name").(string)
+		return nil
+	}
+
+	subscriptionID := d.Get("azurerm_subscription_id").(string)
+	if subscriptionID == "" {
+		return fmt.Errorf("one of azurerm_subscription_id or azurerm_management_group_id must be set")
+	}
+	data["scopeLevel"] = azureRmScopeLevelSubscription
+	data["subscriptionId"] = subscriptionID
+	data["subscriptionName"] = d.Get("azurerm_subscription_name").(string)
+
+	if resourceGroup, ok := d.GetOk("resource_group"); ok {
+		scope := fmt.Sprintf("/subscriptions/%s/resourcegroups/%s", subscriptionID, resourceGroup)
+		if workspace, ok := d.GetOk("azureml_workspace_name"); ok {
+			scope += fmt.Sprintf("/providers/Microsoft.MachineLearningServices/workspaces/%s", workspace)
+			data["scopeLevel"] = azureRmScopeLevelAzureMLWorkspace
+			data["resourceGroupName"] = resourceGroup.(string)
+			data["mlWorkspaceName"] = workspace.(string)
+			data["mlWorkspaceLocation"] = d.Get("azureml_workspace_location").(string)
+			data["mlWorkspaceId"] = scope
+		}
+		parameters["scope"] = scope
+	}
+	return nil
+}
+
+// expandServiceEndpointAzureRMNoSecrets rejects the secrets of a service principal for authentication schemes without them
+func expandServiceEndpointAzureRMNoSecrets(credentials map[string]interface{}, authenticationScheme string) error {
+	for _, key := range []string{"serviceprincipalkey", "serviceprincipalcertificate"} {
+		secret, err := expandSecretValue(credentials, key, false)
+		if err != nil {
+			return err
+		}
+		if secret != "" {
+			return fmt.Errorf("%s can not be used with the authentication scheme %s", key, authenticationScheme)
+		}
+	}
+	return nil
+}
+
+func flattenCredentials(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint) interface{} {
 	// secret value won't return by service and should not be overwritten
-	return []map[string]interface{}{{
-		"serviceprincipalid":  (*serviceEndpoint.Authorization.Parameters)["serviceprincipalid"],
-		"serviceprincipalkey": d.Get("credentials.0.serviceprincipalkey").(string),
-		hashKey:               hashValue,
-	}}
+	configuration := d.Get("credentials.0").(map[string]interface{})
+	credentials := map[string]interface{}{
+		"serviceprincipalid":          (*serviceEndpoint.Authorization.Parameters)["serviceprincipalid"],
+		"serviceprincipalkey":         d.Get("credentials.0.serviceprincipalkey").(string),
+		"serviceprincipalcertificate": d.Get("credentials.0.serviceprincipalcertificate").(string),
+	}
+	for _, key := range []string{"serviceprincipalkey", "serviceprincipalcertificate"} {
+		newHash, hashKey := tfhelper.HelpFlattenSecretNested(d, "credentials", configuration, key)
+		credentials[hashKey] = newHash
+		flattenSecretSource(configuration, credentials, key, d.HasChange("credentials"))
+	}
+	return []map[string]interface{}{credentials}
 }
 
 // Convert AzDO data structure to internal Terraform data structure
 func flattenServiceEndpointAzureRM(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID) {
 	doBaseFlattening(d, serviceEndpoint, projectID)
-	scope := (*serviceEndpoint.Authorization.Parameters)["scope"]
+	authenticationScheme := converter.ToString(serviceEndpoint.Authorization.Scheme, azureRmAuthenticationSchemeServicePrincipal)
+	d.Set("service_endpoint_authentication_scheme", authenticationScheme)
 
-	if (*serviceEndpoint.Data)["creationMode"] == "Manual" {
-		newHash, hashKey := tfhelper.HelpFlattenSecretNested(d, "credentials", d.Get("credentials.0").(map[string]interface{}), "serviceprincipalkey")
-		credentials := flattenCredentials(d, serviceEndpoint, hashKey, newHash)
-		d.Set("credentials", credentials)
+	if (*serviceEndpoint.Data)["creationMode"] == "Manual" && authenticationScheme != azureRmAuthenticationSchemeManagedServiceIdentity {
+		d.Set("credentials", flattenCredentials(d, serviceEndpoint))
 	}
 
-	s := strings.SplitN(scope, "/", -1)
-	if len(s) == 5 {
-		d.Set("resource_group", s[4])
+	if authenticationScheme == azureRmAuthenticationSchemeWorkloadIdentityFederation {
+		d.Set("workload_identity_federation_issuer", (*serviceEndpoint.Authorization.Parameters)["workloadIdentityFederationIssuer"])
+		d.Set("workload_identity_federation_subject", (*serviceEndpoint.Authorization.Parameters)["workloadIdentityFederationSubject"])
 	}
 
 	d.Set("azurerm_spn_tenantid", (*serviceEndpoint.Authorization.Parameters)["tenantid"])
+	if (*serviceEndpoint.Data)["scopeLevel"] == azureRmScopeLevelManagementGroup {
+		d.Set("azurerm_management_group_id", (*serviceEndpoint.Data)["managementGroupId"])
+		d.Set("azurerm_management_group_name", (*serviceEndpoint.Data)["managementGroupName"])
+		return
+	}
+
+	s := strings.SplitN((*serviceEndpoint.Authorization.Parameters)["scope"], "/", -1)
+	if len(s) >= 5 && strings.EqualFold(s[3], "resourcegroups") {
+		d.Set("resource_group", s[4])
+	}
+	if (*serviceEndpoint.Data)["scopeLevel"] == azureRmScopeLevelAzureMLWorkspace {
+		d.Set("azureml_workspace_name", (*serviceEndpoint.Data)["mlWorkspaceName"])
+		d.Set("azureml_workspace_location", (*serviceEndpoint.Data)["mlWorkspaceLocation"])
+	}
+
 	d.Set("azurerm_subscription_id", (*serviceEndpoint.Data)["subscriptionId"])
 	d.Set("azurerm_subscription_name", (*serviceEndpoint.Data)["subscriptionName"])
 }
@@ -7,6 +7,8 @@ package serviceendpoint
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -16,6 +18,7 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/secretmemo"
 	"github.com/stretchr/testify/require"
 )
 
@@ -255,6 +258,205 @@ func TestServiceEndpointAzureRM_Update_DoesNotSwallowError(t *testing.T) {
 	}
 }
 
+// verifies that the service principal key can be read from a file instead of being set inline
+func TestServiceEndpointAzureRM_ExpandReadsServicePrincipalKeyFromFile(t *testing.T) {
+	endpoint := getManualAuthServiceEndpoint()
+	key := (*endpoint.Authorization.Parameters)["serviceprincipalkey"]
+	keyFile := filepath.Join(t.TempDir(), "serviceprincipalkey")
+	require.Nil(t, os.WriteFile(keyFile, []byte(key+"\n"), 0600))
+	_, keyHash, err := secretmemo.IsUpdating(key, "")
+	require.Nil(t, err)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointAzureRM().Schema, nil)
+	resourceData.Set("credentials", []map[string]interface{}{{
+		"serviceprincipalid":       (*endpoint.Authorization.Parameters)["serviceprincipalid"],
+		"serviceprincipalkey_file": keyFile,
+		"serviceprincipalkey_hash": keyHash,
+	}})
+	flattenServiceEndpointAzureRM(resourceData, &endpoint, azurermTestServiceEndpointAzureRMProjectID)
+	require.Equal(t, keyFile, resourceData.Get("credentials.0.serviceprincipalkey_file"))
+	require.Equal(t, keyHash, resourceData.Get("credentials.0.serviceprincipalkey_hash"))
+	require.Empty(t, resourceData.Get("credentials.0.serviceprincipalkey"))
+
+	expandedEndpoint, _, err := expandServiceEndpointAzureRM(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, endpoint, *expandedEndpoint)
+}
+
+func getAzureRMResourceData(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	config := map[string]interface{}{
+		"project_id":            azurermTestServiceEndpointAzureRMProjectID.String(),
+		"service_endpoint_name": "_AZURERM_UNIT_TEST_CONN_NAME",
+		"azurerm_spn_tenantid":  "aba07645-051c-44b4-b806-c34d33f3dcd1",
+	}
+	for key, value := range raw {
+		config[key] = value
+	}
+	return schema.TestResourceDataRaw(t, ResourceServiceEndpointAzureRM().Schema, config)
+}
+
+// verifies that a service endpoint using workload identity federation is created without a secret
+func TestServiceEndpointAzureRM_ExpandWorkloadIdentityFederation(t *testing.T) {
+	resourceData := getAzureRMResourceData(t, map[string]interface{}{
+		"service_endpoint_authentication_scheme": "WorkloadIdentityFederation",
+		"azurerm_subscription_id":                "42125daf-72fd-417c-9ea7-080690625ad3",
+		"azurerm_subscription_name":              "SUBSCRIPTION_TEST",
+		"credentials": []interface{}{map[string]interface{}{
+			"serviceprincipalid": "e31eaaac-47da-4156-b433-9b0538c94b7e",
+		}},
+	})
+
+	endpoint, _, err := expandServiceEndpointAzureRM(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, "WorkloadIdentityFederation", *endpoint.Authorization.Scheme)
+	require.Equal(t, map[string]string{
+		"serviceprincipalid": "e31eaaac-47da-4156-b433-9b0538c94b7e",
+		"tenantid":           "aba07645-051c-44b4-b806-c34d33f3dcd1",
+	}, *endpoint.Authorization.Parameters)
+	require.Equal(t, "Manual", (*endpoint.Data)["creationMode"])
+	require.Equal(t, "Subscription", (*endpoint.Data)["scopeLevel"])
+}
+
+// verifies that the issuer and subject of a service endpoint using workload identity federation are exported
+func TestServiceEndpointAzureRM_FlattenWorkloadIdentityFederation(t *testing.T) {
+	endpoint := getManualAuthServiceEndpoint()
+	endpoint.Authorization.Scheme = converter.String("WorkloadIdentityFederation")
+	endpoint.Authorization.Parameters = &map[string]string{
+		"serviceprincipalid":                "e31eaaac-47da-4156-b433-9b0538c94b7e",
+		"tenantid":                          "aba07645-051c-44b4-b806-c34d33f3dcd1",
+		"workloadIdentityFederationIssuer":  "https://vstoken.dev.azure.com/00000000-0000-0000-0000-000000000000",
+		"workloadIdentityFederationSubject": "sc://organization/project/_AZURERM_UNIT_TEST_CONN_NAME",
+	}
+
+	resourceData := getAzureRMResourceData(t, nil)
+	flattenServiceEndpointAzureRM(resourceData, &endpoint, azurermTestServiceEndpointAzureRMProjectID)
+
+	require.Equal(t, "WorkloadIdentityFederation", resourceData.Get("service_endpoint_authentication_scheme"))
+	require.Equal(t, "https://vstoken.dev.azure.com/00000000-0000-0000-0000-000000000000", resourceData.Get("workload_identity_federation_issuer"))
+	require.Equal(t, "sc://organization/project/_AZURERM_UNIT_TEST_CONN_NAME", resourceData.Get("workload_identity_federation_subject"))
+	require.Equal(t, "e31eaaac-47da-4156-b433-9b0538c94b7e", resourceData.Get("credentials.0.serviceprincipalid"))
+}
+
+// verifies that a secret is rejected for service endpoints using workload identity federation
+func TestServiceEndpointAzureRM_ExpandWorkloadIdentityFederationRejectsSecret(t *testing.T) {
+	resourceData := getAzureRMResourceData(t, map[string]interface{}{
+		"service_endpoint_authentication_scheme": "WorkloadIdentityFederation",
+		"azurerm_subscription_id":                "42125daf-72fd-417c-9ea7-080690625ad3",
+		"credentials": []interface{}{map[string]interface{}{
+			"serviceprincipalid":  "e31eaaac-47da-4156-b433-9b0538c94b7e",
+			"serviceprincipalkey": "d96d8515-20b2-4413-8879-27c5d040cbc2",
+		}},
+	})
+
+	_, _, err := expandServiceEndpointAzureRM(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "serviceprincipalkey can not be used with the authentication scheme WorkloadIdentityFederation")
+}
+
+// verifies that credentials are rejected for service endpoints using a managed identity
+func TestServiceEndpointAzureRM_ExpandManagedServiceIdentity(t *testing.T) {
+	resourceData := getAzureRMResourceData(t, map[string]interface{}{
+		"service_endpoint_authentication_scheme": "ManagedServiceIdentity",
+		"azurerm_subscription_id":                "42125daf-72fd-417c-9ea7-080690625ad3",
+	})
+
+	endpoint, _, err := expandServiceEndpointAzureRM(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, "ManagedServiceIdentity", *endpoint.Authorization.Scheme)
+	require.Equal(t, map[string]string{"tenantid": "aba07645-051c-44b4-b806-c34d33f3dcd1"}, *endpoint.Authorization.Parameters)
+
+	resourceData = getAzureRMResourceData(t, map[string]interface{}{
+		"service_endpoint_authentication_scheme": "ManagedServiceIdentity",
+		"azurerm_subscription_id":                "42125daf-72fd-417c-9ea7-080690625ad3",
+		"credentials": []interface{}{map[string]interface{}{
+			"serviceprincipalid": "e31eaaac-47da-4156-b433-9b0538c94b7e",
+		}},
+	})
+	_, _, err = expandServiceEndpointAzureRM(resourceData)
+	require.NotNil(t, err)
+}
+
+// verifies that a service principal can authenticate with a certificate instead of a secret
+func TestServiceEndpointAzureRM_ExpandServicePrincipalCertificate(t *testing.T) {
+	resourceData := getAzureRMResourceData(t, map[string]interface{}{
+		"azurerm_subscription_id": "42125daf-72fd-417c-9ea7-080690625ad3",
+		"credentials": []interface{}{map[string]interface{}{
+			"serviceprincipalid":          "e31eaaac-47da-4156-b433-9b0538c94b7e",
+			"serviceprincipalcertificate": "-----BEGIN CERTIFICATE-----",
+		}},
+	})
+
+	endpoint, _, err := expandServiceEndpointAzureRM(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, "ServicePrincipal", *endpoint.Authorization.Scheme)
+	require.Equal(t, map[string]string{
+		"authenticationType":          "spnCertificate",
+		"serviceprincipalid":          "e31eaaac-47da-4156-b433-9b0538c94b7e",
+		"servicePrincipalCertificate": "-----BEGIN CERTIFICATE-----",
+		"tenantid":                    "aba07645-051c-44b4-b806-c34d33f3dcd1",
+	}, *endpoint.Authorization.Parameters)
+}
+
+// verifies that the scope of a service endpoint can be a management group
+func TestServiceEndpointAzureRM_ExpandFlattenManagementGroupScope(t *testing.T) {
+	resourceData := getAzureRMResourceData(t, map[string]interface{}{
+		"service_endpoint_authentication_scheme": "WorkloadIdentityFederation",
+		"azurerm_management_group_id":            "contoso",
+		"azurerm_management_group_name":          "Contoso",
+	})
+
+	endpoint, _, err := expandServiceEndpointAzureRM(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{
+		"creationMode":        "Automatic",
+		"environment":         "AzureCloud",
+		"scopeLevel":          "ManagementGroup",
+		"managementGroupId":   "contoso",
+		"managementGroupName": "Contoso",
+	}, *endpoint.Data)
+
+	endpoint.Id = &azurermTestServiceEndpointAzureRMID
+	flattenedData := getAzureRMResourceData(t, nil)
+	flattenServiceEndpointAzureRM(flattenedData, endpoint, azurermTestServiceEndpointAzureRMProjectID)
+	require.Equal(t, "contoso", flattenedData.Get("azurerm_management_group_id"))
+	require.Equal(t, "Contoso", flattenedData.Get("azurerm_management_group_name"))
+}
+
+// verifies that a management group or a subscription is required
+func TestServiceEndpointAzureRM_ExpandRequiresScope(t *testing.T) {
+	resourceData := getAzureRMResourceData(t, nil)
+	resourceData.Set("azurerm_subscription_id", "")
+
+	_, _, err := expandServiceEndpointAzureRM(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "one of azurerm_subscription_id or azurerm_management_group_id must be set")
+}
+
+// verifies that the scope of a service endpoint can be an Azure Machine Learning workspace
+func TestServiceEndpointAzureRM_ExpandFlattenAzureMLWorkspaceScope(t *testing.T) {
+	resourceData := getAzureRMResourceData(t, map[string]interface{}{
+		"azurerm_subscription_id":    "42125daf-72fd-417c-9ea7-080690625ad3",
+		"azurerm_subscription_name":  "SUBSCRIPTION_TEST",
+		"resource_group":             "test",
+		"azureml_workspace_name":     "workspace",
+		"azureml_workspace_location": "westeurope",
+	})
+
+	endpoint, _, err := expandServiceEndpointAzureRM(resourceData)
+	require.Nil(t, err)
+	scope := "/subscriptions/42125daf-72fd-417c-9ea7-080690625ad3/resourcegroups/test/providers/Microsoft.MachineLearningServices/workspaces/workspace"
+	require.Equal(t, scope, (*endpoint.Authorization.Parameters)["scope"])
+	require.Equal(t, "AzureMLWorkspace", (*endpoint.Data)["scopeLevel"])
+	require.Equal(t, scope, (*endpoint.Data)["mlWorkspaceId"])
+
+	endpoint.Id = &azurermTestServiceEndpointAzureRMID
+	flattenedData := getAzureRMResourceData(t, nil)
+	flattenServiceEndpointAzureRM(flattenedData, endpoint, azurermTestServiceEndpointAzureRMProjectID)
+	require.Equal(t, "test", flattenedData.Get("resource_group"))
+	require.Equal(t, "workspace", flattenedData.Get("azureml_workspace_name"))
+	require.Equal(t, "westeurope", flattenedData.Get("azureml_workspace_location"))
+}
+
 // This is a little different than most. The steps done, along with the motivation behind each, are as follows:
 //	(1) The service endpoint is configured. The `serviceprincipalkey` is set to `""`, which matches
 //		the Azure DevOps API behavior. The service will intentionally hide the value of
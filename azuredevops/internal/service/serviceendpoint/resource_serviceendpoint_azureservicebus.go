@@ -0,0 +1,68 @@
+package serviceendpoint
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceServiceEndpointAzureServiceBus schema and implementation for Azure Service Bus service endpoint resource
+func ResourceServiceEndpointAzureServiceBus() *schema.Resource {
+	r := genBaseServiceEndpointResource(flattenServiceEndpointAzureServiceBus, expandServiceEndpointAzureServiceBus)
+
+	r.Schema["queue_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The name of the Azure Service Bus queue the messages are published to",
+	}
+
+	r.Schema["connection_string"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSuppressSecretChanged,
+		Description:      "The connection string of the Azure Service Bus namespace or queue",
+	}
+	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema("connection_string")
+	r.Schema[secretHashKey] = secretHashSchema
+	makeSecretSourceSchema(r, "connection_string")
+
+	return r
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandServiceEndpointAzureServiceBus(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
+	serviceEndpoint, projectID := doBaseExpansion(d)
+	connectionString, err := expandResourceSecretValue(d, "connection_string", true)
+	if err != nil {
+		return nil, nil, err
+	}
+	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"serviceBusConnectionString": connectionString,
+		},
+		Scheme: converter.String("None"),
+	}
+	serviceEndpoint.Data = &map[string]string{
+		"serviceBusQueueName": d.Get("queue_name").(string),
+	}
+	serviceEndpoint.Type = converter.String("AzureServiceBus")
+	serviceEndpoint.Url = converter.String("https://www.windowsazure.com/")
+	return serviceEndpoint, projectID, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenServiceEndpointAzureServiceBus(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID) {
+	doBaseFlattening(d, serviceEndpoint, projectID)
+
+	flattenSecret(d, "connection_string")
+
+	d.Set("connection_string", (*serviceEndpoint.Authorization.Parameters)["serviceBusConnectionString"])
+	if serviceEndpoint.Data != nil {
+		d.Set("queue_name", (*serviceEndpoint.Data)["serviceBusQueueName"])
+	}
+}
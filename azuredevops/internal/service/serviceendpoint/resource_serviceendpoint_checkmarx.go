@@ -0,0 +1,94 @@
+package serviceendpoint
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceServiceEndpointCheckmarx schema and implementation for Checkmarx service endpoint resource
+func ResourceServiceEndpointCheckmarx() *schema.Resource {
+	r := genBaseServiceEndpointResource(flattenServiceEndpointCheckmarx, expandServiceEndpointCheckmarx)
+
+	r.Schema["url"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		Description:  "Url of the Checkmarx server",
+	}
+
+	r.Schema["team"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The full name of the Checkmarx team the projects are scanned for",
+	}
+
+	r.Schema["preset"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The name of the Checkmarx preset used for the scans",
+	}
+
+	r.Schema["username"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The user name of the Checkmarx user",
+	}
+
+	r.Schema["password"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSuppressSecretChanged,
+		Description:      "The password of the Checkmarx user",
+	}
+	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema("password")
+	r.Schema[secretHashKey] = secretHashSchema
+	makeSecretSourceSchema(r, "password")
+
+	return r
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandServiceEndpointCheckmarx(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
+	serviceEndpoint, projectID := doBaseExpansion(d)
+	password, err := expandResourceSecretValue(d, "password", true)
+	if err != nil {
+		return nil, nil, err
+	}
+	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"username": d.Get("username").(string),
+			"password": password,
+		},
+		Scheme: converter.String("UsernamePassword"),
+	}
+	serviceEndpoint.Data = &map[string]string{
+		"team":   d.Get("team").(string),
+		"preset": d.Get("preset").(string),
+	}
+	serviceEndpoint.Type = converter.String("Checkmarx-Endpoint")
+	serviceEndpoint.Url = converter.String(d.Get("url").(string))
+	return serviceEndpoint, projectID, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenServiceEndpointCheckmarx(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID) {
+	doBaseFlattening(d, serviceEndpoint, projectID)
+
+	flattenSecret(d, "password")
+
+	d.Set("url", *serviceEndpoint.Url)
+	d.Set("username", (*serviceEndpoint.Authorization.Parameters)["username"])
+	d.Set("password", (*serviceEndpoint.Authorization.Parameters)["password"])
+	if serviceEndpoint.Data != nil {
+		d.Set("team", (*serviceEndpoint.Data)["team"])
+		d.Set("preset", (*serviceEndpoint.Data)["preset"])
+	}
+}
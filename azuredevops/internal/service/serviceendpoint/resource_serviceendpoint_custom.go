@@ -0,0 +1,309 @@
+package serviceendpoint
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/forminput"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/secretmemo"
+)
+
+const (
+	customAuthorizationParameters     = "authorization_parameters"
+	customAuthorizationParametersHash = "authorization_parameters_hash"
+)
+
+// ResourceServiceEndpointCustom schema and implementation for service endpoints of any type, e.g. the types
+// contributed by extensions
+func ResourceServiceEndpointCustom() *schema.Resource {
+	r := genBaseServiceEndpointResource(flattenServiceEndpointCustom, expandServiceEndpointCustom)
+	r.Create = genServiceEndpointCustomValidateFunc(r.Create)
+	r.Update = genServiceEndpointCustomValidateFunc(r.Update)
+
+	r.Schema["type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The type of the service endpoint as registered by Azure DevOps or the extension contributing it",
+	}
+
+	r.Schema["url"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		Description:  "Url of the service endpoint, defaults to the url of the service endpoint type",
+	}
+
+	r.Schema["authorization_scheme"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The authorization scheme of the service endpoint type used by the service endpoint",
+	}
+
+	r.Schema[customAuthorizationParameters] = &schema.Schema{
+		Type:             schema.TypeMap,
+		Optional:         true,
+		Computed:         true,
+		Sensitive:        true,
+		DiffSuppressFunc: suppressCustomAuthorizationParameterChanged,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Description: "The parameters of the authorization scheme",
+	}
+
+	r.Schema[customAuthorizationParametersHash] = &schema.Schema{
+		Type:      schema.TypeMap,
+		Computed:  true,
+		Sensitive: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Description: fmt.Sprintf("The bcrypted hashes of the values of '%s'", customAuthorizationParameters),
+	}
+
+	r.Schema["data"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Description: "The data of the service endpoint as defined by the inputs of the service endpoint type",
+	}
+
+	return r
+}
+
+// suppressCustomAuthorizationParameterChanged suppresses the changes of the authorization parameters whose values
+// are not returned by the service and whose configured value matches the hash stored on the last apply
+func suppressCustomAuthorizationParameterChanged(k, old, new string, d *schema.ResourceData) bool {
+	key := strings.TrimPrefix(k, customAuthorizationParameters+".")
+	if key == "%" || old != "" {
+		return false
+	}
+	hash, _ := d.Get(customAuthorizationParametersHash).(map[string]interface{})[key].(string)
+	isUpdating, _, err := secretmemo.IsUpdating(new, hash)
+	if err != nil {
+		log.Printf("Change forced. Swallowing err while using secret hashing: %s", err)
+		return false
+	}
+	return hash != "" && !isUpdating
+}
+
+// genServiceEndpointCustomValidateFunc validates the service endpoint against the metadata of its type before
+// it is created or updated
+func genServiceEndpointCustomValidateFunc(f func(d *schema.ResourceData, m interface{}) error) func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*client.AggregatedClient)
+		serviceEndpoint, _, err := expandServiceEndpointCustom(d)
+		if err != nil {
+			return fmt.Errorf(errMsgTfConfigRead, err)
+		}
+
+		endpointType, err := getServiceEndpointType(clients, *serviceEndpoint.Type)
+		if err != nil {
+			return err
+		}
+		if err := validateServiceEndpointCustom(endpointType, serviceEndpoint); err != nil {
+			return err
+		}
+		if d.Get("url").(string) == "" && endpointType.EndpointUrl != nil && endpointType.EndpointUrl.Value != nil {
+			d.Set("url", *endpointType.EndpointUrl.Value)
+		}
+		return f(d, m)
+	}
+}
+
+// getServiceEndpointType returns the metadata of the service endpoint type
+func getServiceEndpointType(clients *client.AggregatedClient, endpointType string) (*serviceendpoint.ServiceEndpointType, error) {
+	endpointTypes, err := clients.ServiceEndpointClient.GetServiceEndpointTypes(
+		clients.Ctx,
+		serviceendpoint.GetServiceEndpointTypesArgs{
+			Type: converter.String(endpointType),
+		})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the service endpoint type %s: %+v", endpointType, err)
+	}
+	if endpointTypes != nil {
+		for _, t := range *endpointTypes {
+			if t.Name != nil && strings.EqualFold(*t.Name, endpointType) {
+				return &t, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("The service endpoint type %s is not available in the organization, the extension contributing it may not be installed", endpointType)
+}
+
+// validateServiceEndpointCustom verifies that the authorization scheme is supported by the service endpoint type
+// and that the required inputs of the scheme and the type are set
+func validateServiceEndpointCustom(endpointType *serviceendpoint.ServiceEndpointType, serviceEndpoint *serviceendpoint.ServiceEndpoint) error {
+	var scheme *serviceendpoint.ServiceEndpointAuthenticationScheme
+	var schemes []string
+	if endpointType.AuthenticationSchemes != nil {
+		for i, s := range *endpointType.AuthenticationSchemes {
+			if s.Scheme == nil {
+				continue
+			}
+			schemes = append(schemes, *s.Scheme)
+			if strings.EqualFold(*s.Scheme, *serviceEndpoint.Authorization.Scheme) {
+				scheme = &(*endpointType.AuthenticationSchemes)[i]
+			}
+		}
+	}
+	if scheme == nil {
+		return fmt.Errorf("The authorization scheme %s is not supported by the service endpoint type %s, supported schemes are: %s", *serviceEndpoint.Authorization.Scheme, *serviceEndpoint.Type, strings.Join(schemes, ", "))
+	}
+
+	parameters := *serviceEndpoint.Authorization.Parameters
+	if scheme.InputDescriptors != nil && len(*scheme.InputDescriptors) > 0 {
+		known := map[string]bool{}
+		for _, input := range *scheme.InputDescriptors {
+			if input.Id != nil {
+				known[strings.ToLower(*input.Id)] = true
+			}
+		}
+		var unknown []string
+		for key := range parameters {
+			if !known[strings.ToLower(key)] {
+				unknown = append(unknown, key)
+			}
+		}
+		if len(unknown) > 0 {
+			sort.Strings(unknown)
+			return fmt.Errorf("The authorization scheme %s of the service endpoint type %s does not support the parameters: %s", *scheme.Scheme, *serviceEndpoint.Type, strings.Join(unknown, ", "))
+		}
+	}
+
+	if missing := missingServiceEndpointInputs(scheme.InputDescriptors, parameters); len(missing) > 0 {
+		return fmt.Errorf("The authorization scheme %s of the service endpoint type %s requires the parameters: %s", *scheme.Scheme, *serviceEndpoint.Type, strings.Join(missing, ", "))
+	}
+	if missing := missingServiceEndpointInputs(endpointType.InputDescriptors, *serviceEndpoint.Data); len(missing) > 0 {
+		return fmt.Errorf("The service endpoint type %s requires the data: %s", *serviceEndpoint.Type, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// missingServiceEndpointInputs returns the IDs of the required inputs that are not set
+func missingServiceEndpointInputs(inputs *[]forminput.InputDescriptor, values map[string]string) []string {
+	if inputs == nil {
+		return nil
+	}
+	set := map[string]bool{}
+	for key, value := range values {
+		if value != "" {
+			set[strings.ToLower(key)] = true
+		}
+	}
+
+	var missing []string
+	for _, input := range *inputs {
+		if input.Id == nil || input.Validation == nil || input.Validation.IsRequired == nil || !*input.Validation.IsRequired {
+			continue
+		}
+		if input.Values != nil && input.Values.DefaultValue != nil && *input.Values.DefaultValue != "" {
+			continue
+		}
+		if !set[strings.ToLower(*input.Id)] {
+			missing = append(missing, *input.Id)
+		}
+	}
+	return missing
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandServiceEndpointCustom(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
+	serviceEndpoint, projectID := doBaseExpansion(d)
+	serviceEndpoint.Type = converter.String(d.Get("type").(string))
+	serviceEndpoint.Url = converter.String(d.Get("url").(string))
+
+	hashes := d.Get(customAuthorizationParametersHash).(map[string]interface{})
+	parameters := map[string]string{}
+	for key, value := range d.Get(customAuthorizationParameters).(map[string]interface{}) {
+		parameter := value.(string)
+		if hash, _ := hashes[key].(string); parameter == "" && hash != "" {
+			// the secret is not returned by the service, "null" keeps the secret of the service endpoint
+			parameter = "null"
+		}
+		parameters[key] = parameter
+	}
+	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &parameters,
+		Scheme:     converter.String(d.Get("authorization_scheme").(string)),
+	}
+
+	data := map[string]string{}
+	for key, value := range d.Get("data").(map[string]interface{}) {
+		data[key] = value.(string)
+	}
+	serviceEndpoint.Data = &data
+	return serviceEndpoint, projectID, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenServiceEndpointCustom(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID) {
+	doBaseFlattening(d, serviceEndpoint, projectID)
+	d.Set("type", serviceEndpoint.Type)
+	d.Set("url", serviceEndpoint.Url)
+
+	var parameters map[string]string
+	if serviceEndpoint.Authorization != nil {
+		d.Set("authorization_scheme", serviceEndpoint.Authorization.Scheme)
+		if serviceEndpoint.Authorization.Parameters != nil {
+			parameters = *serviceEndpoint.Authorization.Parameters
+		}
+	}
+	configuration := d.Get(customAuthorizationParameters).(map[string]interface{})
+	flattened := flattenCustomValues(configuration, parameters)
+
+	// secret values won't be returned by the service, they are kept as empty values and their hashes are stored
+	hashes := d.Get(customAuthorizationParametersHash).(map[string]interface{})
+	newHashes := map[string]interface{}{}
+	changed := d.HasChange(customAuthorizationParameters)
+	for key, value := range configuration {
+		if _, ok := flattened[key]; !ok {
+			flattened[key] = ""
+		}
+		hash, _ := hashes[key].(string)
+		if secret := value.(string); changed && secret != "" && secret != "null" {
+			var err error
+			if _, hash, err = secretmemo.IsUpdating(secret, hash); err != nil {
+				log.Printf("Swallowing err while using secret hashing: %s", err)
+			}
+		}
+		if hash != "" {
+			newHashes[key] = hash
+		}
+	}
+	d.Set(customAuthorizationParameters, flattened)
+	d.Set(customAuthorizationParametersHash, newHashes)
+
+	var data map[string]string
+	if serviceEndpoint.Data != nil {
+		data = *serviceEndpoint.Data
+	}
+	d.Set("data", flattenCustomValues(d.Get("data").(map[string]interface{}), data))
+}
+
+// flattenCustomValues returns the values of the service endpoint, the values the service adds to the
+// configured ones are ignored
+func flattenCustomValues(configuration map[string]interface{}, values map[string]string) map[string]interface{} {
+	flattened := map[string]interface{}{}
+	for key, value := range values {
+		if _, ok := configuration[key]; ok || len(configuration) == 0 {
+			flattened[key] = value
+		}
+	}
+	return flattened
+}
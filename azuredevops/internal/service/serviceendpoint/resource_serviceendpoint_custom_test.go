@@ -0,0 +1,338 @@
+//go:build (all || resource_serviceendpoint_custom) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_custom
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/forminput"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var customTestServiceEndpointID = uuid.New()
+var customRandomServiceEndpointProjectID = uuid.New()
+var customTestServiceEndpointProjectID = &customRandomServiceEndpointProjectID
+
+var customTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"username": "CUSTOM_TEST_username",
+		},
+		Scheme: converter.String("UsernamePassword"),
+	},
+	Data: &map[string]string{
+		"organization": "CUSTOM_TEST_organization",
+	},
+	Id:    &customTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_CONN_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("custom-endpoint"),
+	Url:   converter.String("https://custom.example.com"),
+	ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: customTestServiceEndpointProjectID,
+			},
+			Name:        converter.String("UNIT_TEST_CONN_NAME"),
+			Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		},
+	},
+}
+
+var customTestServiceEndpointType = serviceendpoint.ServiceEndpointType{
+	Name: converter.String("custom-endpoint"),
+	EndpointUrl: &serviceendpoint.EndpointUrl{
+		Value: converter.String("https://custom.example.com"),
+	},
+	AuthenticationSchemes: &[]serviceendpoint.ServiceEndpointAuthenticationScheme{
+		{
+			Scheme: converter.String("UsernamePassword"),
+			InputDescriptors: &[]forminput.InputDescriptor{
+				{
+					Id:         converter.String("username"),
+					Validation: &forminput.InputValidation{IsRequired: converter.Bool(true)},
+				},
+				{
+					Id:         converter.String("password"),
+					Validation: &forminput.InputValidation{IsRequired: converter.Bool(true)},
+				},
+			},
+		},
+		{
+			Scheme: converter.String("Token"),
+		},
+	},
+	InputDescriptors: &[]forminput.InputDescriptor{
+		{
+			Id:         converter.String("organization"),
+			Validation: &forminput.InputValidation{IsRequired: converter.Bool(true)},
+		},
+	},
+}
+
+func getCustomResourceData(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	raw["project_id"] = customTestServiceEndpointProjectID.String()
+	raw["service_endpoint_name"] = "UNIT_TEST_CONN_NAME"
+	raw["description"] = "UNIT_TEST_CONN_DESCRIPTION"
+	raw["type"] = "custom-endpoint"
+	return schema.TestResourceDataRaw(t, ResourceServiceEndpointCustom().Schema, raw)
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestServiceEndpointCustom_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointCustom().Schema, nil)
+	flattenServiceEndpointCustom(resourceData, &customTestServiceEndpoint, customTestServiceEndpointProjectID)
+
+	serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointCustom(resourceData)
+
+	require.Equal(t, customTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, customTestServiceEndpointProjectID, projectID)
+	require.Nil(t, err)
+}
+
+// verifies that the secret parameters not returned by the service are kept with their hashes and are not cleared
+// by the next update
+func TestServiceEndpointCustom_Flatten_KeepsSecretParameters(t *testing.T) {
+	resourceData := getCustomResourceData(t, map[string]interface{}{
+		"authorization_scheme": "UsernamePassword",
+		"authorization_parameters": map[string]interface{}{
+			"username": "CUSTOM_TEST_username",
+			"password": "CUSTOM_TEST_password",
+		},
+	})
+	flattenServiceEndpointCustom(resourceData, &customTestServiceEndpoint, customTestServiceEndpointProjectID)
+
+	parameters := resourceData.Get(customAuthorizationParameters).(map[string]interface{})
+	require.Equal(t, map[string]interface{}{"username": "CUSTOM_TEST_username", "password": ""}, parameters)
+	hashes := resourceData.Get(customAuthorizationParametersHash).(map[string]interface{})
+	require.Len(t, hashes, 2)
+	require.NotEmpty(t, hashes["password"])
+
+	require.True(t, suppressCustomAuthorizationParameterChanged(customAuthorizationParameters+".password", "", "CUSTOM_TEST_password", resourceData))
+	require.False(t, suppressCustomAuthorizationParameterChanged(customAuthorizationParameters+".password", "", "CUSTOM_TEST_changed", resourceData))
+	require.False(t, suppressCustomAuthorizationParameterChanged(customAuthorizationParameters+".username", "CUSTOM_TEST_username", "CUSTOM_TEST_changed", resourceData))
+
+	serviceEndpoint, _, err := expandServiceEndpointCustom(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, "null", (*serviceEndpoint.Authorization.Parameters)["password"])
+	require.Equal(t, "CUSTOM_TEST_username", (*serviceEndpoint.Authorization.Parameters)["username"])
+}
+
+// verifies that the service endpoint is validated against the metadata of its type
+func TestServiceEndpointCustom_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		raw           map[string]interface{}
+		expectedError string
+	}{
+		{
+			name: "valid",
+			raw: map[string]interface{}{
+				"authorization_scheme":     "usernamepassword",
+				"authorization_parameters": map[string]interface{}{"username": "u", "password": "p"},
+				"data":                     map[string]interface{}{"organization": "o"},
+			},
+		},
+		{
+			name: "unsupported scheme",
+			raw: map[string]interface{}{
+				"authorization_scheme": "OAuth",
+				"data":                 map[string]interface{}{"organization": "o"},
+			},
+			expectedError: "supported schemes are: UsernamePassword, Token",
+		},
+		{
+			name: "missing parameter",
+			raw: map[string]interface{}{
+				"authorization_scheme":     "UsernamePassword",
+				"authorization_parameters": map[string]interface{}{"username": "u"},
+				"data":                     map[string]interface{}{"organization": "o"},
+			},
+			expectedError: "requires the parameters: password",
+		},
+		{
+			name: "unknown parameter",
+			raw: map[string]interface{}{
+				"authorization_scheme":     "UsernamePassword",
+				"authorization_parameters": map[string]interface{}{"username": "u", "password": "p", "apitoken": "t"},
+				"data":                     map[string]interface{}{"organization": "o"},
+			},
+			expectedError: "does not support the parameters: apitoken",
+		},
+		{
+			name: "missing data",
+			raw: map[string]interface{}{
+				"authorization_scheme": "Token",
+			},
+			expectedError: "requires the data: organization",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			serviceEndpoint, _, err := expandServiceEndpointCustom(getCustomResourceData(t, test.raw))
+			require.Nil(t, err)
+
+			err = validateServiceEndpointCustom(&customTestServiceEndpointType, serviceEndpoint)
+			if test.expectedError == "" {
+				require.Nil(t, err)
+			} else {
+				require.Contains(t, err.Error(), test.expectedError)
+			}
+		})
+	}
+}
+
+// verifies that the service endpoint isn't created if its type is not available
+func TestServiceEndpointCustom_Create_UnknownType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointCustom()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenServiceEndpointCustom(resourceData, &customTestServiceEndpoint, customTestServiceEndpointProjectID)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetServiceEndpointTypes(clients.Ctx, serviceendpoint.GetServiceEndpointTypesArgs{Type: converter.String("custom-endpoint")}).
+		Return(&[]serviceendpoint.ServiceEndpointType{}, nil).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "the extension contributing it may not be installed")
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestServiceEndpointCustom_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointCustom()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenServiceEndpointCustom(resourceData, &customTestServiceEndpoint, customTestServiceEndpointProjectID)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetServiceEndpointTypes(clients.Ctx, serviceendpoint.GetServiceEndpointTypesArgs{Type: converter.String("custom-endpoint")}).
+		Return(&[]serviceendpoint.ServiceEndpointType{{
+			Name:                  converter.String("custom-endpoint"),
+			AuthenticationSchemes: &[]serviceendpoint.ServiceEndpointAuthenticationScheme{{Scheme: converter.String("UsernamePassword")}},
+		}}, nil).
+		Times(1)
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &customTestServiceEndpoint}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestServiceEndpointCustom_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointCustom()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenServiceEndpointCustom(resourceData, &customTestServiceEndpoint, customTestServiceEndpointProjectID)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{
+		EndpointId: customTestServiceEndpoint.Id,
+		Project:    converter.String(customTestServiceEndpointProjectID.String()),
+	}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestServiceEndpointCustom_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointCustom()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenServiceEndpointCustom(resourceData, &customTestServiceEndpoint, customTestServiceEndpointProjectID)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{
+		EndpointId: customTestServiceEndpoint.Id,
+		ProjectIds: &[]string{
+			customTestServiceEndpointProjectID.String(),
+		},
+	}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestServiceEndpointCustom_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointCustom()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenServiceEndpointCustom(resourceData, &customTestServiceEndpoint, customTestServiceEndpointProjectID)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetServiceEndpointTypes(clients.Ctx, serviceendpoint.GetServiceEndpointTypesArgs{Type: converter.String("custom-endpoint")}).
+		Return(&[]serviceendpoint.ServiceEndpointType{{
+			Name:                  converter.String("custom-endpoint"),
+			AuthenticationSchemes: &[]serviceendpoint.ServiceEndpointAuthenticationScheme{{Scheme: converter.String("UsernamePassword")}},
+		}}, nil).
+		Times(1)
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &customTestServiceEndpoint,
+		EndpointId: customTestServiceEndpoint.Id,
+	}
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
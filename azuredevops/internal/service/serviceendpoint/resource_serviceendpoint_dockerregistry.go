@@ -34,6 +34,7 @@ func ResourceServiceEndpointDockerRegistry() *schema.Resource {
 	}
 	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema("docker_password")
 	r.Schema[secretHashKey] = secretHashSchema
+	makeSecretSourceSchema(r, "docker_password")
 	r.Schema["docker_email"] = &schema.Schema{
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -56,11 +57,15 @@ func ResourceServiceEndpointDockerRegistry() *schema.Resource {
 // Convert internal Terraform data structure to an AzDO data structure
 func expandServiceEndpointDockerRegistry(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
 	serviceEndpoint, projectID := doBaseExpansion(d)
+	password, err := expandResourceSecretValue(d, "docker_password", false)
+	if err != nil {
+		return nil, nil, err
+	}
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
 			"registry": d.Get("docker_registry").(string),
 			"username": d.Get("docker_username").(string),
-			"password": d.Get("docker_password").(string),
+			"password": password,
 			"email":    d.Get("docker_email").(string),
 		},
 		Scheme: converter.String("UsernamePassword"),
@@ -79,7 +84,7 @@ func flattenServiceEndpointDockerRegistry(d *schema.ResourceData, serviceEndpoin
 	d.Set("docker_registry", (*serviceEndpoint.Authorization.Parameters)["registry"])
 	d.Set("docker_email", (*serviceEndpoint.Authorization.Parameters)["email"])
 	d.Set("docker_username", (*serviceEndpoint.Authorization.Parameters)["username"])
-	tfhelper.HelpFlattenSecret(d, "docker_password")
+	flattenSecret(d, "docker_password")
 	d.Set("docker_password", (*serviceEndpoint.Authorization.Parameters)["password"])
 	d.Set("registry_type", (*serviceEndpoint.Data)["registrytype"])
 }
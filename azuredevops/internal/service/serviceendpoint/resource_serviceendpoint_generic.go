@@ -34,6 +34,7 @@ func ResourceServiceEndpointGeneric() *schema.Resource {
 	}
 	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema("password")
 	r.Schema[secretHashKey] = secretHashSchema
+	makeSecretSourceSchema(r, "password")
 	return r
 }
 
@@ -41,10 +42,14 @@ func expandServiceEndpointGeneric(d *schema.ResourceData) (*serviceendpoint.Serv
 	serviceEndpoint, projectID := doBaseExpansion(d)
 	serviceEndpoint.Type = converter.String("generic")
 	serviceEndpoint.Url = converter.String(d.Get("server_url").(string))
+	password, err := expandResourceSecretValue(d, "password", false)
+	if err != nil {
+		return nil, nil, err
+	}
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
 			"username": d.Get("username").(string),
-			"password": d.Get("password").(string),
+			"password": password,
 		},
 		Scheme: converter.String("UsernamePassword"),
 	}
@@ -55,6 +60,6 @@ func flattenServiceEndpointGeneric(d *schema.ResourceData, serviceEndpoint *serv
 	doBaseFlattening(d, serviceEndpoint, projectID)
 	d.Set("server_url", *serviceEndpoint.Url)
 	d.Set("username", (*serviceEndpoint.Authorization.Parameters)["username"])
-	tfhelper.HelpFlattenSecret(d, "password")
+	flattenSecret(d, "password")
 	d.Set("password", (*serviceEndpoint.Authorization.Parameters)["password"])
 }
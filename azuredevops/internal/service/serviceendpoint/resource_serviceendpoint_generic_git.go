@@ -42,6 +42,7 @@ func ResourceServiceEndpointGenericGit() *schema.Resource {
 	}
 	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema("password")
 	r.Schema[secretHashKey] = secretHashSchema
+	makeSecretSourceSchema(r, "password")
 	return r
 }
 
@@ -52,10 +53,14 @@ func expandServiceEndpointGenericGit(d *schema.ResourceData) (*serviceendpoint.S
 	serviceEndpoint.Data = &map[string]string{
 		"accessExternalGitServer": strconv.FormatBool(d.Get("enable_pipelines_access").(bool)),
 	}
+	password, err := expandResourceSecretValue(d, "password", false)
+	if err != nil {
+		return nil, nil, err
+	}
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
 			"username": d.Get("username").(string),
-			"password": d.Get("password").(string),
+			"password": password,
 		},
 		Scheme: converter.String("UsernamePassword"),
 	}
@@ -69,6 +74,6 @@ func flattenServiceEndpointGenericGit(d *schema.ResourceData, serviceEndpoint *s
 		d.Set("enable_pipelines_access", v)
 	}
 	d.Set("username", (*serviceEndpoint.Authorization.Parameters)["username"])
-	tfhelper.HelpFlattenSecret(d, "password")
+	flattenSecret(d, "password")
 	d.Set("password", (*serviceEndpoint.Authorization.Parameters)["password"])
 }
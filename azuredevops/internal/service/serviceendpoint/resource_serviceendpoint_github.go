@@ -32,6 +32,7 @@ func ResourceServiceEndpointGitHub() *schema.Resource {
 	}
 	patHashKey, patHashSchema := tfhelper.GenerateSecreteMemoSchema(personalAccessTokenGithub)
 	authPersonal.Schema[patHashKey] = patHashSchema
+	makeSecretSourceSchema(authPersonal, personalAccessTokenGithub)
 	r.Schema["auth_personal"] = &schema.Schema{
 		Type:          schema.TypeSet,
 		Optional:      true,
@@ -69,7 +70,11 @@ func expandServiceEndpointGitHub(d *schema.ResourceData) (*serviceendpoint.Servi
 
 	if config, ok := d.GetOk("auth_personal"); ok {
 		scheme = "Token"
-		parameters = expandAuthPersonalSetGithub(config.(*schema.Set))
+		var err error
+		parameters, err = expandAuthPersonalSetGithub(config.(*schema.Set))
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	if config, ok := d.GetOk("auth_oauth"); ok {
@@ -87,11 +92,15 @@ func expandServiceEndpointGitHub(d *schema.ResourceData) (*serviceendpoint.Servi
 	return serviceEndpoint, projectID, nil
 }
 
-func expandAuthPersonalSetGithub(d *schema.Set) map[string]string {
+func expandAuthPersonalSetGithub(d *schema.Set) (map[string]string, error) {
 	authPerson := make(map[string]string)
 	val := d.List()[0].(map[string]interface{}) //auth_personal only have one map configure structure
-	authPerson["AccessToken"] = val[personalAccessTokenGithub].(string)
-	return authPerson
+	accessToken, err := expandSecretValue(val, personalAccessTokenGithub, true)
+	if err != nil {
+		return nil, err
+	}
+	authPerson["AccessToken"] = accessToken
+	return authPerson, nil
 }
 
 func expandAuthOauthSet(d *schema.Set) map[string]string {
@@ -126,6 +135,7 @@ func flattenAuthPerson(d *schema.ResourceData, authPersonalSet []interface{}) []
 		if authPersonal, ok := authPersonalSet[0].(map[string]interface{}); ok {
 			newHash, hashKey := tfhelper.HelpFlattenSecretNested(d, "auth_personal", authPersonal, personalAccessTokenGithub)
 			authPersonal[hashKey] = newHash
+			flattenSecretSource(authPersonal, authPersonal, personalAccessTokenGithub, d.HasChange("auth_personal"))
 			return []interface{}{authPersonal}
 		}
 	}
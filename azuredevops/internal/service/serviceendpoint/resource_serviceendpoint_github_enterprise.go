@@ -32,6 +32,7 @@ func ResourceServiceEndpointGitHubEnterprise() *schema.Resource {
 	}
 	patHashKey, patHashSchema := tfhelper.GenerateSecreteMemoSchema(personalAccessTokenGithubEnterprise)
 	authPersonal.Schema[patHashKey] = patHashSchema
+	makeSecretSourceSchema(authPersonal, personalAccessTokenGithubEnterprise)
 	r.Schema["auth_personal"] = &schema.Schema{
 		Type:     schema.TypeSet,
 		MinItems: 1,
@@ -66,6 +67,7 @@ func flattenAuthPersonGithubEnterprise(d *schema.ResourceData, authPersonalSet [
 		if authPersonal, ok := authPersonalSet[0].(map[string]interface{}); ok {
 			newHash, hashKey := tfhelper.HelpFlattenSecretNested(d, "auth_personal", authPersonal, personalAccessTokenGithub)
 			authPersonal[hashKey] = newHash
+			flattenSecretSource(authPersonal, authPersonal, personalAccessTokenGithubEnterprise, d.HasChange("auth_personal"))
 			return []interface{}{authPersonal}
 		}
 	}
@@ -86,7 +88,11 @@ func expandServiceEndpointGitHubEnterprise(d *schema.ResourceData) (*serviceendp
 
 	if config, ok := d.GetOk("auth_personal"); ok {
 		scheme = "Token"
-		parameters = expandAuthPersonalSetGithubEnterprise(config.(*schema.Set))
+		var err error
+		parameters, err = expandAuthPersonalSetGithubEnterprise(config.(*schema.Set))
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
@@ -97,10 +103,14 @@ func expandServiceEndpointGitHubEnterprise(d *schema.ResourceData) (*serviceendp
 	return serviceEndpoint, projectID, nil
 }
 
-func expandAuthPersonalSetGithubEnterprise(d *schema.Set) map[string]string {
+func expandAuthPersonalSetGithubEnterprise(d *schema.Set) (map[string]string, error) {
 	authPerson := make(map[string]string)
 	val := d.List()[0].(map[string]interface{}) //auth_personal only have one map configure structure
 
-	authPerson["apitoken"] = val[personalAccessTokenGithub].(string)
-	return authPerson
+	apiToken, err := expandSecretValue(val, personalAccessTokenGithubEnterprise, true)
+	if err != nil {
+		return nil, err
+	}
+	authPerson["apitoken"] = apiToken
+	return authPerson, nil
 }
@@ -0,0 +1,72 @@
+package serviceendpoint
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceServiceEndpointGitLab schema and implementation for GitLab service endpoint resource
+func ResourceServiceEndpointGitLab() *schema.Resource {
+	r := genBaseServiceEndpointResource(flattenServiceEndpointGitLab, expandServiceEndpointGitLab)
+
+	r.Schema["url"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		Description:  "Url of the GitLab server",
+	}
+
+	r.Schema["username"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The user name of the GitLab user",
+	}
+
+	r.Schema["api_token"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSuppressSecretChanged,
+		Description:      "The API token of the GitLab user",
+	}
+	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema("api_token")
+	r.Schema[secretHashKey] = secretHashSchema
+	makeSecretSourceSchema(r, "api_token")
+
+	return r
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandServiceEndpointGitLab(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
+	serviceEndpoint, projectID := doBaseExpansion(d)
+	apiToken, err := expandResourceSecretValue(d, "api_token", true)
+	if err != nil {
+		return nil, nil, err
+	}
+	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"username": d.Get("username").(string),
+			"apiToken": apiToken,
+		},
+		Scheme: converter.String("UsernamePassword"),
+	}
+	serviceEndpoint.Type = converter.String("gitlab")
+	serviceEndpoint.Url = converter.String(d.Get("url").(string))
+	return serviceEndpoint, projectID, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenServiceEndpointGitLab(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID) {
+	doBaseFlattening(d, serviceEndpoint, projectID)
+
+	flattenSecret(d, "api_token")
+
+	d.Set("url", *serviceEndpoint.Url)
+	d.Set("username", (*serviceEndpoint.Authorization.Parameters)["username"])
+	d.Set("api_token", (*serviceEndpoint.Authorization.Parameters)["apiToken"])
+}
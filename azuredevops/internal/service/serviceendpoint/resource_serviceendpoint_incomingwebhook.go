@@ -33,6 +33,7 @@ func ResourceServiceEndpointIncomingWebhook() *schema.Resource {
 	}
 	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema("secret")
 	r.Schema[secretHashKey] = secretHashSchema
+	makeSecretSourceSchema(r, "secret")
 	return r
 }
 
@@ -40,10 +41,14 @@ func expandServiceEndpointIncomingWebhook(d *schema.ResourceData) (*serviceendpo
 	serviceEndpoint, projectID := doBaseExpansion(d)
 	serviceEndpoint.Type = converter.String("incomingwebhook")
 	serviceEndpoint.Url = converter.String("https://dev.azure.com")
+	secret, err := expandResourceSecretValue(d, "secret", false)
+	if err != nil {
+		return nil, nil, err
+	}
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
 			"webhookname": d.Get("webhook_name").(string),
-			"secret":      d.Get("secret").(string),
+			"secret":      secret,
 			"header":      d.Get("http_header").(string),
 		},
 		Scheme: converter.String("None"),
@@ -54,7 +59,7 @@ func expandServiceEndpointIncomingWebhook(d *schema.ResourceData) (*serviceendpo
 func flattenServiceEndpointIncomingWebhook(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID) {
 	doBaseFlattening(d, serviceEndpoint, projectID)
 	d.Set("webhook_name", (*serviceEndpoint.Authorization.Parameters)["webhookname"])
-	tfhelper.HelpFlattenSecret(d, "secret")
+	flattenSecret(d, "secret")
 	d.Set("secret", (*serviceEndpoint.Authorization.Parameters)["secret"])
 	d.Set("http_header", (*serviceEndpoint.Authorization.Parameters)["header"])
 }
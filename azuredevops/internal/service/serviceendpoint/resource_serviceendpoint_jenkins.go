@@ -0,0 +1,88 @@
+package serviceendpoint
+
+import (
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceServiceEndpointJenkins schema and implementation for Jenkins service endpoint resource
+func ResourceServiceEndpointJenkins() *schema.Resource {
+	r := genBaseServiceEndpointResource(flattenServiceEndpointJenkins, expandServiceEndpointJenkins)
+
+	r.Schema["url"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		Description:  "Url of the Jenkins server",
+	}
+
+	r.Schema["accept_untrusted_certs"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Allows the Jenkins server to use a self-signed certificate",
+	}
+
+	r.Schema["username"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The user name of the Jenkins server",
+	}
+
+	r.Schema["password"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSuppressSecretChanged,
+		Description:      "The password or API token of the Jenkins user",
+	}
+	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema("password")
+	r.Schema[secretHashKey] = secretHashSchema
+	makeSecretSourceSchema(r, "password")
+
+	return r
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandServiceEndpointJenkins(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
+	serviceEndpoint, projectID := doBaseExpansion(d)
+	password, err := expandResourceSecretValue(d, "password", true)
+	if err != nil {
+		return nil, nil, err
+	}
+	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"username": d.Get("username").(string),
+			"password": password,
+		},
+		Scheme: converter.String("UsernamePassword"),
+	}
+	serviceEndpoint.Data = &map[string]string{
+		"acceptUntrustedCerts": strconv.FormatBool(d.Get("accept_untrusted_certs").(bool)),
+	}
+	serviceEndpoint.Type = converter.String("jenkins")
+	serviceEndpoint.Url = converter.String(d.Get("url").(string))
+	return serviceEndpoint, projectID, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenServiceEndpointJenkins(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID) {
+	doBaseFlattening(d, serviceEndpoint, projectID)
+
+	flattenSecret(d, "password")
+
+	d.Set("url", *serviceEndpoint.Url)
+	d.Set("username", (*serviceEndpoint.Authorization.Parameters)["username"])
+	d.Set("password", (*serviceEndpoint.Authorization.Parameters)["password"])
+	if serviceEndpoint.Data != nil {
+		acceptUntrustedCerts, _ := strconv.ParseBool((*serviceEndpoint.Data)["acceptUntrustedCerts"])
+		d.Set("accept_untrusted_certs", acceptUntrustedCerts)
+	}
+}
@@ -199,10 +199,13 @@ func expandServiceEndpointKubernetes(d *schema.ResourceData) (*serviceendpoint.S
 	case "Kubeconfig":
 		configurationRaw := d.Get(resourceBlockKubeconfig).(*schema.Set).List()
 		configuration := configurationRaw[0].(map[string]interface{})
+		kubeConfigYAML, err := expandSecretValue(configuration, "kube_config", true)
+		if err != nil {
+			return nil, nil, err
+		}
 
 		clusterContextInput := configuration["cluster_context"].(string)
 		if clusterContextInput == "" {
-			kubeConfigYAML := configuration["kube_config"].(string)
 			var kubeConfigYAMLUnmarshalled map[string]interface{}
 			err := yaml.Unmarshal([]byte(kubeConfigYAML), &kubeConfigYAMLUnmarshalled)
 			if err != nil {
@@ -216,7 +219,7 @@ func expandServiceEndpointKubernetes(d *schema.ResourceData) (*serviceendpoint.S
 		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 			Parameters: &map[string]string{
 				"clusterContext": clusterContextInput,
-				"kubeconfig":     configuration["kube_config"].(string),
+				"kubeconfig":     kubeConfigYAML,
 			},
 			Scheme: converter.String("Kubernetes"),
 		}
@@ -228,11 +231,19 @@ func expandServiceEndpointKubernetes(d *schema.ResourceData) (*serviceendpoint.S
 	case "ServiceAccount":
 		configurationRaw := d.Get(resourceBlockServiceAccount).(*schema.Set).List()
 		configuration := configurationRaw[0].(map[string]interface{})
+		token, err := expandSecretValue(configuration, "token", true)
+		if err != nil {
+			return nil, nil, err
+		}
+		caCert, err := expandSecretValue(configuration, "ca_cert", true)
+		if err != nil {
+			return nil, nil, err
+		}
 
 		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 			Parameters: &map[string]string{
-				"apiToken":                  configuration["token"].(string),
-				"serviceAccountCertificate": configuration["ca_cert"].(string),
+				"apiToken":                  token,
+				"serviceAccountCertificate": caCert,
 			},
 			Scheme: converter.String("Token"),
 		}
@@ -292,6 +303,7 @@ func flattenServiceEndpointKubernetes(d *schema.ResourceData, serviceEndpoint *s
 			"accept_untrusted_certs": acceptUntrustedCerts,
 			hashKeyKubeconfig:        newHashKubeconfig,
 		}
+		flattenSecretSource(configuration, kubeconfig, "kube_config", d.HasChange(resourceBlockKubeconfig))
 
 		kubeconfigList := make([]map[string]interface{}, 1)
 		kubeconfigList[0] = kubeconfig
@@ -319,6 +331,8 @@ func flattenServiceEndpointKubernetes(d *schema.ResourceData, serviceEndpoint *s
 				hashKeyToken: newHashToken,
 				hashKeyCert:  newHashCert,
 			}
+			flattenSecretSource(configuration, serviceAccount, "token", d.HasChange(resourceBlockServiceAccount))
+			flattenSecretSource(configuration, serviceAccount, "ca_cert", d.HasChange(resourceBlockServiceAccount))
 		}
 
 		serviceAccountList := make([]map[string]interface{}, 1)
@@ -0,0 +1,60 @@
+package serviceendpoint
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceServiceEndpointMaven schema and implementation for Maven service endpoint resource
+func ResourceServiceEndpointMaven() *schema.Resource {
+	r := genBaseServiceEndpointResource(flattenServiceEndpointMaven, expandServiceEndpointMaven)
+
+	r.Schema["url"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		Description:  "Url of the Maven repository",
+	}
+
+	r.Schema["repository_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The ID of the server that matches the id element of the repository or mirror in the Maven settings",
+	}
+
+	makeTokenAndBasicAuthenticationSchema(r, "Maven repository")
+
+	return r
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandServiceEndpointMaven(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
+	serviceEndpoint, projectID := doBaseExpansion(d)
+	serviceEndpoint.Type = converter.String("externalmavenrepository")
+	serviceEndpoint.Url = converter.String(d.Get("url").(string))
+	serviceEndpoint.Data = &map[string]string{
+		"RepositoryId": d.Get("repository_id").(string),
+	}
+
+	authorization, err := expandTokenAndBasicAuthentication(d)
+	if err != nil {
+		return nil, nil, err
+	}
+	serviceEndpoint.Authorization = authorization
+	return serviceEndpoint, projectID, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenServiceEndpointMaven(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID) {
+	doBaseFlattening(d, serviceEndpoint, projectID)
+	flattenTokenAndBasicAuthentication(d, serviceEndpoint)
+
+	d.Set("url", *serviceEndpoint.Url)
+	if serviceEndpoint.Data != nil {
+		d.Set("repository_id", (*serviceEndpoint.Data)["RepositoryId"])
+	}
+}
@@ -31,6 +31,7 @@ func ResourceServiceEndpointNpm() *schema.Resource {
 	// Add a spot in the schema to store the token secretly
 	stSecretHashKey, stSecretHashSchema := tfhelper.GenerateSecreteMemoSchema("access_token")
 	r.Schema[stSecretHashKey] = stSecretHashSchema
+	makeSecretSourceSchema(r, "access_token")
 
 	return r
 }
@@ -38,9 +39,13 @@ func ResourceServiceEndpointNpm() *schema.Resource {
 // Convert internal Terraform data structure to an AzDO data structure
 func expandServiceEndpointNpm(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
 	serviceEndpoint, projectID := doBaseExpansion(d)
+	accessToken, err := expandResourceSecretValue(d, "access_token", true)
+	if err != nil {
+		return nil, nil, err
+	}
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
-			"apitoken": d.Get("access_token").(string),
+			"apitoken": accessToken,
 		},
 		Scheme: converter.String("Token"),
 	}
@@ -53,7 +58,7 @@ func expandServiceEndpointNpm(d *schema.ResourceData) (*serviceendpoint.ServiceE
 func flattenServiceEndpointNpm(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID) {
 	doBaseFlattening(d, serviceEndpoint, projectID)
 
-	tfhelper.HelpFlattenSecret(d, "access_token")
+	flattenSecret(d, "access_token")
 
 	d.Set("url", *serviceEndpoint.Url)
 	d.Set("access_token", (*serviceEndpoint.Authorization.Parameters)["apitoken"])
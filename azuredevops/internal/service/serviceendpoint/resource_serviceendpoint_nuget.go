@@ -0,0 +1,107 @@
+package serviceendpoint
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const authenticationAPIKey = "authentication_api_key"
+
+// ResourceServiceEndpointNuGet schema and implementation for NuGet service endpoint resource
+func ResourceServiceEndpointNuGet() *schema.Resource {
+	r := genBaseServiceEndpointResource(flattenServiceEndpointNuGet, expandServiceEndpointNuGet)
+
+	r.Schema["url"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		Description:  "Url of the NuGet feed",
+	}
+
+	apiKeyHashKey, apiKeyHashSchema := tfhelper.GenerateSecreteMemoSchema("api_key")
+	apiKey := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"api_key": {
+				Description:      "The NuGet API key.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: tfhelper.DiffFuncSuppressSecretChanged,
+			},
+			apiKeyHashKey: apiKeyHashSchema,
+		},
+	}
+	makeSecretSourceSchema(apiKey, "api_key")
+
+	r.Schema[authenticationAPIKey] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MinItems: 1,
+		MaxItems: 1,
+		Elem:     apiKey,
+	}
+	makeTokenAndBasicAuthenticationSchema(r, "NuGet feed", authenticationAPIKey)
+
+	return r
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandServiceEndpointNuGet(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
+	serviceEndpoint, projectID := doBaseExpansion(d)
+	serviceEndpoint.Type = converter.String("externalnugetfeed")
+	serviceEndpoint.Url = converter.String(d.Get("url").(string))
+
+	if x, ok := d.GetOk(authenticationAPIKey); ok {
+		apiKey, err := expandSecret(x.([]interface{})[0].(map[string]interface{}), "api_key")
+		if err != nil {
+			return nil, nil, err
+		}
+		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+			Parameters: &map[string]string{
+				"nugetkey": apiKey,
+			},
+			Scheme: converter.String("None"),
+		}
+		return serviceEndpoint, projectID, nil
+	}
+
+	authorization, err := expandTokenAndBasicAuthentication(d)
+	if err != nil {
+		return nil, nil, err
+	}
+	serviceEndpoint.Authorization = authorization
+	return serviceEndpoint, projectID, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenServiceEndpointNuGet(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID) {
+	doBaseFlattening(d, serviceEndpoint, projectID)
+	d.Set("url", *serviceEndpoint.Url)
+
+	if flattenTokenAndBasicAuthentication(d, serviceEndpoint) {
+		return
+	}
+	if serviceEndpoint.Authorization == nil || serviceEndpoint.Authorization.Scheme == nil || !strings.EqualFold(*serviceEndpoint.Authorization.Scheme, "None") {
+		return
+	}
+
+	auth := map[string]interface{}{}
+	if x, ok := d.GetOk(authenticationAPIKey); ok {
+		configuration := x.([]interface{})[0].(map[string]interface{})
+		if len(configuration) > 0 {
+			newHash, hashKey := tfhelper.HelpFlattenSecretNested(d, authenticationAPIKey, configuration, "api_key")
+			auth[hashKey] = newHash
+			flattenSecretSource(configuration, auth, "api_key", d.HasChange(authenticationAPIKey))
+		}
+	}
+	if serviceEndpoint.Authorization.Parameters != nil {
+		auth["api_key"] = (*serviceEndpoint.Authorization.Parameters)["nugetkey"]
+	}
+	d.Set(authenticationAPIKey, []interface{}{auth})
+}
@@ -0,0 +1,242 @@
+//go:build (all || resource_serviceendpoint_nuget) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_nuget
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var nugetTestServiceEndpointID = uuid.New()
+var nugetRandomServiceEndpointProjectID = uuid.New()
+var nugetTestServiceEndpointProjectID = &nugetRandomServiceEndpointProjectID
+
+var nugetTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"nugetkey": "NUGET_TEST_api_key",
+		},
+		Scheme: converter.String("None"),
+	},
+	Id:    &nugetTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_CONN_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("externalnugetfeed"),
+	Url:   converter.String("https://api.nuget.org/v3/index.json"),
+	ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: nugetTestServiceEndpointProjectID,
+			},
+			Name:        converter.String("UNIT_TEST_CONN_NAME"),
+			Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		},
+	},
+}
+
+var nugetTestServiceEndpointIDBasic = uuid.New()
+var nugetRandomServiceEndpointProjectIDBasic = uuid.New()
+var nugetTestServiceEndpointProjectIDBasic = &nugetRandomServiceEndpointProjectIDBasic
+
+var nugetTestServiceEndpointBasic = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"username": "NUGET_TEST_username",
+			"password": "NUGET_TEST_password",
+		},
+		Scheme: converter.String("UsernamePassword"),
+	},
+	Id:    &nugetTestServiceEndpointIDBasic,
+	Name:  converter.String("UNIT_TEST_CONN_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("externalnugetfeed"),
+	Url:   converter.String("https://api.nuget.org/v3/index.json"),
+	ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: nugetTestServiceEndpointProjectIDBasic,
+			},
+			Name:        converter.String("UNIT_TEST_CONN_NAME"),
+			Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		},
+	},
+}
+
+var nugetTestServiceEndpointIDToken = uuid.New()
+var nugetRandomServiceEndpointProjectIDToken = uuid.New()
+var nugetTestServiceEndpointProjectIDToken = &nugetRandomServiceEndpointProjectIDToken
+
+var nugetTestServiceEndpointToken = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"apitoken": "NUGET_TEST_token",
+		},
+		Scheme: converter.String("Token"),
+	},
+	Id:    &nugetTestServiceEndpointIDToken,
+	Name:  converter.String("UNIT_TEST_CONN_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("externalnugetfeed"),
+	Url:   converter.String("https://api.nuget.org/v3/index.json"),
+	ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: nugetTestServiceEndpointProjectIDToken,
+			},
+			Name:        converter.String("UNIT_TEST_CONN_NAME"),
+			Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		},
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint using an API key
+func TestServiceEndpointNuGet_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointNuGet().Schema, nil)
+	flattenServiceEndpointNuGet(resourceData, &nugetTestServiceEndpoint, nugetTestServiceEndpointProjectID)
+
+	serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointNuGet(resourceData)
+
+	require.Equal(t, nugetTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, nugetTestServiceEndpointProjectID, projectID)
+	require.Nil(t, err)
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint using a user name and password
+func TestServiceEndpointNuGet_ExpandFlatten_RoundtripBasic(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointNuGet().Schema, nil)
+	flattenServiceEndpointNuGet(resourceData, &nugetTestServiceEndpointBasic, nugetTestServiceEndpointProjectIDBasic)
+
+	serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointNuGet(resourceData)
+
+	require.Equal(t, nugetTestServiceEndpointBasic, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, nugetTestServiceEndpointProjectIDBasic, projectID)
+	require.Nil(t, err)
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint using a personal access token
+func TestServiceEndpointNuGet_ExpandFlatten_RoundtripToken(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointNuGet().Schema, nil)
+	flattenServiceEndpointNuGet(resourceData, &nugetTestServiceEndpointToken, nugetTestServiceEndpointProjectIDToken)
+
+	serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointNuGet(resourceData)
+
+	require.Equal(t, nugetTestServiceEndpointToken, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, nugetTestServiceEndpointProjectIDToken, projectID)
+	require.Nil(t, err)
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestServiceEndpointNuGet_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointNuGet()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenServiceEndpointNuGet(resourceData, &nugetTestServiceEndpoint, nugetTestServiceEndpointProjectID)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &nugetTestServiceEndpoint}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestServiceEndpointNuGet_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointNuGet()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenServiceEndpointNuGet(resourceData, &nugetTestServiceEndpoint, nugetTestServiceEndpointProjectID)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{
+		EndpointId: nugetTestServiceEndpoint.Id,
+		Project:    converter.String(nugetTestServiceEndpointProjectID.String()),
+	}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestServiceEndpointNuGet_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointNuGet()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenServiceEndpointNuGet(resourceData, &nugetTestServiceEndpoint, nugetTestServiceEndpointProjectID)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{
+		EndpointId: nugetTestServiceEndpoint.Id,
+		ProjectIds: &[]string{
+			nugetTestServiceEndpointProjectID.String(),
+		},
+	}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestServiceEndpointNuGet_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointNuGet()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenServiceEndpointNuGet(resourceData, &nugetTestServiceEndpoint, nugetTestServiceEndpointProjectID)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &nugetTestServiceEndpoint,
+		EndpointId: nugetTestServiceEndpoint.Id,
+	}
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
@@ -0,0 +1,47 @@
+package serviceendpoint
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceServiceEndpointPythonDownload schema and implementation for Python package download service endpoint resource
+func ResourceServiceEndpointPythonDownload() *schema.Resource {
+	r := genBaseServiceEndpointResource(flattenServiceEndpointPythonDownload, expandServiceEndpointPythonDownload)
+
+	r.Schema["url"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		Description:  "Url of the Python package index the packages are downloaded from",
+	}
+
+	makeTokenAndBasicAuthenticationSchema(r, "Python package index")
+
+	return r
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandServiceEndpointPythonDownload(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
+	serviceEndpoint, projectID := doBaseExpansion(d)
+	serviceEndpoint.Type = converter.String("externalPythonDownloadFeed")
+	serviceEndpoint.Url = converter.String(d.Get("url").(string))
+
+	authorization, err := expandTokenAndBasicAuthentication(d)
+	if err != nil {
+		return nil, nil, err
+	}
+	serviceEndpoint.Authorization = authorization
+	return serviceEndpoint, projectID, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenServiceEndpointPythonDownload(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID) {
+	doBaseFlattening(d, serviceEndpoint, projectID)
+	flattenTokenAndBasicAuthentication(d, serviceEndpoint)
+
+	d.Set("url", *serviceEndpoint.Url)
+}
@@ -0,0 +1,60 @@
+package serviceendpoint
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceServiceEndpointPythonUpload schema and implementation for Python package upload service endpoint resource
+func ResourceServiceEndpointPythonUpload() *schema.Resource {
+	r := genBaseServiceEndpointResource(flattenServiceEndpointPythonUpload, expandServiceEndpointPythonUpload)
+
+	r.Schema["url"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		Description:  "Url of the Python repository the packages are uploaded to",
+	}
+
+	r.Schema["repository_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The name of the repository in the .pypirc file used by twine",
+	}
+
+	makeTokenAndBasicAuthenticationSchema(r, "Python repository")
+
+	return r
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandServiceEndpointPythonUpload(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
+	serviceEndpoint, projectID := doBaseExpansion(d)
+	serviceEndpoint.Type = converter.String("externalPythonUploadFeed")
+	serviceEndpoint.Url = converter.String(d.Get("url").(string))
+	serviceEndpoint.Data = &map[string]string{
+		"EndpointName": d.Get("repository_name").(string),
+	}
+
+	authorization, err := expandTokenAndBasicAuthentication(d)
+	if err != nil {
+		return nil, nil, err
+	}
+	serviceEndpoint.Authorization = authorization
+	return serviceEndpoint, projectID, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenServiceEndpointPythonUpload(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID) {
+	doBaseFlattening(d, serviceEndpoint, projectID)
+	flattenTokenAndBasicAuthentication(d, serviceEndpoint)
+
+	d.Set("url", *serviceEndpoint.Url)
+	if serviceEndpoint.Data != nil {
+		d.Set("repository_name", (*serviceEndpoint.Data)["EndpointName"])
+	}
+}
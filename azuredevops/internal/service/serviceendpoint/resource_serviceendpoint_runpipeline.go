@@ -37,7 +37,10 @@ func expandServiceEndpointRunPipeline(d *schema.ResourceData) (*serviceendpoint.
 	serviceEndpoint.Type = converter.String("azdoapi")
 
 	scheme := "Token"
-	parameters := rpExpandAuthPersonalSet(d.Get("auth_personal").(*schema.Set))
+	parameters, err := rpExpandAuthPersonalSet(d.Get("auth_personal").(*schema.Set))
+	if err != nil {
+		return nil, nil, err
+	}
 
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &parameters,
@@ -55,13 +58,17 @@ func expandServiceEndpointRunPipeline(d *schema.ResourceData) (*serviceendpoint.
 	return serviceEndpoint, projectID, nil
 }
 
-func rpExpandAuthPersonalSet(d *schema.Set) map[string]string {
+func rpExpandAuthPersonalSet(d *schema.Set) (map[string]string, error) {
 	authPerson := make(map[string]string)
 	if len(d.List()) == 1 {
 		val := d.List()[0].(map[string]interface{}) //auth_personal block may have only one element inside
-		authPerson["apitoken"] = val["personal_access_token"].(string)
+		apiToken, err := expandSecretValue(val, "personal_access_token", true)
+		if err != nil {
+			return nil, err
+		}
+		authPerson["apitoken"] = apiToken
 	}
-	return authPerson
+	return authPerson, nil
 }
 
 func rpPersonalAccessTokenField() *schema.Resource {
@@ -80,6 +87,7 @@ func rpPersonalAccessTokenField() *schema.Resource {
 	}
 	patHashKey, patHashSchema := tfhelper.GenerateSecreteMemoSchema(fieldName)
 	personalAccessToken.Schema[patHashKey] = patHashSchema
+	makeSecretSourceSchema(personalAccessToken, fieldName)
 
 	return personalAccessToken
 }
@@ -99,6 +107,7 @@ func rpFlattenAuthPersonal(d *schema.ResourceData, authPersonalSet []interface{}
 		if authPersonal, ok := authPersonalSet[0].(map[string]interface{}); ok {
 			newHash, hashKey := tfhelper.HelpFlattenSecretNested(d, "auth_personal", authPersonal, "personal_access_token")
 			authPersonal[hashKey] = newHash
+			flattenSecretSource(authPersonal, authPersonal, "personal_access_token", d.HasChange("auth_personal"))
 			return []interface{}{authPersonal}
 		}
 	}
@@ -30,65 +30,70 @@ func ResourceServiceEndpointServiceFabric() *schema.Resource {
 
 	secretHashKeyClientCertificate, secretHashSchemaClientCertificate := tfhelper.GenerateSecreteMemoSchema("client_certificate")
 	secretHashKeyClientCertificatePassword, secretHashSchemaClientCertificatePassword := tfhelper.GenerateSecreteMemoSchema("client_certificate_password")
-	r.Schema[resourceBlockServiceFabricCertificate] = &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"server_certificate_lookup":      servicefabricServerCertificateLookupSchema(),
-				"server_certificate_thumbprint":  servicefabricServerCertificateThumbprintSchema(resourceBlockServiceFabricCertificate),
-				"server_certificate_common_name": servicefabricServerCertificateCommonNameSchema(resourceBlockServiceFabricCertificate),
-				"client_certificate": {
-					Type:             schema.TypeString,
-					Required:         true,
-					Description:      "Base64 encoding of the cluster's client certificate file.",
-					Sensitive:        true,
-					ValidateFunc:     validation.StringIsNotEmpty,
-					DiffSuppressFunc: tfhelper.DiffFuncSuppressSecretChanged,
-				},
-				"client_certificate_password": {
-					Type:             schema.TypeString,
-					Optional:         true,
-					Description:      "Password for the certificate.",
-					Sensitive:        true,
-					ValidateFunc:     validation.StringIsNotEmpty,
-					DiffSuppressFunc: tfhelper.DiffFuncSuppressSecretChanged,
-				},
-				secretHashKeyClientCertificate:         secretHashSchemaClientCertificate,
-				secretHashKeyClientCertificatePassword: secretHashSchemaClientCertificatePassword,
+	certificate := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"server_certificate_lookup":      servicefabricServerCertificateLookupSchema(),
+			"server_certificate_thumbprint":  servicefabricServerCertificateThumbprintSchema(resourceBlockServiceFabricCertificate),
+			"server_certificate_common_name": servicefabricServerCertificateCommonNameSchema(resourceBlockServiceFabricCertificate),
+			"client_certificate": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Base64 encoding of the cluster's client certificate file.",
+				Sensitive:        true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: tfhelper.DiffFuncSuppressSecretChanged,
+			},
+			"client_certificate_password": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Password for the certificate.",
+				Sensitive:        true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: tfhelper.DiffFuncSuppressSecretChanged,
 			},
+			secretHashKeyClientCertificate:         secretHashSchemaClientCertificate,
+			secretHashKeyClientCertificatePassword: secretHashSchemaClientCertificatePassword,
 		},
+	}
+	makeSecretSourceSchema(certificate, "client_certificate")
+	makeSecretSourceSchema(certificate, "client_certificate_password")
+	r.Schema[resourceBlockServiceFabricCertificate] = &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		Elem:          certificate,
 		ConflictsWith: []string{resourceBlockServiceFabricAzureActiveDirectory, resourceBlockServiceFabricNone},
 	}
 
 	secretHashKeyPassword, secretHashSchemaPassword := tfhelper.GenerateSecreteMemoSchema("password")
-	r.Schema[resourceBlockServiceFabricAzureActiveDirectory] = &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"server_certificate_lookup":      servicefabricServerCertificateLookupSchema(),
-				"server_certificate_thumbprint":  servicefabricServerCertificateThumbprintSchema(resourceBlockServiceFabricAzureActiveDirectory),
-				"server_certificate_common_name": servicefabricServerCertificateCommonNameSchema(resourceBlockServiceFabricAzureActiveDirectory),
-				"username": {
-					Type:         schema.TypeString,
-					Required:     true,
-					ValidateFunc: validation.StringIsNotEmpty,
-					Description:  "Specify an Azure Active Directory account.",
-				},
-				"password": {
-					Type:             schema.TypeString,
-					Required:         true,
-					Description:      "Password for the Azure Active Directory account.",
-					Sensitive:        true,
-					ValidateFunc:     validation.StringIsNotEmpty,
-					DiffSuppressFunc: tfhelper.DiffFuncSuppressSecretChanged,
-				},
-				secretHashKeyPassword: secretHashSchemaPassword,
+	azureActiveDirectory := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"server_certificate_lookup":      servicefabricServerCertificateLookupSchema(),
+			"server_certificate_thumbprint":  servicefabricServerCertificateThumbprintSchema(resourceBlockServiceFabricAzureActiveDirectory),
+			"server_certificate_common_name": servicefabricServerCertificateCommonNameSchema(resourceBlockServiceFabricAzureActiveDirectory),
+			"username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Specify an Azure Active Directory account.",
 			},
+			"password": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Password for the Azure Active Directory account.",
+				Sensitive:        true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: tfhelper.DiffFuncSuppressSecretChanged,
+			},
+			secretHashKeyPassword: secretHashSchemaPassword,
 		},
+	}
+	makeSecretSourceSchema(azureActiveDirectory, "password")
+	r.Schema[resourceBlockServiceFabricAzureActiveDirectory] = &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		Elem:          azureActiveDirectory,
 		ConflictsWith: []string{resourceBlockServiceFabricCertificate, resourceBlockServiceFabricNone},
 	}
 
@@ -158,8 +163,16 @@ func expandServiceEndpointServiceFabric(d *schema.ResourceData) (*serviceendpoin
 	if certificateOk {
 		configuration := certificate.([]interface{})[0].(map[string]interface{})
 		parameters := expandServiceEndpointServiceFabricServerCertificateLookup(configuration)
-		parameters["certificate"] = configuration["client_certificate"].(string)
-		parameters["certificatepassword"] = configuration["client_certificate_password"].(string)
+		clientCertificate, err := expandSecretValue(configuration, "client_certificate", true)
+		if err != nil {
+			return nil, nil, err
+		}
+		clientCertificatePassword, err := expandSecretValue(configuration, "client_certificate_password", false)
+		if err != nil {
+			return nil, nil, err
+		}
+		parameters["certificate"] = clientCertificate
+		parameters["certificatepassword"] = clientCertificatePassword
 		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 			Parameters: &parameters,
 			Scheme:     converter.String("Certificate"),
@@ -171,8 +184,12 @@ func expandServiceEndpointServiceFabric(d *schema.ResourceData) (*serviceendpoin
 	if azureActiveDirectoryExists {
 		configuration := azureActiveDirectory.([]interface{})[0].(map[string]interface{})
 		parameters := expandServiceEndpointServiceFabricServerCertificateLookup(configuration)
+		password, err := expandSecretValue(configuration, "password", true)
+		if err != nil {
+			return nil, nil, err
+		}
 		parameters["username"] = configuration["username"].(string)
-		parameters["password"] = configuration["password"].(string)
+		parameters["password"] = password
 		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 			Parameters: &parameters,
 			Scheme:     converter.String("UsernamePassword"),
@@ -211,7 +228,7 @@ func expandServiceEndpointServiceFabricServerCertificateLookup(configuration map
 	return parameters
 }
 
-func flattenServiceFabricCertificate(serviceEndpoint *serviceendpoint.ServiceEndpoint, hashKeyClientCertificate string, hashValueClientCertificate string, hashKeyClientCertificatePassword string, hashValueClientCertificatePassword string) interface{} {
+func flattenServiceFabricCertificate(serviceEndpoint *serviceendpoint.ServiceEndpoint, hashKeyClientCertificate string, hashValueClientCertificate string, hashKeyClientCertificatePassword string, hashValueClientCertificatePassword string) []map[string]interface{} {
 	result := flattenServiceEndpointServiceFabricServerCertificateLookup(serviceEndpoint)
 	result[0]["client_certificate"] = (*serviceEndpoint.Authorization.Parameters)["certificate"]
 	result[0]["client_certificate_password"] = (*serviceEndpoint.Authorization.Parameters)["certificatepassword"]
@@ -220,7 +237,7 @@ func flattenServiceFabricCertificate(serviceEndpoint *serviceendpoint.ServiceEnd
 	return result
 }
 
-func flattenServiceFabricAzureActiveDirectory(serviceEndpoint *serviceendpoint.ServiceEndpoint, hashKeyPassword string, hashValuePassword string) interface{} {
+func flattenServiceFabricAzureActiveDirectory(serviceEndpoint *serviceendpoint.ServiceEndpoint, hashKeyPassword string, hashValuePassword string) []map[string]interface{} {
 	result := flattenServiceEndpointServiceFabricServerCertificateLookup(serviceEndpoint)
 	result[0]["username"] = (*serviceEndpoint.Authorization.Parameters)["username"]
 	result[0]["password"] = (*serviceEndpoint.Authorization.Parameters)["password"]
@@ -260,13 +277,18 @@ func flattenServiceEndpointServiceFabric(d *schema.ResourceData, serviceEndpoint
 
 	switch *serviceEndpoint.Authorization.Scheme {
 	case "Certificate":
-		newHashClientCertificate, hashKeyClientCertificate := tfhelper.HelpFlattenSecretNested(d, resourceBlockServiceFabricCertificate, d.Get("certificate.0").(map[string]interface{}), "client_certificate")
-		newHashClientCertificatePassword, hashKeyClientCertificatePassword := tfhelper.HelpFlattenSecretNested(d, "certificate", d.Get("certificate.0").(map[string]interface{}), "client_certificate_password")
+		configuration := d.Get("certificate.0").(map[string]interface{})
+		newHashClientCertificate, hashKeyClientCertificate := tfhelper.HelpFlattenSecretNested(d, resourceBlockServiceFabricCertificate, configuration, "client_certificate")
+		newHashClientCertificatePassword, hashKeyClientCertificatePassword := tfhelper.HelpFlattenSecretNested(d, "certificate", configuration, "client_certificate_password")
 		certificate := flattenServiceFabricCertificate(serviceEndpoint, hashKeyClientCertificate, newHashClientCertificate, hashKeyClientCertificatePassword, newHashClientCertificatePassword)
+		flattenSecretSource(configuration, certificate[0], "client_certificate", d.HasChange(resourceBlockServiceFabricCertificate))
+		flattenSecretSource(configuration, certificate[0], "client_certificate_password", d.HasChange(resourceBlockServiceFabricCertificate))
 		d.Set(resourceBlockServiceFabricCertificate, certificate)
 	case "UsernamePassword":
-		newHashPassword, hashKeyPassword := tfhelper.HelpFlattenSecretNested(d, resourceBlockServiceFabricAzureActiveDirectory, d.Get("azure_active_directory.0").(map[string]interface{}), "password")
+		configuration := d.Get("azure_active_directory.0").(map[string]interface{})
+		newHashPassword, hashKeyPassword := tfhelper.HelpFlattenSecretNested(d, resourceBlockServiceFabricAzureActiveDirectory, configuration, "password")
 		azureActiveDirectory := flattenServiceFabricAzureActiveDirectory(serviceEndpoint, hashKeyPassword, newHashPassword)
+		flattenSecretSource(configuration, azureActiveDirectory[0], "password", d.HasChange(resourceBlockServiceFabricAzureActiveDirectory))
 		d.Set(resourceBlockServiceFabricAzureActiveDirectory, azureActiveDirectory)
 	case "None":
 		none := flattenServiceFabricNone(serviceEndpoint)
@@ -31,6 +31,7 @@ func ResourceServiceEndpointSonarQube() *schema.Resource {
 	// Add a spot in the schema to store the token secretly
 	stSecretHashKey, stSecretHashSchema := tfhelper.GenerateSecreteMemoSchema("token")
 	r.Schema[stSecretHashKey] = stSecretHashSchema
+	makeSecretSourceSchema(r, "token")
 
 	return r
 }
@@ -38,10 +39,14 @@ func ResourceServiceEndpointSonarQube() *schema.Resource {
 // Convert internal Terraform data structure to an AzDO data structure
 func expandServiceEndpointSonarQube(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
 	serviceEndpoint, projectID := doBaseExpansion(d)
+	token, err := expandResourceSecretValue(d, "token", true)
+	if err != nil {
+		return nil, nil, err
+	}
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Scheme: converter.String("UsernamePassword"),
 		Parameters: &map[string]string{
-			"username": d.Get("token").(string),
+			"username": token,
 		},
 	}
 	serviceEndpoint.Type = converter.String("sonarqube")
@@ -53,7 +58,7 @@ func expandServiceEndpointSonarQube(d *schema.ResourceData) (*serviceendpoint.Se
 func flattenServiceEndpointSonarQube(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID) {
 	doBaseFlattening(d, serviceEndpoint, projectID)
 
-	tfhelper.HelpFlattenSecret(d, "token")
+	flattenSecret(d, "token")
 
 	d.Set("url", *serviceEndpoint.Url)
 	d.Set("token", (*serviceEndpoint.Authorization.Parameters)["username"])
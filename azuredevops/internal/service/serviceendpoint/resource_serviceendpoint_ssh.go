@@ -53,6 +53,8 @@ func ResourceServiceEndpointSSH() *schema.Resource {
 	r.Schema[privateKeyHashKey] = privateKeyHashSchema
 	pwdHashKey, pwdHashSchema := tfhelper.GenerateSecreteMemoSchema("password")
 	r.Schema[pwdHashKey] = pwdHashSchema
+	makeSecretSourceSchema(r, "password")
+	makeSecretSourceSchema(r, "private_key")
 	return r
 }
 
@@ -64,8 +66,12 @@ func expandServiceEndpointSSH(d *schema.ResourceData) (*serviceendpoint.ServiceE
 	serviceEndpoint.Type = converter.String("ssh")
 	parameters := map[string]string{}
 	parameters["username"] = d.Get("username").(string)
-	if pwd, ok := d.GetOk("password"); ok {
-		parameters["password"] = pwd.(string)
+	password, err := expandResourceSecretValue(d, "password", false)
+	if err != nil {
+		return nil, nil, err
+	}
+	if password != "" {
+		parameters["password"] = password
 	}
 	serviceEndpoint.Authorization.Parameters = &parameters
 
@@ -74,8 +80,12 @@ func expandServiceEndpointSSH(d *schema.ResourceData) (*serviceendpoint.ServiceE
 	if port, ok := d.GetOk("port"); ok {
 		data["Port"] = strconv.Itoa(port.(int))
 	}
-	if privateKey, ok := d.GetOk("private_key"); ok {
-		data["PrivateKey"] = privateKey.(string)
+	privateKey, err := expandResourceSecretValue(d, "private_key", false)
+	if err != nil {
+		return nil, nil, err
+	}
+	if privateKey != "" {
+		data["PrivateKey"] = privateKey
 	}
 	serviceEndpoint.Data = &data
 
@@ -90,6 +100,6 @@ func flattenServiceEndpointSSH(d *schema.ResourceData, serviceEndpoint *servicee
 		d.Set("port", port)
 	}
 	d.Set("username", (*serviceEndpoint.Authorization.Parameters)["username"])
-	tfhelper.HelpFlattenSecret(d, "private_key")
-	tfhelper.HelpFlattenSecret(d, "password")
+	flattenSecret(d, "private_key")
+	flattenSecret(d, "password")
 }
@@ -0,0 +1,144 @@
+package taskagent
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const (
+	envProjectID                           = "project_id"
+	envName                                = "name"
+	envDescription                         = "description"
+	invalidEnvironmentIDErrorMessageFormat = "Environment ID was unexpectedly not a valid integer: %+v"
+)
+
+// ResourceEnvironment schema and implementation for environment resource
+func ResourceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceEnvironmentCreate,
+		Read:     resourceEnvironmentRead,
+		Update:   resourceEnvironmentUpdate,
+		Delete:   resourceEnvironmentDelete,
+		Importer: tfhelper.ImportProjectQualifiedResourceInteger(),
+		Schema: map[string]*schema.Schema{
+			envProjectID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsUUID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+			envName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			envDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceEnvironmentCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	environment, err := clients.TaskAgentClient.AddEnvironment(clients.Ctx, taskagent.AddEnvironmentArgs{
+		EnvironmentCreateParameter: &taskagent.EnvironmentCreateParameter{
+			Name:        converter.String(d.Get(envName).(string)),
+			Description: converter.String(d.Get(envDescription).(string)),
+		},
+		Project: converter.String(d.Get(envProjectID).(string)),
+	})
+	if err != nil {
+		return fmt.Errorf(" failed creating environment: %+v", err)
+	}
+
+	d.SetId(strconv.Itoa(*environment.Id))
+	return resourceEnvironmentRead(d, m)
+}
+
+func resourceEnvironmentRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	environmentID, err := converter.ASCIIToIntPtr(d.Id())
+	if err != nil {
+		return fmt.Errorf(invalidEnvironmentIDErrorMessageFormat, err)
+	}
+
+	environment, err := clients.TaskAgentClient.GetEnvironmentById(clients.Ctx, taskagent.GetEnvironmentByIdArgs{
+		Project:       converter.String(d.Get(envProjectID).(string)),
+		EnvironmentId: environmentID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" failed reading environment: %+v", err)
+	}
+
+	flattenEnvironment(d, environment)
+	return nil
+}
+
+func resourceEnvironmentUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	environmentID, err := converter.ASCIIToIntPtr(d.Id())
+	if err != nil {
+		return fmt.Errorf(invalidEnvironmentIDErrorMessageFormat, err)
+	}
+
+	_, err = clients.TaskAgentClient.UpdateEnvironment(clients.Ctx, taskagent.UpdateEnvironmentArgs{
+		EnvironmentUpdateParameter: &taskagent.EnvironmentUpdateParameter{
+			Name:        converter.String(d.Get(envName).(string)),
+			Description: converter.String(d.Get(envDescription).(string)),
+		},
+		Project:       converter.String(d.Get(envProjectID).(string)),
+		EnvironmentId: environmentID,
+	})
+	if err != nil {
+		return fmt.Errorf(" failed updating environment: %+v", err)
+	}
+
+	return resourceEnvironmentRead(d, m)
+}
+
+func resourceEnvironmentDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	environmentID, err := converter.ASCIIToIntPtr(d.Id())
+	if err != nil {
+		return fmt.Errorf(invalidEnvironmentIDErrorMessageFormat, err)
+	}
+
+	err = clients.TaskAgentClient.DeleteEnvironment(clients.Ctx, taskagent.DeleteEnvironmentArgs{
+		Project:       converter.String(d.Get(envProjectID).(string)),
+		EnvironmentId: environmentID,
+	})
+	if err != nil {
+		return fmt.Errorf(" failed deleting environment: %+v", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func flattenEnvironment(d *schema.ResourceData, environment *taskagent.EnvironmentInstance) {
+	d.Set(envName, converter.ToString(environment.Name, ""))
+	d.Set(envDescription, converter.ToString(environment.Description, ""))
+	if environment.Project != nil && environment.Project.Id != nil {
+		d.Set(envProjectID, environment.Project.Id.String())
+	}
+}
@@ -0,0 +1,276 @@
+package taskagent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const (
+	envKubeProjectID         = "project_id"
+	envKubeEnvironmentID     = "environment_id"
+	envKubeServiceEndpointID = "service_endpoint_id"
+	envKubeName              = "name"
+	envKubeNamespace         = "namespace"
+	envKubeClusterName       = "cluster_name"
+	envKubeTags              = "tags"
+)
+
+// ResourceEnvironmentKubernetes schema and implementation for Kubernetes environment resource
+func ResourceEnvironmentKubernetes() *schema.Resource {
+	// Note: there is no update API, so all fields will require a new resource
+	return &schema.Resource{
+		Create: resourceEnvironmentKubernetesCreate,
+		Read:   resourceEnvironmentKubernetesRead,
+		Delete: resourceEnvironmentKubernetesDelete,
+		Importer: &schema.ResourceImporter{
+			State: importEnvironmentKubernetes,
+		},
+		Schema: map[string]*schema.Schema{
+			envKubeProjectID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsUUID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+			envKubeEnvironmentID: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			envKubeServiceEndpointID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsUUID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+			envKubeName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			envKubeNamespace: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			envKubeClusterName: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			envKubeTags: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+type azDOTaskAgentAddKubernetesResourceArgs struct {
+	// (required) Parameters of a Kubernetes resource backed by an existing service endpoint
+	CreateParameters *taskagent.KubernetesResourceCreateParametersExistingEndpoint
+	// (required) Project ID or project name
+	Project *string
+	// (required)
+	EnvironmentId *int
+}
+
+// azDOTaskAgentAddKubernetesResource sends the request itself because
+// taskagent.AddKubernetesResourceArgs does not accept a service endpoint ID
+func azDOTaskAgentAddKubernetesResource(ctx context.Context, taskAgentClient taskagent.Client, args azDOTaskAgentAddKubernetesResourceArgs) (*taskagent.KubernetesResource, error) {
+	if args.CreateParameters == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.CreateParameters"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+	if args.EnvironmentId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.EnvironmentId"}
+	}
+	routeValues["environmentId"] = strconv.Itoa(*args.EnvironmentId)
+
+	body, marshalErr := json.Marshal(*args.CreateParameters)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	locationID, _ := uuid.Parse("73fba52f-15ab-42b3-a538-ce67a9223a04")
+	sdkClient, err := client.UnwrapClient(taskAgentClient)
+	if err != nil {
+		return nil, err
+	}
+	if clientImpl, ok := sdkClient.(*taskagent.ClientImpl); ok {
+		resp, err := clientImpl.Client.Send(ctx, http.MethodPost, locationID, "6.0-preview.1", routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+		if err != nil {
+			return nil, err
+		}
+		var responseValue taskagent.KubernetesResource
+		err = clientImpl.Client.UnmarshalBody(resp, &responseValue)
+		return &responseValue, err
+	}
+
+	return nil, fmt.Errorf("Invalid Azure DevOps TaskAgent client implementation %T", taskAgentClient)
+}
+
+func resourceEnvironmentKubernetesCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	args, err := expandEnvironmentKubernetes(d)
+	if err != nil {
+		return err
+	}
+
+	resource, err := azDOTaskAgentAddKubernetesResource(clients.Ctx, clients.TaskAgentClient, *args)
+	if err != nil {
+		return fmt.Errorf(" failed creating Kubernetes environment resource: %+v", err)
+	}
+
+	d.SetId(strconv.Itoa(*resource.Id))
+	return resourceEnvironmentKubernetesRead(d, m)
+}
+
+func resourceEnvironmentKubernetesRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	resourceID, err := converter.ASCIIToIntPtr(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing Kubernetes environment resource ID: %+v", err)
+	}
+
+	resource, err := clients.TaskAgentClient.GetKubernetesResource(clients.Ctx, taskagent.GetKubernetesResourceArgs{
+		Project:       converter.String(d.Get(envKubeProjectID).(string)),
+		EnvironmentId: converter.Int(d.Get(envKubeEnvironmentID).(int)),
+		ResourceId:    resourceID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" failed reading Kubernetes environment resource: %+v", err)
+	}
+
+	flattenEnvironmentKubernetes(d, resource)
+	return nil
+}
+
+func resourceEnvironmentKubernetesDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	resourceID, err := converter.ASCIIToIntPtr(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing Kubernetes environment resource ID: %+v", err)
+	}
+
+	err = clients.TaskAgentClient.DeleteKubernetesResource(clients.Ctx, taskagent.DeleteKubernetesResourceArgs{
+		Project:       converter.String(d.Get(envKubeProjectID).(string)),
+		EnvironmentId: converter.Int(d.Get(envKubeEnvironmentID).(int)),
+		ResourceId:    resourceID,
+	})
+	if err != nil {
+		return fmt.Errorf(" failed deleting Kubernetes environment resource: %+v", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// importEnvironmentKubernetes imports a resource by an ID that looks like one of the following:
+//
+//	<project ID>/<environment ID>/<resource ID>
+//	<project name>/<environment ID>/<resource ID>
+func importEnvironmentKubernetes(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf(" unexpected format of ID (%s), expected projectNameOrID/environmentID/resourceID", d.Id())
+	}
+
+	environmentID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf(" environment ID was expected to be integer, but was not: %+v", err)
+	}
+	if _, err := strconv.Atoi(parts[2]); err != nil {
+		return nil, fmt.Errorf(" resource ID was expected to be integer, but was not: %+v", err)
+	}
+
+	projectID, err := tfhelper.GetRealProjectId(parts[0], m)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set(envKubeProjectID, projectID)
+	d.Set(envKubeEnvironmentID, environmentID)
+	d.SetId(parts[2])
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandEnvironmentKubernetes(d *schema.ResourceData) (*azDOTaskAgentAddKubernetesResourceArgs, error) {
+	serviceEndpointID, err := uuid.Parse(d.Get(envKubeServiceEndpointID).(string))
+	if err != nil {
+		return nil, fmt.Errorf(" parsing service endpoint ID: %+v", err)
+	}
+
+	parameters := &taskagent.KubernetesResourceCreateParametersExistingEndpoint{
+		Name:              converter.String(d.Get(envKubeName).(string)),
+		Namespace:         converter.String(d.Get(envKubeNamespace).(string)),
+		ServiceEndpointId: &serviceEndpointID,
+	}
+	if clusterName, ok := d.GetOk(envKubeClusterName); ok {
+		parameters.ClusterName = converter.String(clusterName.(string))
+	}
+	if tags, ok := d.GetOk(envKubeTags); ok {
+		tagList := tfhelper.ExpandStringSet(tags.(*schema.Set))
+		parameters.Tags = &tagList
+	}
+
+	return &azDOTaskAgentAddKubernetesResourceArgs{
+		CreateParameters: parameters,
+		Project:          converter.String(d.Get(envKubeProjectID).(string)),
+		EnvironmentId:    converter.Int(d.Get(envKubeEnvironmentID).(int)),
+	}, nil
+}
+
+func flattenEnvironmentKubernetes(d *schema.ResourceData, resource *taskagent.KubernetesResource) {
+	d.Set(envKubeName, converter.ToString(resource.Name, ""))
+	d.Set(envKubeNamespace, converter.ToString(resource.Namespace, ""))
+	d.Set(envKubeClusterName, converter.ToString(resource.ClusterName, ""))
+	if resource.ServiceEndpointId != nil {
+		d.Set(envKubeServiceEndpointID, resource.ServiceEndpointId.String())
+	}
+	if resource.EnvironmentReference != nil && resource.EnvironmentReference.Id != nil {
+		d.Set(envKubeEnvironmentID, *resource.EnvironmentReference.Id)
+	}
+	if resource.Tags != nil {
+		d.Set(envKubeTags, *resource.Tags)
+	} else {
+		d.Set(envKubeTags, nil)
+	}
+}
@@ -0,0 +1,168 @@
+//go:build (all || resource_environment_resource_kubernetes) && !exclude_resource_environment_resource_kubernetes
+// +build all resource_environment_resource_kubernetes
+// +build !exclude_resource_environment_resource_kubernetes
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var kubernetesProjectID = uuid.New()
+var kubernetesServiceEndpointID = uuid.New()
+var kubernetesEnvironmentID = 3
+var kubernetesResourceID = 7
+
+var testKubernetesResource = taskagent.KubernetesResource{
+	Id:                converter.Int(kubernetesResourceID),
+	Name:              converter.String("frontend"),
+	Namespace:         converter.String("frontend-ns"),
+	ClusterName:       converter.String("aks-cluster"),
+	ServiceEndpointId: &kubernetesServiceEndpointID,
+	Tags:              &[]string{"web"},
+	EnvironmentReference: &taskagent.EnvironmentReference{
+		Id: converter.Int(kubernetesEnvironmentID),
+	},
+}
+
+func getKubernetesResourceData(t *testing.T) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, ResourceEnvironmentKubernetes().Schema, nil)
+	resourceData.Set(envKubeProjectID, kubernetesProjectID.String())
+	resourceData.Set(envKubeEnvironmentID, kubernetesEnvironmentID)
+	resourceData.Set(envKubeServiceEndpointID, kubernetesServiceEndpointID.String())
+	resourceData.Set(envKubeName, *testKubernetesResource.Name)
+	resourceData.Set(envKubeNamespace, *testKubernetesResource.Namespace)
+	resourceData.Set(envKubeClusterName, *testKubernetesResource.ClusterName)
+	resourceData.Set(envKubeTags, *testKubernetesResource.Tags)
+	return resourceData
+}
+
+func TestEnvironmentKubernetes_Expand_UsesExistingEndpoint(t *testing.T) {
+	args, err := expandEnvironmentKubernetes(getKubernetesResourceData(t))
+	require.Nil(t, err)
+
+	require.Equal(t, kubernetesProjectID.String(), *args.Project)
+	require.Equal(t, kubernetesEnvironmentID, *args.EnvironmentId)
+	require.Equal(t, &taskagent.KubernetesResourceCreateParametersExistingEndpoint{
+		Name:              testKubernetesResource.Name,
+		Namespace:         testKubernetesResource.Namespace,
+		ClusterName:       testKubernetesResource.ClusterName,
+		ServiceEndpointId: &kubernetesServiceEndpointID,
+		Tags:              testKubernetesResource.Tags,
+	}, args.CreateParameters)
+}
+
+func TestEnvironmentKubernetes_Read_FlattensResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceEnvironmentKubernetes().Schema, nil)
+	resourceData.Set(envKubeProjectID, kubernetesProjectID.String())
+	resourceData.Set(envKubeEnvironmentID, kubernetesEnvironmentID)
+	resourceData.SetId("7")
+
+	taskAgentClient.
+		EXPECT().
+		GetKubernetesResource(clients.Ctx, taskagent.GetKubernetesResourceArgs{
+			Project:       converter.String(kubernetesProjectID.String()),
+			EnvironmentId: converter.Int(kubernetesEnvironmentID),
+			ResourceId:    converter.Int(kubernetesResourceID),
+		}).
+		Return(&testKubernetesResource, nil).
+		Times(1)
+
+	err := resourceEnvironmentKubernetesRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, *testKubernetesResource.Name, resourceData.Get(envKubeName))
+	require.Equal(t, *testKubernetesResource.Namespace, resourceData.Get(envKubeNamespace))
+	require.Equal(t, *testKubernetesResource.ClusterName, resourceData.Get(envKubeClusterName))
+	require.Equal(t, kubernetesServiceEndpointID.String(), resourceData.Get(envKubeServiceEndpointID))
+	require.Equal(t, 1, resourceData.Get(envKubeTags).(*schema.Set).Len())
+}
+
+func TestEnvironmentKubernetes_Read_RemovesResourceIfNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	resourceData := getKubernetesResourceData(t)
+	resourceData.SetId("7")
+
+	taskAgentClient.
+		EXPECT().
+		GetKubernetesResource(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{
+			StatusCode: converter.Int(http.StatusNotFound),
+		}).
+		Times(1)
+
+	err := resourceEnvironmentKubernetesRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+func TestEnvironmentKubernetes_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	resourceData := getKubernetesResourceData(t)
+	resourceData.SetId("7")
+
+	taskAgentClient.
+		EXPECT().
+		DeleteKubernetesResource(clients.Ctx, taskagent.DeleteKubernetesResourceArgs{
+			Project:       converter.String(kubernetesProjectID.String()),
+			EnvironmentId: converter.Int(kubernetesEnvironmentID),
+			ResourceId:    converter.Int(kubernetesResourceID),
+		}).
+		Return(errors.New("DeleteKubernetesResource() Failed")).
+		Times(1)
+
+	err := resourceEnvironmentKubernetesDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteKubernetesResource() Failed")
+}
+
+// verifies that a client that can't send the request fails with an error instead of a panic
+func TestEnvironmentKubernetes_Create_UnsupportedClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clients := &client.AggregatedClient{
+		TaskAgentClient: azdosdkmocks.NewMockTaskagentClient(ctrl),
+		Ctx:             context.Background(),
+	}
+	err := resourceEnvironmentKubernetesCreate(getKubernetesResourceData(t), clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Invalid Azure DevOps TaskAgent client implementation")
+}
+
+func TestEnvironmentKubernetes_Import_RejectsMalformedID(t *testing.T) {
+	for _, id := range []string{"project", "project/3", "project/env/7", "project/3/resource", "/3/7"} {
+		resourceData := schema.TestResourceDataRaw(t, ResourceEnvironmentKubernetes().Schema, nil)
+		resourceData.SetId(id)
+
+		_, err := importEnvironmentKubernetes(resourceData, &client.AggregatedClient{})
+		require.NotNil(t, err, "expected an error for ID %q", id)
+	}
+}
@@ -0,0 +1,167 @@
+//go:build (all || resource_environment) && !exclude_resource_environment
+// +build all resource_environment
+// +build !exclude_resource_environment
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var environmentProjectID = uuid.New()
+var environmentID = 10
+
+var testEnvironment = taskagent.EnvironmentInstance{
+	Id:          converter.Int(environmentID),
+	Name:        converter.String("production"),
+	Description: converter.String("Production environment"),
+	Project: &taskagent.ProjectReference{
+		Id: &environmentProjectID,
+	},
+}
+
+func getEnvironmentResourceData(t *testing.T) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, ResourceEnvironment().Schema, nil)
+	resourceData.Set(envProjectID, environmentProjectID.String())
+	resourceData.Set(envName, *testEnvironment.Name)
+	resourceData.Set(envDescription, *testEnvironment.Description)
+	return resourceData
+}
+
+func TestEnvironment_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	expectedArgs := taskagent.AddEnvironmentArgs{
+		EnvironmentCreateParameter: &taskagent.EnvironmentCreateParameter{
+			Name:        testEnvironment.Name,
+			Description: testEnvironment.Description,
+		},
+		Project: converter.String(environmentProjectID.String()),
+	}
+	taskAgentClient.
+		EXPECT().
+		AddEnvironment(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("AddEnvironment() Failed")).
+		Times(1)
+
+	err := resourceEnvironmentCreate(getEnvironmentResourceData(t), clients)
+	require.Contains(t, err.Error(), "AddEnvironment() Failed")
+}
+
+func TestEnvironment_Read_FlattensEnvironment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceEnvironment().Schema, nil)
+	resourceData.Set(envProjectID, environmentProjectID.String())
+	resourceData.SetId("10")
+
+	taskAgentClient.
+		EXPECT().
+		GetEnvironmentById(clients.Ctx, taskagent.GetEnvironmentByIdArgs{
+			Project:       converter.String(environmentProjectID.String()),
+			EnvironmentId: converter.Int(environmentID),
+		}).
+		Return(&testEnvironment, nil).
+		Times(1)
+
+	err := resourceEnvironmentRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "10", resourceData.Id())
+	require.Equal(t, *testEnvironment.Name, resourceData.Get(envName))
+	require.Equal(t, *testEnvironment.Description, resourceData.Get(envDescription))
+}
+
+func TestEnvironment_Read_RemovesResourceIfNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	resourceData := getEnvironmentResourceData(t)
+	resourceData.SetId("10")
+
+	taskAgentClient.
+		EXPECT().
+		GetEnvironmentById(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{
+			StatusCode: converter.Int(http.StatusNotFound),
+		}).
+		Times(1)
+
+	err := resourceEnvironmentRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+func TestEnvironment_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	resourceData := getEnvironmentResourceData(t)
+	resourceData.SetId("10")
+
+	expectedArgs := taskagent.UpdateEnvironmentArgs{
+		EnvironmentUpdateParameter: &taskagent.EnvironmentUpdateParameter{
+			Name:        testEnvironment.Name,
+			Description: testEnvironment.Description,
+		},
+		Project:       converter.String(environmentProjectID.String()),
+		EnvironmentId: converter.Int(environmentID),
+	}
+	taskAgentClient.
+		EXPECT().
+		UpdateEnvironment(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("UpdateEnvironment() Failed")).
+		Times(1)
+
+	err := resourceEnvironmentUpdate(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateEnvironment() Failed")
+}
+
+func TestEnvironment_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	resourceData := getEnvironmentResourceData(t)
+	resourceData.SetId("10")
+
+	taskAgentClient.
+		EXPECT().
+		DeleteEnvironment(clients.Ctx, taskagent.DeleteEnvironmentArgs{
+			Project:       converter.String(environmentProjectID.String()),
+			EnvironmentId: converter.Int(environmentID),
+		}).
+		Return(errors.New("DeleteEnvironment() Failed")).
+		Times(1)
+
+	err := resourceEnvironmentDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteEnvironment() Failed")
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -65,6 +66,33 @@ func ToBool(value *bool, defaultValue bool) bool {
 	return defaultValue
 }
 
+// ToInt Given a pointer return its value, or a default value of the pointer is nil
+func ToInt(value *int, defaultValue int) int {
+	if value != nil {
+		return *value
+	}
+
+	return defaultValue
+}
+
+// ToStringSlice Given a pointer return its value, or a default value of the pointer is nil
+func ToStringSlice(value *[]string, defaultValue []string) []string {
+	if value != nil {
+		return *value
+	}
+
+	return defaultValue
+}
+
+// ThroughJSON Convert a value to another type by encoding it as JSON and decoding it into out
+func ThroughJSON(in interface{}, out interface{}) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
 // AccountLicenseType Get a pointer to an AccountLicenseType
 func AccountLicenseType(accountLicenseTypeValue string) (*licensing.AccountLicenseType, error) {
 	var accountLicenseType licensing.AccountLicenseType
@@ -147,3 +147,24 @@ func TestEncodeUtf16HexString(t *testing.T) {
 		assert.EqualValues(t, etest.encodedString, val)
 	}
 }
+
+func TestToInt(t *testing.T) {
+	assert.Equal(t, 42, ToInt(Int(42), 0))
+	assert.Equal(t, 7, ToInt(nil, 7))
+}
+
+func TestToStringSlice(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, ToStringSlice(&[]string{"a", "b"}, nil))
+	assert.Equal(t, []string{}, ToStringSlice(nil, []string{}))
+}
+
+func TestThroughJSON(t *testing.T) {
+	var out struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}
+	err := ThroughJSON(map[string]interface{}{"name": "test", "count": 3}, &out)
+	assert.Nil(t, err)
+	assert.Equal(t, "test", out.Name)
+	assert.Equal(t, 3, out.Count)
+}
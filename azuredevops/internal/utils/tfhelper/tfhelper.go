@@ -57,7 +57,7 @@ func DiffFuncSuppressSecretChanged(k, old, new string, d *schema.ResourceData) b
 func HelpFlattenSecretNested(d *schema.ResourceData, parentKey string, d2 map[string]interface{}, secretKey string) (string, string) {
 	hashKey := calcSecretHashKey(secretKey)
 	if len(d2) == 0 {
-		return hashKey, ""
+		return "", hashKey
 	}
 	oldHash := d2[hashKey].(string)
 	if !d.HasChange(parentKey) {
@@ -0,0 +1,135 @@
+package azuredevops
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+)
+
+const (
+	organization = "organization"
+
+	// separates the organization url from the ID of the resource in an import ID
+	organizationImportSeparator = "|"
+)
+
+// configureResources adds the organization argument to the resources and data sources and wraps their functions,
+// so that they run with the clients of the organization and fail with a clear error when they use an API that the
+// Azure DevOps Server does not provide
+func configureResources(p *schema.Provider) {
+	for name, resource := range p.ResourcesMap {
+		resource.Schema[organization] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The url of the Azure DevOps organization of the resource, defaults to the org_service_url of the provider.",
+		}
+		wrapResource(name, resource)
+	}
+	for name, dataSource := range p.DataSourcesMap {
+		dataSource.Schema[organization] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The url of the Azure DevOps organization of the data source, defaults to the org_service_url of the provider.",
+		}
+		wrapResource(name, dataSource)
+	}
+}
+
+func wrapResource(name string, resource *schema.Resource) {
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, m interface{}) error {
+			clients, err := organizationClients(d, m)
+			if err != nil {
+				return err
+			}
+			return unsupportedAPI(name, clients, f(d, clients))
+		}
+	}
+	resource.Create = wrap(resource.Create)
+	resource.Read = wrap(resource.Read)
+	resource.Update = wrap(resource.Update)
+	resource.Delete = wrap(resource.Delete)
+
+	if exists := resource.Exists; exists != nil {
+		resource.Exists = func(d *schema.ResourceData, m interface{}) (bool, error) {
+			clients, err := organizationClients(d, m)
+			if err != nil {
+				return false, err
+			}
+			ok, err := exists(d, clients)
+			return ok, unsupportedAPI(name, clients, err)
+		}
+	}
+	if customizeDiff := resource.CustomizeDiff; customizeDiff != nil {
+		resource.CustomizeDiff = func(d *schema.ResourceDiff, m interface{}) error {
+			if !d.NewValueKnown(organization) {
+				// the diff is customized again once the organization is known
+				return nil
+			}
+			clients, err := organizationClients(d, m)
+			if err != nil {
+				return err
+			}
+			return unsupportedAPI(name, clients, customizeDiff(d, clients))
+		}
+	}
+	if resource.Importer != nil && resource.Importer.State != nil {
+		state := resource.Importer.State
+		resource.Importer.State = func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			// resources of other organizations are imported with an ID prefixed by the organization url
+			if organizationURL, id, ok := parseOrganizationImportID(d.Id()); ok {
+				d.Set(organization, organizationURL)
+				d.SetId(id)
+			}
+			clients, err := organizationClients(d, m)
+			if err != nil {
+				return nil, err
+			}
+			imported, err := state(d, clients)
+			for _, importedData := range imported {
+				importedData.Set(organization, d.Get(organization))
+			}
+			return imported, unsupportedAPI(name, clients, err)
+		}
+	}
+}
+
+// resourceGetter reads the arguments of a resource, it is implemented by schema.ResourceData and schema.ResourceDiff
+type resourceGetter interface {
+	Get(key string) interface{}
+}
+
+// organizationClients returns the clients of the organization of the resource
+func organizationClients(d resourceGetter, m interface{}) (interface{}, error) {
+	clients, ok := m.(*client.AggregatedClient)
+	if !ok {
+		return m, nil
+	}
+	organizationURL, _ := d.Get(organization).(string)
+	return clients.ForOrganization(organizationURL)
+}
+
+// parseOrganizationImportID splits an import ID of the form <organization url>|<resource ID>
+func parseOrganizationImportID(importID string) (string, string, bool) {
+	lowerID := strings.ToLower(importID)
+	if !strings.HasPrefix(lowerID, "https://") && !strings.HasPrefix(lowerID, "http://") {
+		return "", "", false
+	}
+	parts := strings.SplitN(importID, organizationImportSeparator, 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+func unsupportedAPI(name string, m interface{}, err error) error {
+	if clients, ok := m.(*client.AggregatedClient); ok && err != nil {
+		return client.UnsupportedAPI(name, clients.ServerInfo, err)
+	}
+	return err
+}
@@ -0,0 +1,116 @@
+package azuredevops
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvider_ResourcesHaveOrganization(t *testing.T) {
+	provider := Provider()
+	for name, resource := range provider.ResourcesMap {
+		require.Contains(t, resource.Schema, organization, "resource %s has no organization argument", name)
+		require.True(t, resource.Schema[organization].ForceNew)
+	}
+	for name, dataSource := range provider.DataSourcesMap {
+		require.Contains(t, dataSource.Schema, organization, "data source %s has no organization argument", name)
+	}
+}
+
+// verifies that the resources report the APIs an Azure DevOps Server does not provide
+func TestProvider_ReportsUnsupportedAPIs(t *testing.T) {
+	clients := &client.AggregatedClient{
+		ServerInfo: &client.ServerInfo{DeploymentType: client.DeploymentTypeOnPremises, APIVersion: "5.0"},
+	}
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			organization: {Type: schema.TypeString, Optional: true},
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			err := &azuredevops.LocationIdNotRegisteredError{LocationId: uuid.New(), Url: "https://tfs.contoso.com/tfs/defaultcollection"}
+			return fmt.Errorf("reading environment: %+v", err)
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			return errors.New("environment not found")
+		},
+	}
+	wrapResource("azuredevops_environment", resource)
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+
+	err := resource.Read(d, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "azuredevops_environment is unsupported on this server version (Azure DevOps Server, API version 5.0)")
+	require.Equal(t, "environment not found", resource.Delete(d, clients).Error())
+	require.Nil(t, resource.Create)
+}
+
+// verifies that the resources import the ID of another organization
+func TestProvider_ImportsOrganizationID(t *testing.T) {
+	var importedID string
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			organization: {Type: schema.TypeString, Optional: true},
+		},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				importedID = d.Id()
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+	wrapResource("azuredevops_project", resource)
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+	d.SetId("https://dev.azure.com/other|2f9bd1b4-0a6c-4a5e-9c42-6d5d1b1e8e3a")
+	imported, err := resource.Importer.State(d, "meta")
+	require.Nil(t, err)
+	require.Equal(t, "2f9bd1b4-0a6c-4a5e-9c42-6d5d1b1e8e3a", importedID)
+	require.Equal(t, "https://dev.azure.com/other", imported[0].Get(organization))
+}
+
+// verifies that the diff of a resource is customized with the clients of its organization
+func TestProvider_CustomizeDiffUsesOrganizationClients(t *testing.T) {
+	var diffClients interface{}
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			organization: {Type: schema.TypeString, Optional: true, ForceNew: true},
+			"name":       {Type: schema.TypeString, Optional: true},
+		},
+		CustomizeDiff: func(d *schema.ResourceDiff, m interface{}) error {
+			diffClients = m
+			return nil
+		},
+	}
+	wrapResource("azuredevops_project", resource)
+	clients := &client.AggregatedClient{OrganizationURL: "https://dev.azure.com/default"}
+
+	_, err := resource.Diff(nil, terraform.NewResourceConfigRaw(map[string]interface{}{"name": "project"}), clients)
+	require.Nil(t, err)
+	require.True(t, diffClients == clients)
+
+	diffClients = nil
+	config := map[string]interface{}{"name": "project", organization: "https://dev.azure.com/other"}
+	_, err = resource.Diff(nil, terraform.NewResourceConfigRaw(config), clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "can not be used for the organization https://dev.azure.com/other")
+	require.Nil(t, diffClients)
+}
+
+func TestProvider_ParseOrganizationImportID(t *testing.T) {
+	organizationURL, id, ok := parseOrganizationImportID("https://dev.azure.com/other|project/definition")
+	require.True(t, ok)
+	require.Equal(t, "https://dev.azure.com/other", organizationURL)
+	require.Equal(t, "project/definition", id)
+
+	for _, importID := range []string{"project/definition", "https://dev.azure.com/other", "https://dev.azure.com/other|"} {
+		_, _, ok := parseOrganizationImportID(importID)
+		require.False(t, ok, importID)
+	}
+}
@@ -1,10 +1,15 @@
 package azuredevops
 
 import (
+	"sort"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/checks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/core"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/git"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/graph"
@@ -12,6 +17,7 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/policy/branch"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/policy/repository"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/release"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/serviceendpoint"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/taskagent"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/workitemtracking"
@@ -30,6 +36,9 @@ func Provider() *schema.Provider {
 			"azuredevops_branch_policy_merge_types":              branch.ResourceBranchPolicyMergeTypes(),
 			"azuredevops_branch_policy_status_check":             branch.ResourceBranchPolicyStatusCheck(),
 			"azuredevops_build_definition":                       build.ResourceBuildDefinition(),
+			"azuredevops_build_folder":                           build.ResourceBuildFolder(),
+			"azuredevops_build_run":                              build.ResourceBuildRun(),
+			"azuredevops_release_definition":                     release.ResourceReleaseDefinition(),
 			"azuredevops_project":                                core.ResourceProject(),
 			"azuredevops_project_features":                       core.ResourceProjectFeatures(),
 			"azuredevops_variable_group":                         taskagent.ResourceVariableGroup(),
@@ -59,12 +68,25 @@ func Provider() *schema.Provider {
 			"azuredevops_serviceendpoint_npm":                    serviceendpoint.ResourceServiceEndpointNpm(),
 			"azuredevops_serviceendpoint_generic":                serviceendpoint.ResourceServiceEndpointGeneric(),
 			"azuredevops_serviceendpoint_generic_git":            serviceendpoint.ResourceServiceEndpointGenericGit(),
+			"azuredevops_serviceendpoint_nuget":                  serviceendpoint.ResourceServiceEndpointNuGet(),
+			"azuredevops_serviceendpoint_maven":                  serviceendpoint.ResourceServiceEndpointMaven(),
+			"azuredevops_serviceendpoint_python_upload":          serviceendpoint.ResourceServiceEndpointPythonUpload(),
+			"azuredevops_serviceendpoint_python_download":        serviceendpoint.ResourceServiceEndpointPythonDownload(),
+			"azuredevops_serviceendpoint_jenkins":                serviceendpoint.ResourceServiceEndpointJenkins(),
+			"azuredevops_serviceendpoint_gitlab":                 serviceendpoint.ResourceServiceEndpointGitLab(),
+			"azuredevops_serviceendpoint_azureservicebus":        serviceendpoint.ResourceServiceEndpointAzureServiceBus(),
+			"azuredevops_serviceendpoint_checkmarx":              serviceendpoint.ResourceServiceEndpointCheckmarx(),
+			"azuredevops_serviceendpoint_custom":                 serviceendpoint.ResourceServiceEndpointCustom(),
 			"azuredevops_git_repository":                         git.ResourceGitRepository(),
 			"azuredevops_git_repository_file":                    git.ResourceGitRepositoryFile(),
+			"azuredevops_git_repository_files":                   git.ResourceGitRepositoryFiles(),
+			"azuredevops_git_repository_branch":                  git.ResourceGitRepositoryBranch(),
 			"azuredevops_user_entitlement":                       memberentitlementmanagement.ResourceUserEntitlement(),
 			"azuredevops_group_membership":                       graph.ResourceGroupMembership(),
 			"azuredevops_agent_pool":                             taskagent.ResourceAgentPool(),
 			"azuredevops_agent_queue":                            taskagent.ResourceAgentQueue(),
+			"azuredevops_environment":                            taskagent.ResourceEnvironment(),
+			"azuredevops_environment_resource_kubernetes":        taskagent.ResourceEnvironmentKubernetes(),
 			"azuredevops_group":                                  graph.ResourceGroup(),
 			"azuredevops_project_permissions":                    permissions.ResourceProjectPermissions(),
 			"azuredevops_git_permissions":                        permissions.ResourceGitPermissions(),
@@ -72,12 +94,19 @@ func Provider() *schema.Provider {
 			"azuredevops_area_permissions":                       permissions.ResourceAreaPermissions(),
 			"azuredevops_iteration_permissions":                  permissions.ResourceIterationPermissions(),
 			"azuredevops_build_definition_permissions":           permissions.ResourceBuildDefinitionPermissions(),
+			"azuredevops_build_folder_permissions":               permissions.ResourceBuildFolderPermissions(),
 			"azuredevops_team":                                   core.ResourceTeam(),
 			"azuredevops_team_members":                           core.ResourceTeamMembers(),
 			"azuredevops_team_administrators":                    core.ResourceTeamAdministrators(),
 			"azuredevops_serviceendpoint_permissions":            permissions.ResourceServiceEndpointPermissions(),
 			"azuredevops_servicehook_permissions":                permissions.ResourceServiceHookPermissions(),
 			"azuredevops_tagging_permissions":                    permissions.ResourceTaggingPermissions(),
+			"azuredevops_environment_permissions":                permissions.ResourceEnvironmentPermissions(),
+			"azuredevops_check_approval":                         checks.ResourceCheckApproval(),
+			"azuredevops_check_branch_control":                   checks.ResourceCheckBranchControl(),
+			"azuredevops_check_business_hours":                   checks.ResourceCheckBusinessHours(),
+			"azuredevops_check_required_template":                checks.ResourceCheckRequiredTemplate(),
+			"azuredevops_check_rest_api":                         checks.ResourceCheckRestAPI(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"azuredevops_agent_pool":       taskagent.DataAgentPool(),
@@ -110,10 +139,157 @@ func Provider() *schema.Provider {
 				Description: "The personal access token which should be used.",
 				Sensitive:   true,
 			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_USERNAME", nil),
+				Description: "The user name sent with the personal access token using basic authentication, e.g. for Azure DevOps Server collections.",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_CLIENT_ID", nil),
+				Description: "The client ID of the service principal or user assigned managed identity which should be used.",
+			},
+			"tenant_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_TENANT_ID", nil),
+				Description: "The tenant ID of the service principal which should be used.",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_CLIENT_SECRET", nil),
+				Description: "The client secret of the service principal which should be used.",
+				Sensitive:   true,
+			},
+			"client_certificate_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_CLIENT_CERTIFICATE_PATH", nil),
+				Description: "The path of the PEM encoded client certificate and private key of the service principal which should be used.",
+			},
+			"client_certificate_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_CLIENT_CERTIFICATE_PASSWORD", nil),
+				Description: "The password of the private key of the client certificate.",
+				Sensitive:   true,
+			},
+			"oidc_token_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_OIDC_TOKEN_FILE_PATH", nil),
+				Description: "The path of the file containing the federated OIDC token of the service principal which should be used.",
+			},
+			"use_msi": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_USE_MSI", false),
+				Description: "Use the managed identity of the machine running Terraform.",
+			},
+			"msi_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_MSI_ENDPOINT", nil),
+				Description: "The endpoint of the managed identity token service which should be used.",
+			},
+			"authority_host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_AUTHORITY_HOST", nil),
+				Description: "The Azure Active Directory authority which should be used, defaults to https://login.microsoftonline.com/.",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      client.DefaultMaxRetries,
+				Description:  "The number of times a throttled or failed request is retried.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_max_wait": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(client.DefaultRetryMaxWait / time.Second),
+				Description:  "The longest time in seconds to wait before a request is retried.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "The maximum number of requests sent to Azure DevOps at the same time, 0 means unlimited.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_concurrent_requests_per_area": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "The maximum number of requests sent to an API area, e.g. graph, security or git, at the same time.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"max_requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0.0,
+				Description:  "The maximum number of requests per second sent to the organization, 0 means unlimited.",
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"identity_cache_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(client.DefaultIdentityCacheTTL / time.Second),
+				Description:  "The time in seconds the identities, descriptors and storage keys resolved by the resources are cached, 0 disables the cache.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_TRACE_FILE", nil),
+				Description: "The file the requests to Azure DevOps and their responses are written to as JSON lines, with credentials redacted.",
+			},
+			"ca_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_CA_CERT_FILE", nil),
+				Description: "The PEM file of the certificate authorities trusted in addition to the system roots, e.g. of an Azure DevOps Server or a TLS intercepting proxy.",
+			},
+			"ca_cert_pem": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_CA_CERT_PEM", nil),
+				Description: "The PEM encoded certificate authorities trusted in addition to the system roots.",
+			},
+			"proxy_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AZDO_PROXY_URL", nil),
+				Description:  "The url of the proxy the requests are sent through, defaults to the HTTPS_PROXY environment variable.",
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_INSECURE_SKIP_VERIFY", false),
+				Description: "Do not verify the certificates of Azure DevOps, for testing only.",
+			},
+			"tls_client_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_TLS_CLIENT_CERT_FILE", nil),
+				Description: "The PEM file of the client certificate presented to servers requiring mutual TLS.",
+			},
+			"tls_client_key_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_TLS_CLIENT_KEY_FILE", nil),
+				Description: "The PEM file of the private key of the client certificate.",
+			},
 		},
 	}
 
 	p.ConfigureFunc = providerConfigure(p)
+	configureResources(p)
 
 	return p
 }
@@ -127,8 +303,75 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			terraformVersion = "0.11+compatible"
 		}
 
-		client, err := client.GetAzdoClient(d.Get("personal_access_token").(string), d.Get("org_service_url").(string), terraformVersion)
+		authConfig := &client.AuthConfig{
+			PersonalAccessToken:       d.Get("personal_access_token").(string),
+			Username:                  d.Get("username").(string),
+			ClientID:                  d.Get("client_id").(string),
+			TenantID:                  d.Get("tenant_id").(string),
+			ClientSecret:              d.Get("client_secret").(string),
+			ClientCertificatePath:     d.Get("client_certificate_path").(string),
+			ClientCertificatePassword: d.Get("client_certificate_password").(string),
+			OIDCTokenFilePath:         d.Get("oidc_token_file_path").(string),
+			UseMSI:                    d.Get("use_msi").(bool),
+			MSIEndpoint:               d.Get("msi_endpoint").(string),
+			AuthorityHost:             d.Get("authority_host").(string),
+		}
+		transportConfig := &client.TransportConfig{
+			MaxRetries:   d.Get("max_retries").(int),
+			RetryMaxWait: time.Duration(d.Get("retry_max_wait").(int)) * time.Second,
+
+			MaxConcurrentRequests:        d.Get("max_concurrent_requests").(int),
+			MaxConcurrentRequestsPerArea: map[string]int{},
+			MaxRequestsPerSecond:         d.Get("max_requests_per_second").(float64),
+
+			IdentityCacheTTL: time.Duration(d.Get("identity_cache_ttl").(int)) * time.Second,
+
+			TraceFile:       d.Get("trace_file").(string),
+			SensitiveFields: sensitiveFieldNames(p),
+
+			CACertFile:         d.Get("ca_cert_file").(string),
+			CACertPEM:          d.Get("ca_cert_pem").(string),
+			ProxyURL:           d.Get("proxy_url").(string),
+			InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+			ClientCertFile:     d.Get("tls_client_cert_file").(string),
+			ClientKeyFile:      d.Get("tls_client_key_file").(string),
+		}
+		for area, limit := range d.Get("max_concurrent_requests_per_area").(map[string]interface{}) {
+			transportConfig.MaxConcurrentRequestsPerArea[area] = limit.(int)
+		}
+		// the clients are created once a resource needs them, provider configurations that are not used
+		// by any resource do not connect to Azure DevOps
+		return client.NewAggregatedClient(authConfig, transportConfig, d.Get("org_service_url").(string), terraformVersion), nil
+	}
+}
+
+// sensitiveFieldNames returns the names of all sensitive fields of the provider, resources and data sources
+func sensitiveFieldNames(p *schema.Provider) []string {
+	names := map[string]bool{}
+	var collect func(fields map[string]*schema.Schema)
+	collect = func(fields map[string]*schema.Schema) {
+		for name, field := range fields {
+			if field.Sensitive {
+				names[name] = true
+			}
+			if elem, ok := field.Elem.(*schema.Resource); ok {
+				collect(elem.Schema)
+			}
+		}
+	}
+
+	collect(p.Schema)
+	for _, resource := range p.ResourcesMap {
+		collect(resource.Schema)
+	}
+	for _, dataSource := range p.DataSourcesMap {
+		collect(dataSource.Schema)
+	}
 
-		return client, err
+	sensitive := make([]string, 0, len(names))
+	for name := range names {
+		sensitive = append(sensitive, name)
 	}
+	sort.Strings(sensitive)
+	return sensitive
 }
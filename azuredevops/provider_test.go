@@ -12,6 +12,9 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_resource_authorization",
 		"azuredevops_build_definition",
 		"azuredevops_build_definition_permissions",
+		"azuredevops_build_folder",
+		"azuredevops_build_folder_permissions",
+		"azuredevops_build_run",
 		"azuredevops_branch_policy_build_validation",
 		"azuredevops_branch_policy_min_reviewers",
 		"azuredevops_branch_policy_auto_reviewers",
@@ -19,6 +22,7 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_branch_policy_comment_resolution",
 		"azuredevops_branch_policy_merge_types",
 		"azuredevops_branch_policy_status_check",
+		"azuredevops_release_definition",
 		"azuredevops_project",
 		"azuredevops_project_features",
 		"azuredevops_serviceendpoint_github",
@@ -40,6 +44,15 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_serviceendpoint_generic",
 		"azuredevops_serviceendpoint_generic_git",
 		"azuredevops_serviceendpoint_incomingwebhook",
+		"azuredevops_serviceendpoint_nuget",
+		"azuredevops_serviceendpoint_maven",
+		"azuredevops_serviceendpoint_python_upload",
+		"azuredevops_serviceendpoint_python_download",
+		"azuredevops_serviceendpoint_jenkins",
+		"azuredevops_serviceendpoint_gitlab",
+		"azuredevops_serviceendpoint_azureservicebus",
+		"azuredevops_serviceendpoint_checkmarx",
+		"azuredevops_serviceendpoint_custom",
 		"azuredevops_variable_group",
 		"azuredevops_repository_policy_author_email_pattern",
 		"azuredevops_repository_policy_case_enforcement",
@@ -50,11 +63,15 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_repository_policy_check_credentials",
 		"azuredevops_git_repository",
 		"azuredevops_git_repository_file",
+		"azuredevops_git_repository_files",
+		"azuredevops_git_repository_branch",
 		"azuredevops_user_entitlement",
 		"azuredevops_group_membership",
 		"azuredevops_group",
 		"azuredevops_agent_pool",
 		"azuredevops_agent_queue",
+		"azuredevops_environment",
+		"azuredevops_environment_resource_kubernetes",
 		"azuredevops_project_permissions",
 		"azuredevops_git_permissions",
 		"azuredevops_workitemquery_permissions",
@@ -64,6 +81,12 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_team_members",
 		"azuredevops_team_administrators",
 		"azuredevops_serviceendpoint_permissions",
+		"azuredevops_environment_permissions",
+		"azuredevops_check_approval",
+		"azuredevops_check_branch_control",
+		"azuredevops_check_business_hours",
+		"azuredevops_check_required_template",
+		"azuredevops_check_rest_api",
 		"azuredevops_servicehook_permissions",
 		"azuredevops_tagging_permissions",
 	}
@@ -116,6 +139,29 @@ func TestProvider_SchemaIsValid(t *testing.T) {
 	tests := []testParams{
 		{"org_service_url", false, "AZDO_ORG_SERVICE_URL", false},
 		{"personal_access_token", false, "AZDO_PERSONAL_ACCESS_TOKEN", true},
+		{"username", false, "AZDO_USERNAME", false},
+		{"client_id", false, "AZDO_CLIENT_ID", false},
+		{"tenant_id", false, "AZDO_TENANT_ID", false},
+		{"client_secret", false, "AZDO_CLIENT_SECRET", true},
+		{"client_certificate_path", false, "AZDO_CLIENT_CERTIFICATE_PATH", false},
+		{"client_certificate_password", false, "AZDO_CLIENT_CERTIFICATE_PASSWORD", true},
+		{"oidc_token_file_path", false, "AZDO_OIDC_TOKEN_FILE_PATH", false},
+		{"use_msi", false, "", false},
+		{"msi_endpoint", false, "AZDO_MSI_ENDPOINT", false},
+		{"authority_host", false, "AZDO_AUTHORITY_HOST", false},
+		{"max_retries", false, "", false},
+		{"retry_max_wait", false, "", false},
+		{"max_concurrent_requests", false, "", false},
+		{"max_concurrent_requests_per_area", false, "", false},
+		{"max_requests_per_second", false, "", false},
+		{"identity_cache_ttl", false, "", false},
+		{"trace_file", false, "AZDO_TRACE_FILE", false},
+		{"ca_cert_file", false, "AZDO_CA_CERT_FILE", false},
+		{"ca_cert_pem", false, "AZDO_CA_CERT_PEM", false},
+		{"proxy_url", false, "AZDO_PROXY_URL", false},
+		{"insecure_skip_verify", false, "", false},
+		{"tls_client_cert_file", false, "AZDO_TLS_CLIENT_CERT_FILE", false},
+		{"tls_client_key_file", false, "AZDO_TLS_CLIENT_KEY_FILE", false},
 	}
 
 	schema := Provider().Schema
@@ -140,3 +186,11 @@ func TestProvider_SchemaIsValid(t *testing.T) {
 		}
 	}
 }
+
+func TestProvider_SensitiveFieldNames(t *testing.T) {
+	names := sensitiveFieldNames(Provider())
+	require.Contains(t, names, "personal_access_token")
+	require.Contains(t, names, "client_secret")
+	require.Contains(t, names, "secret_value")
+	require.NotContains(t, names, "org_service_url")
+}